@@ -0,0 +1,90 @@
+using System.Globalization;
+using System.IO;
+using System.Text;
+using UnityEditor;
+using UnityEngine;
+using UnityEngine.AI;
+
+// 서버 길찾기 격자(game_server/data/nav/<zoneID>.json) 내보내기
+// 열린 씬에 구운 NavMesh 를 cellSize 간격으로 훑어, 셀 중심 가까이에 NavMesh 가 있으면 '.', 없으면 '#' 로 적는다.
+// 파일 형식 (game_server/nav.go 의 navGrid):
+//   { "origin": { "X": -100, "Y": 0, "Z": -100 }, "cellSize": 2, "rows": ["..#.", ...] }
+//   셀 (x, z) 는 X/Z 평면에서 origin + (x*cellSize, z*cellSize) 부터 cellSize 크기의 정사각형이고,
+//   rows[z] 의 x 번째 글자가 그 셀이다. 모든 행의 길이가 같아야 하며 격자 밖은 서버에서 막힌 곳으로 본다.
+// origin/size 는 존의 boundsMin/boundsMax 와 맞추면 된다.
+public class NavGridExporter : EditorWindow
+{
+    private Vector3 origin = new Vector3(-100, 0, -100);
+    private Vector2 size = new Vector2(200, 200); // X, Z 크기 (m)
+    private float cellSize = 2;
+    private float sampleHeight = 10; // 셀 중심 위아래로 NavMesh 를 찾는 높이
+    private string outputPath = "../game_server/data/nav/field.json"; // 프로젝트 폴더 기준
+
+    [MenuItem("Tools/Nav Grid Exporter")]
+    private static void Open()
+    {
+        GetWindow<NavGridExporter>("Nav Grid Exporter");
+    }
+
+    private void OnGUI()
+    {
+        origin = EditorGUILayout.Vector3Field("Origin", origin);
+        size = EditorGUILayout.Vector2Field("Size (X, Z)", size);
+        cellSize = EditorGUILayout.FloatField("Cell Size", cellSize);
+        sampleHeight = EditorGUILayout.FloatField("Sample Height", sampleHeight);
+        outputPath = EditorGUILayout.TextField("Output", outputPath);
+
+        if (GUILayout.Button("Export"))
+        {
+            Export();
+        }
+    }
+
+    private void Export()
+    {
+        if (cellSize <= 0 || size.x < cellSize || size.y < cellSize)
+        {
+            Debug.LogError("NavGridExporter: cellSize 는 0 보다 크고 size 보다 작아야 합니다.");
+            return;
+        }
+        int width = Mathf.CeilToInt(size.x / cellSize);
+        int height = Mathf.CeilToInt(size.y / cellSize);
+        int walkable = 0;
+
+        var json = new StringBuilder();
+        json.Append("{\n");
+        json.AppendFormat(CultureInfo.InvariantCulture, "  \"origin\": {{ \"X\": {0}, \"Y\": {1}, \"Z\": {2} }},\n", origin.x, origin.y, origin.z);
+        json.AppendFormat(CultureInfo.InvariantCulture, "  \"cellSize\": {0},\n", cellSize);
+        json.Append("  \"rows\": [\n");
+        var row = new StringBuilder(width);
+        for (int z = 0; z < height; z++)
+        {
+            row.Clear();
+            for (int x = 0; x < width; x++)
+            {
+                bool ok = IsWalkable(x, z);
+                row.Append(ok ? '.' : '#');
+                if (ok) walkable++;
+            }
+            json.Append("    \"").Append(row).Append('"');
+            json.Append(z < height - 1 ? ",\n" : "\n");
+        }
+        json.Append("  ]\n}\n");
+
+        string path = Path.GetFullPath(Path.Combine(Application.dataPath, "..", outputPath));
+        File.WriteAllText(path, json.ToString());
+        Debug.Log($"NavGridExporter: {width}x{height} 셀 (걸을 수 있는 셀 {walkable}) -> {path}");
+    }
+
+    // 셀 중심에서 가장 가까운 NavMesh 위치가 같은 셀 안에 있으면 걸을 수 있는 셀
+    private bool IsWalkable(int x, int z)
+    {
+        var center = new Vector3(origin.x + (x + 0.5f) * cellSize, origin.y, origin.z + (z + 0.5f) * cellSize);
+        if (!NavMesh.SamplePosition(center, out NavMeshHit hit, sampleHeight, NavMesh.AllAreas))
+        {
+            return false;
+        }
+        float half = cellSize * 0.5f;
+        return Mathf.Abs(hit.position.x - center.x) <= half && Mathf.Abs(hit.position.z - center.z) <= half;
+    }
+}
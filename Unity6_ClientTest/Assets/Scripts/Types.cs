@@ -42,9 +42,11 @@ public class Vector
 public class PlayerState
 {
     public int health;
+    public int maxHealth; // health 가 0 이면 사망 상태
     public Vector Position;
     public Vector Target; // 목표 위치
     public int moveState; // 0: Idle, 1: Moving
+    public string zoneID; // 엔티티가 속한 존
 }
 
 [System.Serializable]
@@ -58,12 +60,24 @@ public class MoveApproved
 {
     public Vector target;
     public float speed;
+    public Vector[] path; // 경유지 (마지막이 target)
+    public long tick;
+    public long serverTime;
+}
+
+[System.Serializable]
+public class MoveRejected
+{
+    public Vector target;
+    public string code; // no_path, off_ground
 }
 
 [System.Serializable]
 public class PositionCorrection
 {
     public Vector position;
+    public long tick;
+    public long serverTime;
 }
 
 [System.Serializable]
@@ -73,11 +87,20 @@ public class RegisterRequest
     public string password;
 }
 
+[System.Serializable]
+public class FieldError
+{
+    public string field;
+    public string code;
+}
+
 [System.Serializable]
 public class RegisterResponse
 {
     public bool success;
+    public string code; // 실패 시 오류 코드
     public string message;
+    public FieldError[] errors; // code 가 validation_failed 일 때 필드별 오류
 }
 
 [System.Serializable]
@@ -91,6 +114,663 @@ public class LoginRequest
 public class LoginResponse
 {
     public bool success;
+    public string code; // 실패 시 오류 코드
     public string message;
     public string token; // JWT 토큰
-}
\ No newline at end of file
+}
+
+// ---- 존(맵) ----
+
+[System.Serializable]
+public class PortalInfo
+{
+    public string id;
+    public Vector position;
+    public float radius;
+    public string targetZone;
+}
+
+[System.Serializable]
+public class ZoneChanged
+{
+    public string zoneID;
+    public string name;
+    public long entityID; // 이 클라이언트가 조종하는 엔티티
+    public Vector position;
+    public Vector boundsMin;
+    public Vector boundsMax;
+    public PortalInfo[] portals;
+    public int tickMs; // 존 틱 주기 (보간 지연 = 2 * tickMs)
+}
+
+[System.Serializable]
+public class UsePortalRequest
+{
+    public string portalID;
+}
+
+[System.Serializable]
+public class ZoneTransferResult
+{
+    public bool success;
+    public string code;
+}
+
+// 스냅샷 델타는 id 외의 필드가 바뀌었을 때만 온다. JsonUtility 는 빠진 필드와 0 을 구별하지 못하므로
+// baseline 이 있는 스냅샷은 별도 파서가 필요하다 (snapshotAck 를 보내지 않으면 항상 전체 스냅샷이 온다)
+[System.Serializable]
+public class EntityState
+{
+    public long id;
+    public string kind; // player, npc, monster
+    public string name;
+    public int[] position; // [x, y, z] * 100 (1cm 단위)
+    public int[] target;
+    public int health;
+    public int maxHealth;
+    public int moveState; // 0: Idle, 1: Moving
+    public StatusEffectInfo[] effects;
+}
+
+[System.Serializable]
+public class Snapshot
+{
+    public long tick;
+    public long serverTime;
+    public long baseline; // 0 이면 전체 스냅샷
+    public EntityState[] entities;
+    public long[] removed;
+}
+
+[System.Serializable]
+public class SnapshotAck
+{
+    public string zoneID; // 스냅샷을 받은 존 (마지막 zoneChanged 의 zoneID)
+    public long tick;
+}
+
+// ---- 인스턴스(던전/방) ----
+[System.Serializable]
+public class InstanceCreateRequest
+{
+    public string template;
+}
+
+[System.Serializable]
+public class InstanceInviteRequest
+{
+    public string instanceID;
+    public string username;
+}
+
+[System.Serializable]
+public class InstanceInvitation
+{
+    public string instanceID;
+    public string template;
+    public string name;
+    public string from;
+}
+
+[System.Serializable]
+public class InstanceJoinRequest
+{
+    public string instanceID;
+}
+
+[System.Serializable]
+public class InstanceResult
+{
+    public string action;
+    public bool success;
+    public string code;
+    public string instanceID;
+}
+
+// ---- 로비/방 ----
+[System.Serializable]
+public class RoomInfo
+{
+    public long id;
+    public string name;
+    public string template;
+    public string host;
+    public string[] members;
+    public int maxPlayers;
+    public bool hasPassword;
+}
+
+[System.Serializable]
+public class LobbyJoined
+{
+    public RoomInfo[] rooms;
+}
+
+[System.Serializable]
+public class RoomList
+{
+    public RoomInfo[] rooms;
+}
+
+[System.Serializable]
+public class RoomCreateRequest
+{
+    public string name;
+    public string template;
+    public int maxPlayers;
+    public string password;
+}
+
+[System.Serializable]
+public class RoomJoinRequest
+{
+    public long roomID;
+    public string password;
+}
+
+[System.Serializable]
+public class RoomResult
+{
+    public string action;
+    public bool success;
+    public string code;
+    public long roomID;
+}
+
+[System.Serializable]
+public class RoomUpdate
+{
+    public RoomInfo room;
+}
+
+// ---- 매치메이킹 ----
+[System.Serializable]
+public class MatchQueueRequest
+{
+    public string mode;
+}
+
+[System.Serializable]
+public class MatchQueueResult
+{
+    public string action;
+    public bool success;
+    public string code;
+    public string mode;
+    public int rating;
+    public int penaltySec;
+}
+
+[System.Serializable]
+public class MatchFound
+{
+    public long matchID;
+    public string mode;
+    public int players;
+    public int acceptTimeoutSec;
+}
+
+[System.Serializable]
+public class MatchResponse
+{
+    public long matchID;
+}
+
+[System.Serializable]
+public class MatchCancelled
+{
+    public long matchID;
+    public string reason;
+    public bool requeued;
+}
+
+[System.Serializable]
+public class MatchPlayer
+{
+    public string username;
+    public int rating;
+}
+
+// JsonUtility 는 중첩 배열을 지원하지 않으므로 teams 는 별도 파서가 필요하다
+[System.Serializable]
+public class MatchStarted
+{
+    public long matchID;
+    public string instanceID;
+    public int team;
+    public int timeLimitSec; // 지나면 무승부
+}
+
+[System.Serializable]
+public class MatchEnded
+{
+    public long matchID;
+    public int winningTeam; // 무승부면 -1
+    public string reason;   // eliminated, forfeit, timeout
+}
+
+[System.Serializable]
+public class RatingChanged
+{
+    public long matchID;
+    public int rating;
+    public int delta;
+}
+
+// ---- 채팅 ----
+[System.Serializable]
+public class ChatSendRequest
+{
+    public string channel; // global, zone, proximity, whisper, party
+    public string to;
+    public string text;
+}
+
+[System.Serializable]
+public class ChatMessage
+{
+    public string channel;
+    public string from;
+    public string to;
+    public string text;
+    public long time;
+    public bool offline;
+}
+
+[System.Serializable]
+public class ChatHistory
+{
+    public string channel;
+    public string zoneID;
+    public ChatMessage[] messages;
+}
+
+[System.Serializable]
+public class ChatBlockRequest
+{
+    public string username;
+}
+
+[System.Serializable]
+public class GMCommandRequest
+{
+    public string command;
+    public string target;
+    public int minutes;
+    public string text;
+}
+
+[System.Serializable]
+public class ChatResult
+{
+    public string action;
+    public bool success;
+    public string code;
+    public long retryAfterMs;
+}
+
+// ---- 파티 ----
+[System.Serializable]
+public class PartyInviteRequest
+{
+    public string username;
+}
+
+[System.Serializable]
+public class PartyInvitation
+{
+    public string from;
+    public long expiresInMs;
+}
+
+[System.Serializable]
+public class PartyInvitationResponse
+{
+    public string from;
+}
+
+[System.Serializable]
+public class PartyInviteDeclined
+{
+    public string username;
+}
+
+[System.Serializable]
+public class PartyMemberRequest
+{
+    public string username;
+}
+
+[System.Serializable]
+public class PartyResult
+{
+    public string action;
+    public bool success;
+    public string code;
+}
+
+[System.Serializable]
+public class PartyMemberState
+{
+    public string username;
+    public bool online;
+    public string zoneID;
+    public Vector position;
+    public int health;
+}
+
+[System.Serializable]
+public class PartyUpdate
+{
+    public long partyID;
+    public string leader;
+    public PartyMemberState[] members;
+}
+
+[System.Serializable]
+public class PartyMemberUpdate
+{
+    public PartyMemberState[] members;
+}
+
+[System.Serializable]
+public class PartyLeft
+{
+    public long partyID;
+    public string reason;
+}
+
+// ---- 친구 ----
+[System.Serializable]
+public class FriendRequest
+{
+    public string username;
+}
+
+[System.Serializable]
+public class FriendResult
+{
+    public string action;
+    public bool success;
+    public string code;
+    public string username;
+}
+
+[System.Serializable]
+public class FriendRequestReceived
+{
+    public string from;
+}
+
+[System.Serializable]
+public class FriendPresence
+{
+    public string username;
+    public bool online;
+    public string zoneID;
+}
+
+[System.Serializable]
+public class FriendRemoved
+{
+    public string username;
+}
+
+[System.Serializable]
+public class FriendListResponse
+{
+    public bool success;
+    public string code;
+    public FriendPresence[] friends;
+    public string[] incoming;
+    public string[] outgoing;
+    public string[] blocked;
+}
+
+// ---- 길드 ----
+[System.Serializable]
+public class GuildCreateRequest
+{
+    public string name;
+}
+
+[System.Serializable]
+public class GuildMemberRequest
+{
+    public string username;
+}
+
+[System.Serializable]
+public class GuildInvitation
+{
+    public int guildID;
+    public string name;
+    public string from;
+}
+
+[System.Serializable]
+public class GuildInvitationResponse
+{
+    public int guildID;
+}
+
+[System.Serializable]
+public class GuildSetRankRequest
+{
+    public string username;
+    public string rank;
+}
+
+[System.Serializable]
+public class GuildMotdRequest
+{
+    public string text;
+}
+
+[System.Serializable]
+public class GuildResult
+{
+    public string action;
+    public bool success;
+    public string code;
+    public int guildID;
+}
+
+[System.Serializable]
+public class GuildMemberInfo
+{
+    public string username;
+    public string rank;
+    public bool online;
+}
+
+[System.Serializable]
+public class GuildRoster
+{
+    public int guildID;
+    public string name;
+    public string motd;
+    public GuildMemberInfo[] members;
+}
+
+[System.Serializable]
+public class GuildEvent
+{
+    public string type;
+    public string username;
+    public string rank;
+    public string text;
+    public string by;
+}
+
+// ---- 전투 ----
+[System.Serializable]
+public class AttackRequest
+{
+    public long targetID;
+    public long clientTime; // 공격을 입력한 순간의 클라이언트 시각 (ms, 지연 보상용)
+    public long renderTime; // 그때 원격 엔티티를 그리던 서버 시각 (있으면 clientTime 대신 씀)
+}
+
+[System.Serializable]
+public class AttackResult
+{
+    public bool success;
+    public string code;
+    public long targetID;
+    public int cooldownMs;
+}
+
+[System.Serializable]
+public class CombatEvent
+{
+    public string type; // damage, heal, death, respawn
+    public long sourceID;
+    public long targetID;
+    public string abilityID; // 기본 공격이면 비어 있음
+    public string statusID; // 지속 피해/회복이면 상태 효과 ID
+    public int amount;
+    public int absorbed; // 보호막이 흡수한 피해
+    public int health;
+    public Vector position; // respawn 일 때만
+    public long tick;
+    public long serverTime;
+}
+
+[System.Serializable]
+public class HealthUpdate
+{
+    public int health;
+    public int maxHealth;
+    public long tick;
+    public long serverTime;
+}
+
+[System.Serializable]
+public class PlayerDied
+{
+    public long killerID; // 존을 옮긴 뒤 다시 보낼 때는 0
+    public int respawnInMs;
+}
+
+// ---- 능력 ----
+[System.Serializable]
+public class UseAbilityRequest
+{
+    public string abilityID;
+    public long targetID; // 대상 지정 능력
+    public Vector position; // 지점 지정 능력 (부채꼴/직선은 방향)
+}
+
+[System.Serializable]
+public class AbilityResult
+{
+    public bool success;
+    public string code;
+    public string abilityID;
+    public int cooldownMs;
+}
+
+[System.Serializable]
+public class CastEvent
+{
+    public string type; // start, finish, interrupt
+    public long casterID;
+    public string abilityID;
+    public int castMs;
+    public long targetID;
+    public Vector position;
+    public int cooldownMs;
+    public string reason;
+    public long tick;
+    public long serverTime;
+}
+
+[System.Serializable]
+public class ResourceUpdate
+{
+    public string resource; // mana, energy
+    public int value;
+    public int max;
+    public long tick;
+    public long serverTime;
+}
+
+// ---- 상태 효과 ----
+[System.Serializable]
+public class StatusEffectInfo
+{
+    public string id;
+    public string kind; // slow, stun, dot, hot, shield, haste, stats
+    public bool buff;
+    public int stacks;
+    public int durationMs;
+    public int remainingMs;
+    public long sourceID;
+    public int shield; // shield 일 때 남은 흡수량
+}
+
+[System.Serializable]
+public class StatusEvent
+{
+    public string type; // applied, refreshed, removed
+    public long entityID;
+    public StatusEffectInfo effect;
+    public string reason; // expired, dispelled, depleted, died
+    public long tick;
+    public long serverTime;
+}
+
+[System.Serializable]
+public class StatusEffects
+{
+    public StatusEffectInfo[] effects;
+}
+
+// ---- 능력치 ----
+[System.Serializable]
+public class CharacterStats
+{
+    public int stamina;
+    public int strength;
+    public int maxHealth;
+    public int attackPower;
+    public float moveSpeed;
+}
+
+[System.Serializable]
+public class StatsUpdate
+{
+    public CharacterStats @base; // 수정치가 없을 때
+    public CharacterStats stats; // 장비/상태 효과 반영
+    public long tick;
+    public long serverTime;
+}
+
+// ---- 연결 ----
+[System.Serializable]
+public class Ping
+{
+    public long serverTime;  // 보낸 시각 (Unix ms, 서버 시계). pong 에 그대로 돌려준다.
+    public long serverClock; // 보낸 시각 (서버 시각, 서버 시작부터 ms)
+}
+
+[System.Serializable]
+public class Pong
+{
+    public long serverTime; // 받은 ping 의 serverTime 그대로
+    public long clientTime; // 답할 때의 클라이언트 시각 (Unix ms)
+}
+
+[System.Serializable]
+public class TimeSyncRequest
+{
+    public long clientTime;
+}
+
+[System.Serializable]
+public class TimeSync
+{
+    public long clientTime; // 요청의 clientTime 그대로
+    public long serverTime;
+}
@@ -80,7 +80,8 @@ public class LoginUI : MonoBehaviour
             yield return www.SendWebRequest();
             waitingUI.Hide();
             loginButton.interactable = true;
-            if (www.result != UnityWebRequest.Result.Success)
+            // 4xx/5xx 응답(ProtocolError)도 JSON 본문에 code/message 가 담겨 오므로 파싱한다.
+            if (www.result != UnityWebRequest.Result.Success && www.result != UnityWebRequest.Result.ProtocolError)
             {
                 popupUI.Popup(PopupType.Error, "서버 오류: " + www.error, new System.Collections.Generic.List<(string, System.Action)>{ ("확인", null) });
             }
@@ -122,7 +123,7 @@ public class LoginUI : MonoBehaviour
         {
             yield return www.SendWebRequest();
             waitingUI.Hide();
-            if (www.result != UnityWebRequest.Result.Success)
+            if (www.result != UnityWebRequest.Result.Success && www.result != UnityWebRequest.Result.ProtocolError)
             {
                 popupUI.Popup(PopupType.Error, "서버 오류: " + www.error, new System.Collections.Generic.List<(string, System.Action)>{ ("확인", null) });
             }
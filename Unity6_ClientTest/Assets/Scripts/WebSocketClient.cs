@@ -10,6 +10,12 @@ public class WebSocketClient : MonoBehaviour
     private WebSocket ws;
     public event System.Action<Vector3, float> onMoveApproved;
     public event System.Action<Vector3> onPositionCorrection;
+
+    // 서버 시각 추정 (timeSync 응답 중 RTT 가 가장 작은 것 기준)
+    private const int timeSyncSamples = 5;
+    private int timeSyncCount;
+    private long bestRtt = long.MaxValue;
+    private long serverClockOffset; // 서버 시각 - 클라이언트 시각
     public event System.Action<bool, string> onRegisterResponse;
     public event System.Action<bool, string> onLoginResponse;
 
@@ -110,6 +116,30 @@ public class WebSocketClient : MonoBehaviour
                 var pos = new Vector3(corr.position.X, corr.position.Y, corr.position.Z);
                 onPositionCorrection?.Invoke(pos);
             }
+            else if (wsMsg.type == "ping")
+            {
+                // 서버가 지연을 잴 수 있도록 바로 답한다
+                SendPong(wsMsg.DecodeData<Ping>().serverTime);
+            }
+            else if (wsMsg.type == "timeSync")
+            {
+                var sync = wsMsg.DecodeData<TimeSync>();
+                long now = ClientTime();
+                long rtt = now - sync.clientTime;
+                if (rtt >= 0 && rtt < bestRtt)
+                {
+                    bestRtt = rtt;
+                    serverClockOffset = sync.serverTime + rtt / 2 - now;
+                }
+                if (++timeSyncCount < timeSyncSamples)
+                    SendTimeSync();
+            }
+            else if (wsMsg.type == "lobbyJoined")
+            {
+                // 접속하면 로비에 먼저 들어간다. 테스트 클라이언트는 서버 시각을 맞추고 바로 월드(시작 존)로 입장한다.
+                SendTimeSync();
+                SendEnterWorld();
+            }
             else if (wsMsg.type == "registerResponse")
             {
                 var resp = wsMsg.DecodeData<RegisterResponse>();
@@ -150,6 +180,42 @@ public class WebSocketClient : MonoBehaviour
         await ws.SendText(JsonUtility.ToJson(msg));
     }
 
+    public async void SendPong(long serverTime)
+    {
+        if (ws == null || ws.State != WebSocketState.Open)
+            return;
+        var pong = new Pong { serverTime = serverTime, clientTime = ClientTime() };
+        var msg = WSMessage.Create("pong", pong);
+        await ws.SendText(JsonUtility.ToJson(msg));
+    }
+
+    public async void SendTimeSync()
+    {
+        if (ws == null || ws.State != WebSocketState.Open)
+            return;
+        var msg = WSMessage.Create("timeSync", new TimeSyncRequest { clientTime = ClientTime() });
+        await ws.SendText(JsonUtility.ToJson(msg));
+    }
+
+    private static long ClientTime()
+    {
+        return DateTimeOffset.UtcNow.ToUnixTimeMilliseconds();
+    }
+
+    // 추정한 지금의 서버 시각 (원격 엔티티는 이보다 보간 지연만큼 앞선 시점으로 그린다)
+    public long EstimatedServerTime()
+    {
+        return ClientTime() + serverClockOffset;
+    }
+
+    public async void SendEnterWorld()
+    {
+        if (ws == null || ws.State != WebSocketState.Open)
+            return;
+        var msg = new WSMessage { type = "enterWorld", data = "" };
+        await ws.SendText(JsonUtility.ToJson(msg));
+    }
+
     public async void SendRegister(string username, string password)
     {
         if (ws == null || ws.State != WebSocketState.Open)
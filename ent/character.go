@@ -0,0 +1,189 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/SilverSS/gameserver/ent/character"
+	"github.com/SilverSS/gameserver/ent/user"
+)
+
+// Character is the model entity for the Character schema.
+type Character struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Level holds the value of the "level" field.
+	Level int `json:"level,omitempty"`
+	// Rating holds the value of the "rating" field.
+	Rating int `json:"rating,omitempty"`
+	// RatedGames holds the value of the "rated_games" field.
+	RatedGames int `json:"rated_games,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CharacterQuery when eager-loading is set.
+	Edges           CharacterEdges `json:"edges"`
+	user_characters *int
+	selectValues    sql.SelectValues
+}
+
+// CharacterEdges holds the relations/edges for other nodes in the graph.
+type CharacterEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CharacterEdges) OwnerOrErr() (*User, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Character) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case character.FieldID, character.FieldLevel, character.FieldRating, character.FieldRatedGames:
+			values[i] = new(sql.NullInt64)
+		case character.FieldName:
+			values[i] = new(sql.NullString)
+		case character.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case character.ForeignKeys[0]: // user_characters
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Character fields.
+func (c *Character) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case character.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			c.ID = int(value.Int64)
+		case character.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				c.Name = value.String
+			}
+		case character.FieldLevel:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field level", values[i])
+			} else if value.Valid {
+				c.Level = int(value.Int64)
+			}
+		case character.FieldRating:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rating", values[i])
+			} else if value.Valid {
+				c.Rating = int(value.Int64)
+			}
+		case character.FieldRatedGames:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rated_games", values[i])
+			} else if value.Valid {
+				c.RatedGames = int(value.Int64)
+			}
+		case character.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				c.CreatedAt = value.Time
+			}
+		case character.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_characters", value)
+			} else if value.Valid {
+				c.user_characters = new(int)
+				*c.user_characters = int(value.Int64)
+			}
+		default:
+			c.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Character.
+// This includes values selected through modifiers, order, etc.
+func (c *Character) Value(name string) (ent.Value, error) {
+	return c.selectValues.Get(name)
+}
+
+// QueryOwner queries the "owner" edge of the Character entity.
+func (c *Character) QueryOwner() *UserQuery {
+	return NewCharacterClient(c.config).QueryOwner(c)
+}
+
+// Update returns a builder for updating this Character.
+// Note that you need to call Character.Unwrap() before calling this method if this Character
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Character) Update() *CharacterUpdateOne {
+	return NewCharacterClient(c.config).UpdateOne(c)
+}
+
+// Unwrap unwraps the Character entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Character) Unwrap() *Character {
+	_tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Character is not a transactional entity")
+	}
+	c.config.driver = _tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Character) String() string {
+	var builder strings.Builder
+	builder.WriteString("Character(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("name=")
+	builder.WriteString(c.Name)
+	builder.WriteString(", ")
+	builder.WriteString("level=")
+	builder.WriteString(fmt.Sprintf("%v", c.Level))
+	builder.WriteString(", ")
+	builder.WriteString("rating=")
+	builder.WriteString(fmt.Sprintf("%v", c.Rating))
+	builder.WriteString(", ")
+	builder.WriteString("rated_games=")
+	builder.WriteString(fmt.Sprintf("%v", c.RatedGames))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Characters is a parsable slice of Character.
+type Characters []*Character
@@ -0,0 +1,337 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/SilverSS/gameserver/ent/character"
+	"github.com/SilverSS/gameserver/ent/user"
+)
+
+// CharacterCreate is the builder for creating a Character entity.
+type CharacterCreate struct {
+	config
+	mutation *CharacterMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (cc *CharacterCreate) SetName(s string) *CharacterCreate {
+	cc.mutation.SetName(s)
+	return cc
+}
+
+// SetLevel sets the "level" field.
+func (cc *CharacterCreate) SetLevel(i int) *CharacterCreate {
+	cc.mutation.SetLevel(i)
+	return cc
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (cc *CharacterCreate) SetNillableLevel(i *int) *CharacterCreate {
+	if i != nil {
+		cc.SetLevel(*i)
+	}
+	return cc
+}
+
+// SetRating sets the "rating" field.
+func (cc *CharacterCreate) SetRating(i int) *CharacterCreate {
+	cc.mutation.SetRating(i)
+	return cc
+}
+
+// SetNillableRating sets the "rating" field if the given value is not nil.
+func (cc *CharacterCreate) SetNillableRating(i *int) *CharacterCreate {
+	if i != nil {
+		cc.SetRating(*i)
+	}
+	return cc
+}
+
+// SetRatedGames sets the "rated_games" field.
+func (cc *CharacterCreate) SetRatedGames(i int) *CharacterCreate {
+	cc.mutation.SetRatedGames(i)
+	return cc
+}
+
+// SetNillableRatedGames sets the "rated_games" field if the given value is not nil.
+func (cc *CharacterCreate) SetNillableRatedGames(i *int) *CharacterCreate {
+	if i != nil {
+		cc.SetRatedGames(*i)
+	}
+	return cc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cc *CharacterCreate) SetCreatedAt(t time.Time) *CharacterCreate {
+	cc.mutation.SetCreatedAt(t)
+	return cc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cc *CharacterCreate) SetNillableCreatedAt(t *time.Time) *CharacterCreate {
+	if t != nil {
+		cc.SetCreatedAt(*t)
+	}
+	return cc
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (cc *CharacterCreate) SetOwnerID(id int) *CharacterCreate {
+	cc.mutation.SetOwnerID(id)
+	return cc
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (cc *CharacterCreate) SetOwner(u *User) *CharacterCreate {
+	return cc.SetOwnerID(u.ID)
+}
+
+// Mutation returns the CharacterMutation object of the builder.
+func (cc *CharacterCreate) Mutation() *CharacterMutation {
+	return cc.mutation
+}
+
+// Save creates the Character in the database.
+func (cc *CharacterCreate) Save(ctx context.Context) (*Character, error) {
+	cc.defaults()
+	return withHooks(ctx, cc.sqlSave, cc.mutation, cc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cc *CharacterCreate) SaveX(ctx context.Context) *Character {
+	v, err := cc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cc *CharacterCreate) Exec(ctx context.Context) error {
+	_, err := cc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cc *CharacterCreate) ExecX(ctx context.Context) {
+	if err := cc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cc *CharacterCreate) defaults() {
+	if _, ok := cc.mutation.Level(); !ok {
+		v := character.DefaultLevel
+		cc.mutation.SetLevel(v)
+	}
+	if _, ok := cc.mutation.Rating(); !ok {
+		v := character.DefaultRating
+		cc.mutation.SetRating(v)
+	}
+	if _, ok := cc.mutation.RatedGames(); !ok {
+		v := character.DefaultRatedGames
+		cc.mutation.SetRatedGames(v)
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		v := character.DefaultCreatedAt()
+		cc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cc *CharacterCreate) check() error {
+	if _, ok := cc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Character.name"`)}
+	}
+	if v, ok := cc.mutation.Name(); ok {
+		if err := character.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Character.name": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.Level(); !ok {
+		return &ValidationError{Name: "level", err: errors.New(`ent: missing required field "Character.level"`)}
+	}
+	if v, ok := cc.mutation.Level(); ok {
+		if err := character.LevelValidator(v); err != nil {
+			return &ValidationError{Name: "level", err: fmt.Errorf(`ent: validator failed for field "Character.level": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.Rating(); !ok {
+		return &ValidationError{Name: "rating", err: errors.New(`ent: missing required field "Character.rating"`)}
+	}
+	if _, ok := cc.mutation.RatedGames(); !ok {
+		return &ValidationError{Name: "rated_games", err: errors.New(`ent: missing required field "Character.rated_games"`)}
+	}
+	if v, ok := cc.mutation.RatedGames(); ok {
+		if err := character.RatedGamesValidator(v); err != nil {
+			return &ValidationError{Name: "rated_games", err: fmt.Errorf(`ent: validator failed for field "Character.rated_games": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Character.created_at"`)}
+	}
+	if len(cc.mutation.OwnerIDs()) == 0 {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "Character.owner"`)}
+	}
+	return nil
+}
+
+func (cc *CharacterCreate) sqlSave(ctx context.Context) (*Character, error) {
+	if err := cc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	cc.mutation.id = &_node.ID
+	cc.mutation.done = true
+	return _node, nil
+}
+
+func (cc *CharacterCreate) createSpec() (*Character, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Character{config: cc.config}
+		_spec = sqlgraph.NewCreateSpec(character.Table, sqlgraph.NewFieldSpec(character.FieldID, field.TypeInt))
+	)
+	if value, ok := cc.mutation.Name(); ok {
+		_spec.SetField(character.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := cc.mutation.Level(); ok {
+		_spec.SetField(character.FieldLevel, field.TypeInt, value)
+		_node.Level = value
+	}
+	if value, ok := cc.mutation.Rating(); ok {
+		_spec.SetField(character.FieldRating, field.TypeInt, value)
+		_node.Rating = value
+	}
+	if value, ok := cc.mutation.RatedGames(); ok {
+		_spec.SetField(character.FieldRatedGames, field.TypeInt, value)
+		_node.RatedGames = value
+	}
+	if value, ok := cc.mutation.CreatedAt(); ok {
+		_spec.SetField(character.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := cc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   character.OwnerTable,
+			Columns: []string{character.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_characters = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CharacterCreateBulk is the builder for creating many Character entities in bulk.
+type CharacterCreateBulk struct {
+	config
+	err      error
+	builders []*CharacterCreate
+}
+
+// Save creates the Character entities in the database.
+func (ccb *CharacterCreateBulk) Save(ctx context.Context) ([]*Character, error) {
+	if ccb.err != nil {
+		return nil, ccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
+	nodes := make([]*Character, len(ccb.builders))
+	mutators := make([]Mutator, len(ccb.builders))
+	for i := range ccb.builders {
+		func(i int, root context.Context) {
+			builder := ccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CharacterMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccb *CharacterCreateBulk) SaveX(ctx context.Context) []*Character {
+	v, err := ccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccb *CharacterCreateBulk) Exec(ctx context.Context) error {
+	_, err := ccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccb *CharacterCreateBulk) ExecX(ctx context.Context) {
+	if err := ccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
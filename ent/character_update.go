@@ -0,0 +1,551 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/SilverSS/gameserver/ent/character"
+	"github.com/SilverSS/gameserver/ent/predicate"
+	"github.com/SilverSS/gameserver/ent/user"
+)
+
+// CharacterUpdate is the builder for updating Character entities.
+type CharacterUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CharacterMutation
+}
+
+// Where appends a list predicates to the CharacterUpdate builder.
+func (cu *CharacterUpdate) Where(ps ...predicate.Character) *CharacterUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetName sets the "name" field.
+func (cu *CharacterUpdate) SetName(s string) *CharacterUpdate {
+	cu.mutation.SetName(s)
+	return cu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cu *CharacterUpdate) SetNillableName(s *string) *CharacterUpdate {
+	if s != nil {
+		cu.SetName(*s)
+	}
+	return cu
+}
+
+// SetLevel sets the "level" field.
+func (cu *CharacterUpdate) SetLevel(i int) *CharacterUpdate {
+	cu.mutation.ResetLevel()
+	cu.mutation.SetLevel(i)
+	return cu
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (cu *CharacterUpdate) SetNillableLevel(i *int) *CharacterUpdate {
+	if i != nil {
+		cu.SetLevel(*i)
+	}
+	return cu
+}
+
+// AddLevel adds i to the "level" field.
+func (cu *CharacterUpdate) AddLevel(i int) *CharacterUpdate {
+	cu.mutation.AddLevel(i)
+	return cu
+}
+
+// SetRating sets the "rating" field.
+func (cu *CharacterUpdate) SetRating(i int) *CharacterUpdate {
+	cu.mutation.ResetRating()
+	cu.mutation.SetRating(i)
+	return cu
+}
+
+// SetNillableRating sets the "rating" field if the given value is not nil.
+func (cu *CharacterUpdate) SetNillableRating(i *int) *CharacterUpdate {
+	if i != nil {
+		cu.SetRating(*i)
+	}
+	return cu
+}
+
+// AddRating adds i to the "rating" field.
+func (cu *CharacterUpdate) AddRating(i int) *CharacterUpdate {
+	cu.mutation.AddRating(i)
+	return cu
+}
+
+// SetRatedGames sets the "rated_games" field.
+func (cu *CharacterUpdate) SetRatedGames(i int) *CharacterUpdate {
+	cu.mutation.ResetRatedGames()
+	cu.mutation.SetRatedGames(i)
+	return cu
+}
+
+// SetNillableRatedGames sets the "rated_games" field if the given value is not nil.
+func (cu *CharacterUpdate) SetNillableRatedGames(i *int) *CharacterUpdate {
+	if i != nil {
+		cu.SetRatedGames(*i)
+	}
+	return cu
+}
+
+// AddRatedGames adds i to the "rated_games" field.
+func (cu *CharacterUpdate) AddRatedGames(i int) *CharacterUpdate {
+	cu.mutation.AddRatedGames(i)
+	return cu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cu *CharacterUpdate) SetCreatedAt(t time.Time) *CharacterUpdate {
+	cu.mutation.SetCreatedAt(t)
+	return cu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cu *CharacterUpdate) SetNillableCreatedAt(t *time.Time) *CharacterUpdate {
+	if t != nil {
+		cu.SetCreatedAt(*t)
+	}
+	return cu
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (cu *CharacterUpdate) SetOwnerID(id int) *CharacterUpdate {
+	cu.mutation.SetOwnerID(id)
+	return cu
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (cu *CharacterUpdate) SetOwner(u *User) *CharacterUpdate {
+	return cu.SetOwnerID(u.ID)
+}
+
+// Mutation returns the CharacterMutation object of the builder.
+func (cu *CharacterUpdate) Mutation() *CharacterMutation {
+	return cu.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (cu *CharacterUpdate) ClearOwner() *CharacterUpdate {
+	cu.mutation.ClearOwner()
+	return cu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *CharacterUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *CharacterUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *CharacterUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *CharacterUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cu *CharacterUpdate) check() error {
+	if v, ok := cu.mutation.Name(); ok {
+		if err := character.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Character.name": %w`, err)}
+		}
+	}
+	if v, ok := cu.mutation.Level(); ok {
+		if err := character.LevelValidator(v); err != nil {
+			return &ValidationError{Name: "level", err: fmt.Errorf(`ent: validator failed for field "Character.level": %w`, err)}
+		}
+	}
+	if v, ok := cu.mutation.RatedGames(); ok {
+		if err := character.RatedGamesValidator(v); err != nil {
+			return &ValidationError{Name: "rated_games", err: fmt.Errorf(`ent: validator failed for field "Character.rated_games": %w`, err)}
+		}
+	}
+	if cu.mutation.OwnerCleared() && len(cu.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Character.owner"`)
+	}
+	return nil
+}
+
+func (cu *CharacterUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(character.Table, character.Columns, sqlgraph.NewFieldSpec(character.FieldID, field.TypeInt))
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.Name(); ok {
+		_spec.SetField(character.FieldName, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.Level(); ok {
+		_spec.SetField(character.FieldLevel, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.AddedLevel(); ok {
+		_spec.AddField(character.FieldLevel, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.Rating(); ok {
+		_spec.SetField(character.FieldRating, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.AddedRating(); ok {
+		_spec.AddField(character.FieldRating, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.RatedGames(); ok {
+		_spec.SetField(character.FieldRatedGames, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.AddedRatedGames(); ok {
+		_spec.AddField(character.FieldRatedGames, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.CreatedAt(); ok {
+		_spec.SetField(character.FieldCreatedAt, field.TypeTime, value)
+	}
+	if cu.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   character.OwnerTable,
+			Columns: []string{character.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   character.OwnerTable,
+			Columns: []string{character.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{character.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cu.mutation.done = true
+	return n, nil
+}
+
+// CharacterUpdateOne is the builder for updating a single Character entity.
+type CharacterUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CharacterMutation
+}
+
+// SetName sets the "name" field.
+func (cuo *CharacterUpdateOne) SetName(s string) *CharacterUpdateOne {
+	cuo.mutation.SetName(s)
+	return cuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cuo *CharacterUpdateOne) SetNillableName(s *string) *CharacterUpdateOne {
+	if s != nil {
+		cuo.SetName(*s)
+	}
+	return cuo
+}
+
+// SetLevel sets the "level" field.
+func (cuo *CharacterUpdateOne) SetLevel(i int) *CharacterUpdateOne {
+	cuo.mutation.ResetLevel()
+	cuo.mutation.SetLevel(i)
+	return cuo
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (cuo *CharacterUpdateOne) SetNillableLevel(i *int) *CharacterUpdateOne {
+	if i != nil {
+		cuo.SetLevel(*i)
+	}
+	return cuo
+}
+
+// AddLevel adds i to the "level" field.
+func (cuo *CharacterUpdateOne) AddLevel(i int) *CharacterUpdateOne {
+	cuo.mutation.AddLevel(i)
+	return cuo
+}
+
+// SetRating sets the "rating" field.
+func (cuo *CharacterUpdateOne) SetRating(i int) *CharacterUpdateOne {
+	cuo.mutation.ResetRating()
+	cuo.mutation.SetRating(i)
+	return cuo
+}
+
+// SetNillableRating sets the "rating" field if the given value is not nil.
+func (cuo *CharacterUpdateOne) SetNillableRating(i *int) *CharacterUpdateOne {
+	if i != nil {
+		cuo.SetRating(*i)
+	}
+	return cuo
+}
+
+// AddRating adds i to the "rating" field.
+func (cuo *CharacterUpdateOne) AddRating(i int) *CharacterUpdateOne {
+	cuo.mutation.AddRating(i)
+	return cuo
+}
+
+// SetRatedGames sets the "rated_games" field.
+func (cuo *CharacterUpdateOne) SetRatedGames(i int) *CharacterUpdateOne {
+	cuo.mutation.ResetRatedGames()
+	cuo.mutation.SetRatedGames(i)
+	return cuo
+}
+
+// SetNillableRatedGames sets the "rated_games" field if the given value is not nil.
+func (cuo *CharacterUpdateOne) SetNillableRatedGames(i *int) *CharacterUpdateOne {
+	if i != nil {
+		cuo.SetRatedGames(*i)
+	}
+	return cuo
+}
+
+// AddRatedGames adds i to the "rated_games" field.
+func (cuo *CharacterUpdateOne) AddRatedGames(i int) *CharacterUpdateOne {
+	cuo.mutation.AddRatedGames(i)
+	return cuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cuo *CharacterUpdateOne) SetCreatedAt(t time.Time) *CharacterUpdateOne {
+	cuo.mutation.SetCreatedAt(t)
+	return cuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cuo *CharacterUpdateOne) SetNillableCreatedAt(t *time.Time) *CharacterUpdateOne {
+	if t != nil {
+		cuo.SetCreatedAt(*t)
+	}
+	return cuo
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (cuo *CharacterUpdateOne) SetOwnerID(id int) *CharacterUpdateOne {
+	cuo.mutation.SetOwnerID(id)
+	return cuo
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (cuo *CharacterUpdateOne) SetOwner(u *User) *CharacterUpdateOne {
+	return cuo.SetOwnerID(u.ID)
+}
+
+// Mutation returns the CharacterMutation object of the builder.
+func (cuo *CharacterUpdateOne) Mutation() *CharacterMutation {
+	return cuo.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (cuo *CharacterUpdateOne) ClearOwner() *CharacterUpdateOne {
+	cuo.mutation.ClearOwner()
+	return cuo
+}
+
+// Where appends a list predicates to the CharacterUpdate builder.
+func (cuo *CharacterUpdateOne) Where(ps ...predicate.Character) *CharacterUpdateOne {
+	cuo.mutation.Where(ps...)
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *CharacterUpdateOne) Select(field string, fields ...string) *CharacterUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Character entity.
+func (cuo *CharacterUpdateOne) Save(ctx context.Context) (*Character, error) {
+	return withHooks(ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *CharacterUpdateOne) SaveX(ctx context.Context) *Character {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *CharacterUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *CharacterUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cuo *CharacterUpdateOne) check() error {
+	if v, ok := cuo.mutation.Name(); ok {
+		if err := character.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Character.name": %w`, err)}
+		}
+	}
+	if v, ok := cuo.mutation.Level(); ok {
+		if err := character.LevelValidator(v); err != nil {
+			return &ValidationError{Name: "level", err: fmt.Errorf(`ent: validator failed for field "Character.level": %w`, err)}
+		}
+	}
+	if v, ok := cuo.mutation.RatedGames(); ok {
+		if err := character.RatedGamesValidator(v); err != nil {
+			return &ValidationError{Name: "rated_games", err: fmt.Errorf(`ent: validator failed for field "Character.rated_games": %w`, err)}
+		}
+	}
+	if cuo.mutation.OwnerCleared() && len(cuo.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Character.owner"`)
+	}
+	return nil
+}
+
+func (cuo *CharacterUpdateOne) sqlSave(ctx context.Context) (_node *Character, err error) {
+	if err := cuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(character.Table, character.Columns, sqlgraph.NewFieldSpec(character.FieldID, field.TypeInt))
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Character.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, character.FieldID)
+		for _, f := range fields {
+			if !character.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != character.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.Name(); ok {
+		_spec.SetField(character.FieldName, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.Level(); ok {
+		_spec.SetField(character.FieldLevel, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.AddedLevel(); ok {
+		_spec.AddField(character.FieldLevel, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.Rating(); ok {
+		_spec.SetField(character.FieldRating, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.AddedRating(); ok {
+		_spec.AddField(character.FieldRating, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.RatedGames(); ok {
+		_spec.SetField(character.FieldRatedGames, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.AddedRatedGames(); ok {
+		_spec.AddField(character.FieldRatedGames, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.CreatedAt(); ok {
+		_spec.SetField(character.FieldCreatedAt, field.TypeTime, value)
+	}
+	if cuo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   character.OwnerTable,
+			Columns: []string{character.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   character.OwnerTable,
+			Columns: []string{character.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Character{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{character.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cuo.mutation.done = true
+	return _node, nil
+}
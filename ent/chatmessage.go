@@ -0,0 +1,174 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/SilverSS/gameserver/ent/chatmessage"
+)
+
+// ChatMessage is the model entity for the ChatMessage schema.
+type ChatMessage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Channel holds the value of the "channel" field.
+	Channel string `json:"channel,omitempty"`
+	// ZoneID holds the value of the "zone_id" field.
+	ZoneID string `json:"zone_id,omitempty"`
+	// Sender holds the value of the "sender" field.
+	Sender string `json:"sender,omitempty"`
+	// Recipient holds the value of the "recipient" field.
+	Recipient string `json:"recipient,omitempty"`
+	// Text holds the value of the "text" field.
+	Text string `json:"text,omitempty"`
+	// Delivered holds the value of the "delivered" field.
+	Delivered bool `json:"delivered,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ChatMessage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case chatmessage.FieldDelivered:
+			values[i] = new(sql.NullBool)
+		case chatmessage.FieldID:
+			values[i] = new(sql.NullInt64)
+		case chatmessage.FieldChannel, chatmessage.FieldZoneID, chatmessage.FieldSender, chatmessage.FieldRecipient, chatmessage.FieldText:
+			values[i] = new(sql.NullString)
+		case chatmessage.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ChatMessage fields.
+func (cm *ChatMessage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case chatmessage.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			cm.ID = int(value.Int64)
+		case chatmessage.FieldChannel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field channel", values[i])
+			} else if value.Valid {
+				cm.Channel = value.String
+			}
+		case chatmessage.FieldZoneID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field zone_id", values[i])
+			} else if value.Valid {
+				cm.ZoneID = value.String
+			}
+		case chatmessage.FieldSender:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sender", values[i])
+			} else if value.Valid {
+				cm.Sender = value.String
+			}
+		case chatmessage.FieldRecipient:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field recipient", values[i])
+			} else if value.Valid {
+				cm.Recipient = value.String
+			}
+		case chatmessage.FieldText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field text", values[i])
+			} else if value.Valid {
+				cm.Text = value.String
+			}
+		case chatmessage.FieldDelivered:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field delivered", values[i])
+			} else if value.Valid {
+				cm.Delivered = value.Bool
+			}
+		case chatmessage.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				cm.CreatedAt = value.Time
+			}
+		default:
+			cm.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ChatMessage.
+// This includes values selected through modifiers, order, etc.
+func (cm *ChatMessage) Value(name string) (ent.Value, error) {
+	return cm.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ChatMessage.
+// Note that you need to call ChatMessage.Unwrap() before calling this method if this ChatMessage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cm *ChatMessage) Update() *ChatMessageUpdateOne {
+	return NewChatMessageClient(cm.config).UpdateOne(cm)
+}
+
+// Unwrap unwraps the ChatMessage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cm *ChatMessage) Unwrap() *ChatMessage {
+	_tx, ok := cm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ChatMessage is not a transactional entity")
+	}
+	cm.config.driver = _tx.drv
+	return cm
+}
+
+// String implements the fmt.Stringer.
+func (cm *ChatMessage) String() string {
+	var builder strings.Builder
+	builder.WriteString("ChatMessage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cm.ID))
+	builder.WriteString("channel=")
+	builder.WriteString(cm.Channel)
+	builder.WriteString(", ")
+	builder.WriteString("zone_id=")
+	builder.WriteString(cm.ZoneID)
+	builder.WriteString(", ")
+	builder.WriteString("sender=")
+	builder.WriteString(cm.Sender)
+	builder.WriteString(", ")
+	builder.WriteString("recipient=")
+	builder.WriteString(cm.Recipient)
+	builder.WriteString(", ")
+	builder.WriteString("text=")
+	builder.WriteString(cm.Text)
+	builder.WriteString(", ")
+	builder.WriteString("delivered=")
+	builder.WriteString(fmt.Sprintf("%v", cm.Delivered))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(cm.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ChatMessages is a parsable slice of ChatMessage.
+type ChatMessages []*ChatMessage
@@ -0,0 +1,321 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/SilverSS/gameserver/ent/chatmessage"
+)
+
+// ChatMessageCreate is the builder for creating a ChatMessage entity.
+type ChatMessageCreate struct {
+	config
+	mutation *ChatMessageMutation
+	hooks    []Hook
+}
+
+// SetChannel sets the "channel" field.
+func (cmc *ChatMessageCreate) SetChannel(s string) *ChatMessageCreate {
+	cmc.mutation.SetChannel(s)
+	return cmc
+}
+
+// SetZoneID sets the "zone_id" field.
+func (cmc *ChatMessageCreate) SetZoneID(s string) *ChatMessageCreate {
+	cmc.mutation.SetZoneID(s)
+	return cmc
+}
+
+// SetNillableZoneID sets the "zone_id" field if the given value is not nil.
+func (cmc *ChatMessageCreate) SetNillableZoneID(s *string) *ChatMessageCreate {
+	if s != nil {
+		cmc.SetZoneID(*s)
+	}
+	return cmc
+}
+
+// SetSender sets the "sender" field.
+func (cmc *ChatMessageCreate) SetSender(s string) *ChatMessageCreate {
+	cmc.mutation.SetSender(s)
+	return cmc
+}
+
+// SetRecipient sets the "recipient" field.
+func (cmc *ChatMessageCreate) SetRecipient(s string) *ChatMessageCreate {
+	cmc.mutation.SetRecipient(s)
+	return cmc
+}
+
+// SetNillableRecipient sets the "recipient" field if the given value is not nil.
+func (cmc *ChatMessageCreate) SetNillableRecipient(s *string) *ChatMessageCreate {
+	if s != nil {
+		cmc.SetRecipient(*s)
+	}
+	return cmc
+}
+
+// SetText sets the "text" field.
+func (cmc *ChatMessageCreate) SetText(s string) *ChatMessageCreate {
+	cmc.mutation.SetText(s)
+	return cmc
+}
+
+// SetDelivered sets the "delivered" field.
+func (cmc *ChatMessageCreate) SetDelivered(b bool) *ChatMessageCreate {
+	cmc.mutation.SetDelivered(b)
+	return cmc
+}
+
+// SetNillableDelivered sets the "delivered" field if the given value is not nil.
+func (cmc *ChatMessageCreate) SetNillableDelivered(b *bool) *ChatMessageCreate {
+	if b != nil {
+		cmc.SetDelivered(*b)
+	}
+	return cmc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cmc *ChatMessageCreate) SetCreatedAt(t time.Time) *ChatMessageCreate {
+	cmc.mutation.SetCreatedAt(t)
+	return cmc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cmc *ChatMessageCreate) SetNillableCreatedAt(t *time.Time) *ChatMessageCreate {
+	if t != nil {
+		cmc.SetCreatedAt(*t)
+	}
+	return cmc
+}
+
+// Mutation returns the ChatMessageMutation object of the builder.
+func (cmc *ChatMessageCreate) Mutation() *ChatMessageMutation {
+	return cmc.mutation
+}
+
+// Save creates the ChatMessage in the database.
+func (cmc *ChatMessageCreate) Save(ctx context.Context) (*ChatMessage, error) {
+	cmc.defaults()
+	return withHooks(ctx, cmc.sqlSave, cmc.mutation, cmc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cmc *ChatMessageCreate) SaveX(ctx context.Context) *ChatMessage {
+	v, err := cmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cmc *ChatMessageCreate) Exec(ctx context.Context) error {
+	_, err := cmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cmc *ChatMessageCreate) ExecX(ctx context.Context) {
+	if err := cmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cmc *ChatMessageCreate) defaults() {
+	if _, ok := cmc.mutation.ZoneID(); !ok {
+		v := chatmessage.DefaultZoneID
+		cmc.mutation.SetZoneID(v)
+	}
+	if _, ok := cmc.mutation.Recipient(); !ok {
+		v := chatmessage.DefaultRecipient
+		cmc.mutation.SetRecipient(v)
+	}
+	if _, ok := cmc.mutation.Delivered(); !ok {
+		v := chatmessage.DefaultDelivered
+		cmc.mutation.SetDelivered(v)
+	}
+	if _, ok := cmc.mutation.CreatedAt(); !ok {
+		v := chatmessage.DefaultCreatedAt()
+		cmc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cmc *ChatMessageCreate) check() error {
+	if _, ok := cmc.mutation.Channel(); !ok {
+		return &ValidationError{Name: "channel", err: errors.New(`ent: missing required field "ChatMessage.channel"`)}
+	}
+	if v, ok := cmc.mutation.Channel(); ok {
+		if err := chatmessage.ChannelValidator(v); err != nil {
+			return &ValidationError{Name: "channel", err: fmt.Errorf(`ent: validator failed for field "ChatMessage.channel": %w`, err)}
+		}
+	}
+	if _, ok := cmc.mutation.ZoneID(); !ok {
+		return &ValidationError{Name: "zone_id", err: errors.New(`ent: missing required field "ChatMessage.zone_id"`)}
+	}
+	if _, ok := cmc.mutation.Sender(); !ok {
+		return &ValidationError{Name: "sender", err: errors.New(`ent: missing required field "ChatMessage.sender"`)}
+	}
+	if _, ok := cmc.mutation.Recipient(); !ok {
+		return &ValidationError{Name: "recipient", err: errors.New(`ent: missing required field "ChatMessage.recipient"`)}
+	}
+	if _, ok := cmc.mutation.Text(); !ok {
+		return &ValidationError{Name: "text", err: errors.New(`ent: missing required field "ChatMessage.text"`)}
+	}
+	if _, ok := cmc.mutation.Delivered(); !ok {
+		return &ValidationError{Name: "delivered", err: errors.New(`ent: missing required field "ChatMessage.delivered"`)}
+	}
+	if _, ok := cmc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ChatMessage.created_at"`)}
+	}
+	return nil
+}
+
+func (cmc *ChatMessageCreate) sqlSave(ctx context.Context) (*ChatMessage, error) {
+	if err := cmc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	cmc.mutation.id = &_node.ID
+	cmc.mutation.done = true
+	return _node, nil
+}
+
+func (cmc *ChatMessageCreate) createSpec() (*ChatMessage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ChatMessage{config: cmc.config}
+		_spec = sqlgraph.NewCreateSpec(chatmessage.Table, sqlgraph.NewFieldSpec(chatmessage.FieldID, field.TypeInt))
+	)
+	if value, ok := cmc.mutation.Channel(); ok {
+		_spec.SetField(chatmessage.FieldChannel, field.TypeString, value)
+		_node.Channel = value
+	}
+	if value, ok := cmc.mutation.ZoneID(); ok {
+		_spec.SetField(chatmessage.FieldZoneID, field.TypeString, value)
+		_node.ZoneID = value
+	}
+	if value, ok := cmc.mutation.Sender(); ok {
+		_spec.SetField(chatmessage.FieldSender, field.TypeString, value)
+		_node.Sender = value
+	}
+	if value, ok := cmc.mutation.Recipient(); ok {
+		_spec.SetField(chatmessage.FieldRecipient, field.TypeString, value)
+		_node.Recipient = value
+	}
+	if value, ok := cmc.mutation.Text(); ok {
+		_spec.SetField(chatmessage.FieldText, field.TypeString, value)
+		_node.Text = value
+	}
+	if value, ok := cmc.mutation.Delivered(); ok {
+		_spec.SetField(chatmessage.FieldDelivered, field.TypeBool, value)
+		_node.Delivered = value
+	}
+	if value, ok := cmc.mutation.CreatedAt(); ok {
+		_spec.SetField(chatmessage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// ChatMessageCreateBulk is the builder for creating many ChatMessage entities in bulk.
+type ChatMessageCreateBulk struct {
+	config
+	err      error
+	builders []*ChatMessageCreate
+}
+
+// Save creates the ChatMessage entities in the database.
+func (cmcb *ChatMessageCreateBulk) Save(ctx context.Context) ([]*ChatMessage, error) {
+	if cmcb.err != nil {
+		return nil, cmcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cmcb.builders))
+	nodes := make([]*ChatMessage, len(cmcb.builders))
+	mutators := make([]Mutator, len(cmcb.builders))
+	for i := range cmcb.builders {
+		func(i int, root context.Context) {
+			builder := cmcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ChatMessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cmcb *ChatMessageCreateBulk) SaveX(ctx context.Context) []*ChatMessage {
+	v, err := cmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cmcb *ChatMessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := cmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cmcb *ChatMessageCreateBulk) ExecX(ctx context.Context) {
+	if err := cmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
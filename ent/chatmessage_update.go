@@ -0,0 +1,405 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/SilverSS/gameserver/ent/chatmessage"
+	"github.com/SilverSS/gameserver/ent/predicate"
+)
+
+// ChatMessageUpdate is the builder for updating ChatMessage entities.
+type ChatMessageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ChatMessageMutation
+}
+
+// Where appends a list predicates to the ChatMessageUpdate builder.
+func (cmu *ChatMessageUpdate) Where(ps ...predicate.ChatMessage) *ChatMessageUpdate {
+	cmu.mutation.Where(ps...)
+	return cmu
+}
+
+// SetChannel sets the "channel" field.
+func (cmu *ChatMessageUpdate) SetChannel(s string) *ChatMessageUpdate {
+	cmu.mutation.SetChannel(s)
+	return cmu
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (cmu *ChatMessageUpdate) SetNillableChannel(s *string) *ChatMessageUpdate {
+	if s != nil {
+		cmu.SetChannel(*s)
+	}
+	return cmu
+}
+
+// SetZoneID sets the "zone_id" field.
+func (cmu *ChatMessageUpdate) SetZoneID(s string) *ChatMessageUpdate {
+	cmu.mutation.SetZoneID(s)
+	return cmu
+}
+
+// SetNillableZoneID sets the "zone_id" field if the given value is not nil.
+func (cmu *ChatMessageUpdate) SetNillableZoneID(s *string) *ChatMessageUpdate {
+	if s != nil {
+		cmu.SetZoneID(*s)
+	}
+	return cmu
+}
+
+// SetSender sets the "sender" field.
+func (cmu *ChatMessageUpdate) SetSender(s string) *ChatMessageUpdate {
+	cmu.mutation.SetSender(s)
+	return cmu
+}
+
+// SetNillableSender sets the "sender" field if the given value is not nil.
+func (cmu *ChatMessageUpdate) SetNillableSender(s *string) *ChatMessageUpdate {
+	if s != nil {
+		cmu.SetSender(*s)
+	}
+	return cmu
+}
+
+// SetRecipient sets the "recipient" field.
+func (cmu *ChatMessageUpdate) SetRecipient(s string) *ChatMessageUpdate {
+	cmu.mutation.SetRecipient(s)
+	return cmu
+}
+
+// SetNillableRecipient sets the "recipient" field if the given value is not nil.
+func (cmu *ChatMessageUpdate) SetNillableRecipient(s *string) *ChatMessageUpdate {
+	if s != nil {
+		cmu.SetRecipient(*s)
+	}
+	return cmu
+}
+
+// SetText sets the "text" field.
+func (cmu *ChatMessageUpdate) SetText(s string) *ChatMessageUpdate {
+	cmu.mutation.SetText(s)
+	return cmu
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (cmu *ChatMessageUpdate) SetNillableText(s *string) *ChatMessageUpdate {
+	if s != nil {
+		cmu.SetText(*s)
+	}
+	return cmu
+}
+
+// SetDelivered sets the "delivered" field.
+func (cmu *ChatMessageUpdate) SetDelivered(b bool) *ChatMessageUpdate {
+	cmu.mutation.SetDelivered(b)
+	return cmu
+}
+
+// SetNillableDelivered sets the "delivered" field if the given value is not nil.
+func (cmu *ChatMessageUpdate) SetNillableDelivered(b *bool) *ChatMessageUpdate {
+	if b != nil {
+		cmu.SetDelivered(*b)
+	}
+	return cmu
+}
+
+// Mutation returns the ChatMessageMutation object of the builder.
+func (cmu *ChatMessageUpdate) Mutation() *ChatMessageMutation {
+	return cmu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cmu *ChatMessageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cmu.sqlSave, cmu.mutation, cmu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cmu *ChatMessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := cmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cmu *ChatMessageUpdate) Exec(ctx context.Context) error {
+	_, err := cmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cmu *ChatMessageUpdate) ExecX(ctx context.Context) {
+	if err := cmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cmu *ChatMessageUpdate) check() error {
+	if v, ok := cmu.mutation.Channel(); ok {
+		if err := chatmessage.ChannelValidator(v); err != nil {
+			return &ValidationError{Name: "channel", err: fmt.Errorf(`ent: validator failed for field "ChatMessage.channel": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cmu *ChatMessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cmu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(chatmessage.Table, chatmessage.Columns, sqlgraph.NewFieldSpec(chatmessage.FieldID, field.TypeInt))
+	if ps := cmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cmu.mutation.Channel(); ok {
+		_spec.SetField(chatmessage.FieldChannel, field.TypeString, value)
+	}
+	if value, ok := cmu.mutation.ZoneID(); ok {
+		_spec.SetField(chatmessage.FieldZoneID, field.TypeString, value)
+	}
+	if value, ok := cmu.mutation.Sender(); ok {
+		_spec.SetField(chatmessage.FieldSender, field.TypeString, value)
+	}
+	if value, ok := cmu.mutation.Recipient(); ok {
+		_spec.SetField(chatmessage.FieldRecipient, field.TypeString, value)
+	}
+	if value, ok := cmu.mutation.Text(); ok {
+		_spec.SetField(chatmessage.FieldText, field.TypeString, value)
+	}
+	if value, ok := cmu.mutation.Delivered(); ok {
+		_spec.SetField(chatmessage.FieldDelivered, field.TypeBool, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chatmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cmu.mutation.done = true
+	return n, nil
+}
+
+// ChatMessageUpdateOne is the builder for updating a single ChatMessage entity.
+type ChatMessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ChatMessageMutation
+}
+
+// SetChannel sets the "channel" field.
+func (cmuo *ChatMessageUpdateOne) SetChannel(s string) *ChatMessageUpdateOne {
+	cmuo.mutation.SetChannel(s)
+	return cmuo
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (cmuo *ChatMessageUpdateOne) SetNillableChannel(s *string) *ChatMessageUpdateOne {
+	if s != nil {
+		cmuo.SetChannel(*s)
+	}
+	return cmuo
+}
+
+// SetZoneID sets the "zone_id" field.
+func (cmuo *ChatMessageUpdateOne) SetZoneID(s string) *ChatMessageUpdateOne {
+	cmuo.mutation.SetZoneID(s)
+	return cmuo
+}
+
+// SetNillableZoneID sets the "zone_id" field if the given value is not nil.
+func (cmuo *ChatMessageUpdateOne) SetNillableZoneID(s *string) *ChatMessageUpdateOne {
+	if s != nil {
+		cmuo.SetZoneID(*s)
+	}
+	return cmuo
+}
+
+// SetSender sets the "sender" field.
+func (cmuo *ChatMessageUpdateOne) SetSender(s string) *ChatMessageUpdateOne {
+	cmuo.mutation.SetSender(s)
+	return cmuo
+}
+
+// SetNillableSender sets the "sender" field if the given value is not nil.
+func (cmuo *ChatMessageUpdateOne) SetNillableSender(s *string) *ChatMessageUpdateOne {
+	if s != nil {
+		cmuo.SetSender(*s)
+	}
+	return cmuo
+}
+
+// SetRecipient sets the "recipient" field.
+func (cmuo *ChatMessageUpdateOne) SetRecipient(s string) *ChatMessageUpdateOne {
+	cmuo.mutation.SetRecipient(s)
+	return cmuo
+}
+
+// SetNillableRecipient sets the "recipient" field if the given value is not nil.
+func (cmuo *ChatMessageUpdateOne) SetNillableRecipient(s *string) *ChatMessageUpdateOne {
+	if s != nil {
+		cmuo.SetRecipient(*s)
+	}
+	return cmuo
+}
+
+// SetText sets the "text" field.
+func (cmuo *ChatMessageUpdateOne) SetText(s string) *ChatMessageUpdateOne {
+	cmuo.mutation.SetText(s)
+	return cmuo
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (cmuo *ChatMessageUpdateOne) SetNillableText(s *string) *ChatMessageUpdateOne {
+	if s != nil {
+		cmuo.SetText(*s)
+	}
+	return cmuo
+}
+
+// SetDelivered sets the "delivered" field.
+func (cmuo *ChatMessageUpdateOne) SetDelivered(b bool) *ChatMessageUpdateOne {
+	cmuo.mutation.SetDelivered(b)
+	return cmuo
+}
+
+// SetNillableDelivered sets the "delivered" field if the given value is not nil.
+func (cmuo *ChatMessageUpdateOne) SetNillableDelivered(b *bool) *ChatMessageUpdateOne {
+	if b != nil {
+		cmuo.SetDelivered(*b)
+	}
+	return cmuo
+}
+
+// Mutation returns the ChatMessageMutation object of the builder.
+func (cmuo *ChatMessageUpdateOne) Mutation() *ChatMessageMutation {
+	return cmuo.mutation
+}
+
+// Where appends a list predicates to the ChatMessageUpdate builder.
+func (cmuo *ChatMessageUpdateOne) Where(ps ...predicate.ChatMessage) *ChatMessageUpdateOne {
+	cmuo.mutation.Where(ps...)
+	return cmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cmuo *ChatMessageUpdateOne) Select(field string, fields ...string) *ChatMessageUpdateOne {
+	cmuo.fields = append([]string{field}, fields...)
+	return cmuo
+}
+
+// Save executes the query and returns the updated ChatMessage entity.
+func (cmuo *ChatMessageUpdateOne) Save(ctx context.Context) (*ChatMessage, error) {
+	return withHooks(ctx, cmuo.sqlSave, cmuo.mutation, cmuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cmuo *ChatMessageUpdateOne) SaveX(ctx context.Context) *ChatMessage {
+	node, err := cmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cmuo *ChatMessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := cmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cmuo *ChatMessageUpdateOne) ExecX(ctx context.Context) {
+	if err := cmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cmuo *ChatMessageUpdateOne) check() error {
+	if v, ok := cmuo.mutation.Channel(); ok {
+		if err := chatmessage.ChannelValidator(v); err != nil {
+			return &ValidationError{Name: "channel", err: fmt.Errorf(`ent: validator failed for field "ChatMessage.channel": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cmuo *ChatMessageUpdateOne) sqlSave(ctx context.Context) (_node *ChatMessage, err error) {
+	if err := cmuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(chatmessage.Table, chatmessage.Columns, sqlgraph.NewFieldSpec(chatmessage.FieldID, field.TypeInt))
+	id, ok := cmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ChatMessage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, chatmessage.FieldID)
+		for _, f := range fields {
+			if !chatmessage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != chatmessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cmuo.mutation.Channel(); ok {
+		_spec.SetField(chatmessage.FieldChannel, field.TypeString, value)
+	}
+	if value, ok := cmuo.mutation.ZoneID(); ok {
+		_spec.SetField(chatmessage.FieldZoneID, field.TypeString, value)
+	}
+	if value, ok := cmuo.mutation.Sender(); ok {
+		_spec.SetField(chatmessage.FieldSender, field.TypeString, value)
+	}
+	if value, ok := cmuo.mutation.Recipient(); ok {
+		_spec.SetField(chatmessage.FieldRecipient, field.TypeString, value)
+	}
+	if value, ok := cmuo.mutation.Text(); ok {
+		_spec.SetField(chatmessage.FieldText, field.TypeString, value)
+	}
+	if value, ok := cmuo.mutation.Delivered(); ok {
+		_spec.SetField(chatmessage.FieldDelivered, field.TypeBool, value)
+	}
+	_node = &ChatMessage{config: cmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chatmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cmuo.mutation.done = true
+	return _node, nil
+}
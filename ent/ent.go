@@ -12,6 +12,11 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/SilverSS/gameserver/ent/character"
+	"github.com/SilverSS/gameserver/ent/chatmessage"
+	"github.com/SilverSS/gameserver/ent/friendship"
+	"github.com/SilverSS/gameserver/ent/guild"
+	"github.com/SilverSS/gameserver/ent/guildmember"
 	"github.com/SilverSS/gameserver/ent/user"
 )
 
@@ -73,7 +78,12 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			user.Table: user.ValidColumn,
+			character.Table:   character.ValidColumn,
+			chatmessage.Table: chatmessage.ValidColumn,
+			friendship.Table:  friendship.ValidColumn,
+			guild.Table:       guild.ValidColumn,
+			guildmember.Table: guildmember.ValidColumn,
+			user.Table:        user.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
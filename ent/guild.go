@@ -0,0 +1,165 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/SilverSS/gameserver/ent/guild"
+)
+
+// Guild is the model entity for the Guild schema.
+type Guild struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// NameKey holds the value of the "name_key" field.
+	NameKey string `json:"name_key,omitempty"`
+	// Motd holds the value of the "motd" field.
+	Motd string `json:"motd,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the GuildQuery when eager-loading is set.
+	Edges        GuildEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// GuildEdges holds the relations/edges for other nodes in the graph.
+type GuildEdges struct {
+	// Members holds the value of the members edge.
+	Members []*GuildMember `json:"members,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// MembersOrErr returns the Members value or an error if the edge
+// was not loaded in eager-loading.
+func (e GuildEdges) MembersOrErr() ([]*GuildMember, error) {
+	if e.loadedTypes[0] {
+		return e.Members, nil
+	}
+	return nil, &NotLoadedError{edge: "members"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Guild) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case guild.FieldID:
+			values[i] = new(sql.NullInt64)
+		case guild.FieldName, guild.FieldNameKey, guild.FieldMotd:
+			values[i] = new(sql.NullString)
+		case guild.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Guild fields.
+func (gu *Guild) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case guild.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			gu.ID = int(value.Int64)
+		case guild.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				gu.Name = value.String
+			}
+		case guild.FieldNameKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name_key", values[i])
+			} else if value.Valid {
+				gu.NameKey = value.String
+			}
+		case guild.FieldMotd:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field motd", values[i])
+			} else if value.Valid {
+				gu.Motd = value.String
+			}
+		case guild.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				gu.CreatedAt = value.Time
+			}
+		default:
+			gu.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Guild.
+// This includes values selected through modifiers, order, etc.
+func (gu *Guild) Value(name string) (ent.Value, error) {
+	return gu.selectValues.Get(name)
+}
+
+// QueryMembers queries the "members" edge of the Guild entity.
+func (gu *Guild) QueryMembers() *GuildMemberQuery {
+	return NewGuildClient(gu.config).QueryMembers(gu)
+}
+
+// Update returns a builder for updating this Guild.
+// Note that you need to call Guild.Unwrap() before calling this method if this Guild
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (gu *Guild) Update() *GuildUpdateOne {
+	return NewGuildClient(gu.config).UpdateOne(gu)
+}
+
+// Unwrap unwraps the Guild entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (gu *Guild) Unwrap() *Guild {
+	_tx, ok := gu.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Guild is not a transactional entity")
+	}
+	gu.config.driver = _tx.drv
+	return gu
+}
+
+// String implements the fmt.Stringer.
+func (gu *Guild) String() string {
+	var builder strings.Builder
+	builder.WriteString("Guild(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", gu.ID))
+	builder.WriteString("name=")
+	builder.WriteString(gu.Name)
+	builder.WriteString(", ")
+	builder.WriteString("name_key=")
+	builder.WriteString(gu.NameKey)
+	builder.WriteString(", ")
+	builder.WriteString("motd=")
+	builder.WriteString(gu.Motd)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(gu.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Guilds is a parsable slice of Guild.
+type Guilds []*Guild
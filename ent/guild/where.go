@@ -0,0 +1,349 @@
+// Code generated by ent, DO NOT EDIT.
+
+package guild
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/SilverSS/gameserver/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Guild {
+	return predicate.Guild(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Guild {
+	return predicate.Guild(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Guild {
+	return predicate.Guild(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Guild {
+	return predicate.Guild(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Guild {
+	return predicate.Guild(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Guild {
+	return predicate.Guild(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Guild {
+	return predicate.Guild(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldName, v))
+}
+
+// NameKey applies equality check predicate on the "name_key" field. It's identical to NameKeyEQ.
+func NameKey(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldNameKey, v))
+}
+
+// Motd applies equality check predicate on the "motd" field. It's identical to MotdEQ.
+func Motd(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldMotd, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Guild {
+	return predicate.Guild(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Guild {
+	return predicate.Guild(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldContainsFold(FieldName, v))
+}
+
+// NameKeyEQ applies the EQ predicate on the "name_key" field.
+func NameKeyEQ(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldNameKey, v))
+}
+
+// NameKeyNEQ applies the NEQ predicate on the "name_key" field.
+func NameKeyNEQ(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldNEQ(FieldNameKey, v))
+}
+
+// NameKeyIn applies the In predicate on the "name_key" field.
+func NameKeyIn(vs ...string) predicate.Guild {
+	return predicate.Guild(sql.FieldIn(FieldNameKey, vs...))
+}
+
+// NameKeyNotIn applies the NotIn predicate on the "name_key" field.
+func NameKeyNotIn(vs ...string) predicate.Guild {
+	return predicate.Guild(sql.FieldNotIn(FieldNameKey, vs...))
+}
+
+// NameKeyGT applies the GT predicate on the "name_key" field.
+func NameKeyGT(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldGT(FieldNameKey, v))
+}
+
+// NameKeyGTE applies the GTE predicate on the "name_key" field.
+func NameKeyGTE(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldGTE(FieldNameKey, v))
+}
+
+// NameKeyLT applies the LT predicate on the "name_key" field.
+func NameKeyLT(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldLT(FieldNameKey, v))
+}
+
+// NameKeyLTE applies the LTE predicate on the "name_key" field.
+func NameKeyLTE(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldLTE(FieldNameKey, v))
+}
+
+// NameKeyContains applies the Contains predicate on the "name_key" field.
+func NameKeyContains(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldContains(FieldNameKey, v))
+}
+
+// NameKeyHasPrefix applies the HasPrefix predicate on the "name_key" field.
+func NameKeyHasPrefix(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldHasPrefix(FieldNameKey, v))
+}
+
+// NameKeyHasSuffix applies the HasSuffix predicate on the "name_key" field.
+func NameKeyHasSuffix(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldHasSuffix(FieldNameKey, v))
+}
+
+// NameKeyEqualFold applies the EqualFold predicate on the "name_key" field.
+func NameKeyEqualFold(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldEqualFold(FieldNameKey, v))
+}
+
+// NameKeyContainsFold applies the ContainsFold predicate on the "name_key" field.
+func NameKeyContainsFold(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldContainsFold(FieldNameKey, v))
+}
+
+// MotdEQ applies the EQ predicate on the "motd" field.
+func MotdEQ(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldMotd, v))
+}
+
+// MotdNEQ applies the NEQ predicate on the "motd" field.
+func MotdNEQ(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldNEQ(FieldMotd, v))
+}
+
+// MotdIn applies the In predicate on the "motd" field.
+func MotdIn(vs ...string) predicate.Guild {
+	return predicate.Guild(sql.FieldIn(FieldMotd, vs...))
+}
+
+// MotdNotIn applies the NotIn predicate on the "motd" field.
+func MotdNotIn(vs ...string) predicate.Guild {
+	return predicate.Guild(sql.FieldNotIn(FieldMotd, vs...))
+}
+
+// MotdGT applies the GT predicate on the "motd" field.
+func MotdGT(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldGT(FieldMotd, v))
+}
+
+// MotdGTE applies the GTE predicate on the "motd" field.
+func MotdGTE(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldGTE(FieldMotd, v))
+}
+
+// MotdLT applies the LT predicate on the "motd" field.
+func MotdLT(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldLT(FieldMotd, v))
+}
+
+// MotdLTE applies the LTE predicate on the "motd" field.
+func MotdLTE(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldLTE(FieldMotd, v))
+}
+
+// MotdContains applies the Contains predicate on the "motd" field.
+func MotdContains(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldContains(FieldMotd, v))
+}
+
+// MotdHasPrefix applies the HasPrefix predicate on the "motd" field.
+func MotdHasPrefix(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldHasPrefix(FieldMotd, v))
+}
+
+// MotdHasSuffix applies the HasSuffix predicate on the "motd" field.
+func MotdHasSuffix(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldHasSuffix(FieldMotd, v))
+}
+
+// MotdEqualFold applies the EqualFold predicate on the "motd" field.
+func MotdEqualFold(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldEqualFold(FieldMotd, v))
+}
+
+// MotdContainsFold applies the ContainsFold predicate on the "motd" field.
+func MotdContainsFold(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldContainsFold(FieldMotd, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Guild {
+	return predicate.Guild(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Guild {
+	return predicate.Guild(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Guild {
+	return predicate.Guild(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Guild {
+	return predicate.Guild(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Guild {
+	return predicate.Guild(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Guild {
+	return predicate.Guild(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Guild {
+	return predicate.Guild(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasMembers applies the HasEdge predicate on the "members" edge.
+func HasMembers() predicate.Guild {
+	return predicate.Guild(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MembersTable, MembersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMembersWith applies the HasEdge predicate on the "members" edge with a given conditions (other predicates).
+func HasMembersWith(preds ...predicate.GuildMember) predicate.Guild {
+	return predicate.Guild(func(s *sql.Selector) {
+		step := newMembersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Guild) predicate.Guild {
+	return predicate.Guild(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Guild) predicate.Guild {
+	return predicate.Guild(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Guild) predicate.Guild {
+	return predicate.Guild(sql.NotPredicates(p))
+}
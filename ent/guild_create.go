@@ -0,0 +1,295 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/SilverSS/gameserver/ent/guild"
+	"github.com/SilverSS/gameserver/ent/guildmember"
+)
+
+// GuildCreate is the builder for creating a Guild entity.
+type GuildCreate struct {
+	config
+	mutation *GuildMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (gc *GuildCreate) SetName(s string) *GuildCreate {
+	gc.mutation.SetName(s)
+	return gc
+}
+
+// SetNameKey sets the "name_key" field.
+func (gc *GuildCreate) SetNameKey(s string) *GuildCreate {
+	gc.mutation.SetNameKey(s)
+	return gc
+}
+
+// SetMotd sets the "motd" field.
+func (gc *GuildCreate) SetMotd(s string) *GuildCreate {
+	gc.mutation.SetMotd(s)
+	return gc
+}
+
+// SetNillableMotd sets the "motd" field if the given value is not nil.
+func (gc *GuildCreate) SetNillableMotd(s *string) *GuildCreate {
+	if s != nil {
+		gc.SetMotd(*s)
+	}
+	return gc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (gc *GuildCreate) SetCreatedAt(t time.Time) *GuildCreate {
+	gc.mutation.SetCreatedAt(t)
+	return gc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (gc *GuildCreate) SetNillableCreatedAt(t *time.Time) *GuildCreate {
+	if t != nil {
+		gc.SetCreatedAt(*t)
+	}
+	return gc
+}
+
+// AddMemberIDs adds the "members" edge to the GuildMember entity by IDs.
+func (gc *GuildCreate) AddMemberIDs(ids ...int) *GuildCreate {
+	gc.mutation.AddMemberIDs(ids...)
+	return gc
+}
+
+// AddMembers adds the "members" edges to the GuildMember entity.
+func (gc *GuildCreate) AddMembers(g ...*GuildMember) *GuildCreate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return gc.AddMemberIDs(ids...)
+}
+
+// Mutation returns the GuildMutation object of the builder.
+func (gc *GuildCreate) Mutation() *GuildMutation {
+	return gc.mutation
+}
+
+// Save creates the Guild in the database.
+func (gc *GuildCreate) Save(ctx context.Context) (*Guild, error) {
+	gc.defaults()
+	return withHooks(ctx, gc.sqlSave, gc.mutation, gc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (gc *GuildCreate) SaveX(ctx context.Context) *Guild {
+	v, err := gc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gc *GuildCreate) Exec(ctx context.Context) error {
+	_, err := gc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gc *GuildCreate) ExecX(ctx context.Context) {
+	if err := gc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gc *GuildCreate) defaults() {
+	if _, ok := gc.mutation.Motd(); !ok {
+		v := guild.DefaultMotd
+		gc.mutation.SetMotd(v)
+	}
+	if _, ok := gc.mutation.CreatedAt(); !ok {
+		v := guild.DefaultCreatedAt()
+		gc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gc *GuildCreate) check() error {
+	if _, ok := gc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Guild.name"`)}
+	}
+	if v, ok := gc.mutation.Name(); ok {
+		if err := guild.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Guild.name": %w`, err)}
+		}
+	}
+	if _, ok := gc.mutation.NameKey(); !ok {
+		return &ValidationError{Name: "name_key", err: errors.New(`ent: missing required field "Guild.name_key"`)}
+	}
+	if v, ok := gc.mutation.NameKey(); ok {
+		if err := guild.NameKeyValidator(v); err != nil {
+			return &ValidationError{Name: "name_key", err: fmt.Errorf(`ent: validator failed for field "Guild.name_key": %w`, err)}
+		}
+	}
+	if _, ok := gc.mutation.Motd(); !ok {
+		return &ValidationError{Name: "motd", err: errors.New(`ent: missing required field "Guild.motd"`)}
+	}
+	if _, ok := gc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Guild.created_at"`)}
+	}
+	return nil
+}
+
+func (gc *GuildCreate) sqlSave(ctx context.Context) (*Guild, error) {
+	if err := gc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := gc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, gc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	gc.mutation.id = &_node.ID
+	gc.mutation.done = true
+	return _node, nil
+}
+
+func (gc *GuildCreate) createSpec() (*Guild, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Guild{config: gc.config}
+		_spec = sqlgraph.NewCreateSpec(guild.Table, sqlgraph.NewFieldSpec(guild.FieldID, field.TypeInt))
+	)
+	if value, ok := gc.mutation.Name(); ok {
+		_spec.SetField(guild.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := gc.mutation.NameKey(); ok {
+		_spec.SetField(guild.FieldNameKey, field.TypeString, value)
+		_node.NameKey = value
+	}
+	if value, ok := gc.mutation.Motd(); ok {
+		_spec.SetField(guild.FieldMotd, field.TypeString, value)
+		_node.Motd = value
+	}
+	if value, ok := gc.mutation.CreatedAt(); ok {
+		_spec.SetField(guild.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := gc.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.MembersTable,
+			Columns: []string{guild.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guildmember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// GuildCreateBulk is the builder for creating many Guild entities in bulk.
+type GuildCreateBulk struct {
+	config
+	err      error
+	builders []*GuildCreate
+}
+
+// Save creates the Guild entities in the database.
+func (gcb *GuildCreateBulk) Save(ctx context.Context) ([]*Guild, error) {
+	if gcb.err != nil {
+		return nil, gcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(gcb.builders))
+	nodes := make([]*Guild, len(gcb.builders))
+	mutators := make([]Mutator, len(gcb.builders))
+	for i := range gcb.builders {
+		func(i int, root context.Context) {
+			builder := gcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GuildMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, gcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, gcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, gcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gcb *GuildCreateBulk) SaveX(ctx context.Context) []*Guild {
+	v, err := gcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gcb *GuildCreateBulk) Exec(ctx context.Context) error {
+	_, err := gcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gcb *GuildCreateBulk) ExecX(ctx context.Context) {
+	if err := gcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,432 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/SilverSS/gameserver/ent/guild"
+	"github.com/SilverSS/gameserver/ent/guildmember"
+	"github.com/SilverSS/gameserver/ent/predicate"
+)
+
+// GuildUpdate is the builder for updating Guild entities.
+type GuildUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GuildMutation
+}
+
+// Where appends a list predicates to the GuildUpdate builder.
+func (gu *GuildUpdate) Where(ps ...predicate.Guild) *GuildUpdate {
+	gu.mutation.Where(ps...)
+	return gu
+}
+
+// SetName sets the "name" field.
+func (gu *GuildUpdate) SetName(s string) *GuildUpdate {
+	gu.mutation.SetName(s)
+	return gu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (gu *GuildUpdate) SetNillableName(s *string) *GuildUpdate {
+	if s != nil {
+		gu.SetName(*s)
+	}
+	return gu
+}
+
+// SetMotd sets the "motd" field.
+func (gu *GuildUpdate) SetMotd(s string) *GuildUpdate {
+	gu.mutation.SetMotd(s)
+	return gu
+}
+
+// SetNillableMotd sets the "motd" field if the given value is not nil.
+func (gu *GuildUpdate) SetNillableMotd(s *string) *GuildUpdate {
+	if s != nil {
+		gu.SetMotd(*s)
+	}
+	return gu
+}
+
+// AddMemberIDs adds the "members" edge to the GuildMember entity by IDs.
+func (gu *GuildUpdate) AddMemberIDs(ids ...int) *GuildUpdate {
+	gu.mutation.AddMemberIDs(ids...)
+	return gu
+}
+
+// AddMembers adds the "members" edges to the GuildMember entity.
+func (gu *GuildUpdate) AddMembers(g ...*GuildMember) *GuildUpdate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return gu.AddMemberIDs(ids...)
+}
+
+// Mutation returns the GuildMutation object of the builder.
+func (gu *GuildUpdate) Mutation() *GuildMutation {
+	return gu.mutation
+}
+
+// ClearMembers clears all "members" edges to the GuildMember entity.
+func (gu *GuildUpdate) ClearMembers() *GuildUpdate {
+	gu.mutation.ClearMembers()
+	return gu
+}
+
+// RemoveMemberIDs removes the "members" edge to GuildMember entities by IDs.
+func (gu *GuildUpdate) RemoveMemberIDs(ids ...int) *GuildUpdate {
+	gu.mutation.RemoveMemberIDs(ids...)
+	return gu
+}
+
+// RemoveMembers removes "members" edges to GuildMember entities.
+func (gu *GuildUpdate) RemoveMembers(g ...*GuildMember) *GuildUpdate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return gu.RemoveMemberIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (gu *GuildUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, gu.sqlSave, gu.mutation, gu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gu *GuildUpdate) SaveX(ctx context.Context) int {
+	affected, err := gu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (gu *GuildUpdate) Exec(ctx context.Context) error {
+	_, err := gu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gu *GuildUpdate) ExecX(ctx context.Context) {
+	if err := gu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gu *GuildUpdate) check() error {
+	if v, ok := gu.mutation.Name(); ok {
+		if err := guild.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Guild.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (gu *GuildUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := gu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(guild.Table, guild.Columns, sqlgraph.NewFieldSpec(guild.FieldID, field.TypeInt))
+	if ps := gu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gu.mutation.Name(); ok {
+		_spec.SetField(guild.FieldName, field.TypeString, value)
+	}
+	if value, ok := gu.mutation.Motd(); ok {
+		_spec.SetField(guild.FieldMotd, field.TypeString, value)
+	}
+	if gu.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.MembersTable,
+			Columns: []string{guild.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guildmember.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedMembersIDs(); len(nodes) > 0 && !gu.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.MembersTable,
+			Columns: []string{guild.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guildmember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.MembersTable,
+			Columns: []string{guild.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guildmember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, gu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{guild.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	gu.mutation.done = true
+	return n, nil
+}
+
+// GuildUpdateOne is the builder for updating a single Guild entity.
+type GuildUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GuildMutation
+}
+
+// SetName sets the "name" field.
+func (guo *GuildUpdateOne) SetName(s string) *GuildUpdateOne {
+	guo.mutation.SetName(s)
+	return guo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (guo *GuildUpdateOne) SetNillableName(s *string) *GuildUpdateOne {
+	if s != nil {
+		guo.SetName(*s)
+	}
+	return guo
+}
+
+// SetMotd sets the "motd" field.
+func (guo *GuildUpdateOne) SetMotd(s string) *GuildUpdateOne {
+	guo.mutation.SetMotd(s)
+	return guo
+}
+
+// SetNillableMotd sets the "motd" field if the given value is not nil.
+func (guo *GuildUpdateOne) SetNillableMotd(s *string) *GuildUpdateOne {
+	if s != nil {
+		guo.SetMotd(*s)
+	}
+	return guo
+}
+
+// AddMemberIDs adds the "members" edge to the GuildMember entity by IDs.
+func (guo *GuildUpdateOne) AddMemberIDs(ids ...int) *GuildUpdateOne {
+	guo.mutation.AddMemberIDs(ids...)
+	return guo
+}
+
+// AddMembers adds the "members" edges to the GuildMember entity.
+func (guo *GuildUpdateOne) AddMembers(g ...*GuildMember) *GuildUpdateOne {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return guo.AddMemberIDs(ids...)
+}
+
+// Mutation returns the GuildMutation object of the builder.
+func (guo *GuildUpdateOne) Mutation() *GuildMutation {
+	return guo.mutation
+}
+
+// ClearMembers clears all "members" edges to the GuildMember entity.
+func (guo *GuildUpdateOne) ClearMembers() *GuildUpdateOne {
+	guo.mutation.ClearMembers()
+	return guo
+}
+
+// RemoveMemberIDs removes the "members" edge to GuildMember entities by IDs.
+func (guo *GuildUpdateOne) RemoveMemberIDs(ids ...int) *GuildUpdateOne {
+	guo.mutation.RemoveMemberIDs(ids...)
+	return guo
+}
+
+// RemoveMembers removes "members" edges to GuildMember entities.
+func (guo *GuildUpdateOne) RemoveMembers(g ...*GuildMember) *GuildUpdateOne {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return guo.RemoveMemberIDs(ids...)
+}
+
+// Where appends a list predicates to the GuildUpdate builder.
+func (guo *GuildUpdateOne) Where(ps ...predicate.Guild) *GuildUpdateOne {
+	guo.mutation.Where(ps...)
+	return guo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (guo *GuildUpdateOne) Select(field string, fields ...string) *GuildUpdateOne {
+	guo.fields = append([]string{field}, fields...)
+	return guo
+}
+
+// Save executes the query and returns the updated Guild entity.
+func (guo *GuildUpdateOne) Save(ctx context.Context) (*Guild, error) {
+	return withHooks(ctx, guo.sqlSave, guo.mutation, guo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (guo *GuildUpdateOne) SaveX(ctx context.Context) *Guild {
+	node, err := guo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (guo *GuildUpdateOne) Exec(ctx context.Context) error {
+	_, err := guo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (guo *GuildUpdateOne) ExecX(ctx context.Context) {
+	if err := guo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (guo *GuildUpdateOne) check() error {
+	if v, ok := guo.mutation.Name(); ok {
+		if err := guild.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Guild.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (guo *GuildUpdateOne) sqlSave(ctx context.Context) (_node *Guild, err error) {
+	if err := guo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(guild.Table, guild.Columns, sqlgraph.NewFieldSpec(guild.FieldID, field.TypeInt))
+	id, ok := guo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Guild.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := guo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, guild.FieldID)
+		for _, f := range fields {
+			if !guild.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != guild.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := guo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := guo.mutation.Name(); ok {
+		_spec.SetField(guild.FieldName, field.TypeString, value)
+	}
+	if value, ok := guo.mutation.Motd(); ok {
+		_spec.SetField(guild.FieldMotd, field.TypeString, value)
+	}
+	if guo.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.MembersTable,
+			Columns: []string{guild.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guildmember.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedMembersIDs(); len(nodes) > 0 && !guo.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.MembersTable,
+			Columns: []string{guild.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guildmember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.MembersTable,
+			Columns: []string{guild.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guildmember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Guild{config: guo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, guo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{guild.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	guo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,187 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/SilverSS/gameserver/ent/guild"
+	"github.com/SilverSS/gameserver/ent/guildmember"
+	"github.com/SilverSS/gameserver/ent/user"
+)
+
+// GuildMember is the model entity for the GuildMember schema.
+type GuildMember struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// GuildID holds the value of the "guild_id" field.
+	GuildID int `json:"guild_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Rank holds the value of the "rank" field.
+	Rank guildmember.Rank `json:"rank,omitempty"`
+	// JoinedAt holds the value of the "joined_at" field.
+	JoinedAt time.Time `json:"joined_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the GuildMemberQuery when eager-loading is set.
+	Edges        GuildMemberEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// GuildMemberEdges holds the relations/edges for other nodes in the graph.
+type GuildMemberEdges struct {
+	// Guild holds the value of the guild edge.
+	Guild *Guild `json:"guild,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// GuildOrErr returns the Guild value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GuildMemberEdges) GuildOrErr() (*Guild, error) {
+	if e.Guild != nil {
+		return e.Guild, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: guild.Label}
+	}
+	return nil, &NotLoadedError{edge: "guild"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GuildMemberEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GuildMember) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case guildmember.FieldID, guildmember.FieldGuildID, guildmember.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case guildmember.FieldRank:
+			values[i] = new(sql.NullString)
+		case guildmember.FieldJoinedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GuildMember fields.
+func (gm *GuildMember) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case guildmember.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			gm.ID = int(value.Int64)
+		case guildmember.FieldGuildID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field guild_id", values[i])
+			} else if value.Valid {
+				gm.GuildID = int(value.Int64)
+			}
+		case guildmember.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				gm.UserID = int(value.Int64)
+			}
+		case guildmember.FieldRank:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rank", values[i])
+			} else if value.Valid {
+				gm.Rank = guildmember.Rank(value.String)
+			}
+		case guildmember.FieldJoinedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field joined_at", values[i])
+			} else if value.Valid {
+				gm.JoinedAt = value.Time
+			}
+		default:
+			gm.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GuildMember.
+// This includes values selected through modifiers, order, etc.
+func (gm *GuildMember) Value(name string) (ent.Value, error) {
+	return gm.selectValues.Get(name)
+}
+
+// QueryGuild queries the "guild" edge of the GuildMember entity.
+func (gm *GuildMember) QueryGuild() *GuildQuery {
+	return NewGuildMemberClient(gm.config).QueryGuild(gm)
+}
+
+// QueryUser queries the "user" edge of the GuildMember entity.
+func (gm *GuildMember) QueryUser() *UserQuery {
+	return NewGuildMemberClient(gm.config).QueryUser(gm)
+}
+
+// Update returns a builder for updating this GuildMember.
+// Note that you need to call GuildMember.Unwrap() before calling this method if this GuildMember
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (gm *GuildMember) Update() *GuildMemberUpdateOne {
+	return NewGuildMemberClient(gm.config).UpdateOne(gm)
+}
+
+// Unwrap unwraps the GuildMember entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (gm *GuildMember) Unwrap() *GuildMember {
+	_tx, ok := gm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GuildMember is not a transactional entity")
+	}
+	gm.config.driver = _tx.drv
+	return gm
+}
+
+// String implements the fmt.Stringer.
+func (gm *GuildMember) String() string {
+	var builder strings.Builder
+	builder.WriteString("GuildMember(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", gm.ID))
+	builder.WriteString("guild_id=")
+	builder.WriteString(fmt.Sprintf("%v", gm.GuildID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", gm.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("rank=")
+	builder.WriteString(fmt.Sprintf("%v", gm.Rank))
+	builder.WriteString(", ")
+	builder.WriteString("joined_at=")
+	builder.WriteString(gm.JoinedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GuildMembers is a parsable slice of GuildMember.
+type GuildMembers []*GuildMember
@@ -0,0 +1,302 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/SilverSS/gameserver/ent/guild"
+	"github.com/SilverSS/gameserver/ent/guildmember"
+	"github.com/SilverSS/gameserver/ent/user"
+)
+
+// GuildMemberCreate is the builder for creating a GuildMember entity.
+type GuildMemberCreate struct {
+	config
+	mutation *GuildMemberMutation
+	hooks    []Hook
+}
+
+// SetGuildID sets the "guild_id" field.
+func (gmc *GuildMemberCreate) SetGuildID(i int) *GuildMemberCreate {
+	gmc.mutation.SetGuildID(i)
+	return gmc
+}
+
+// SetUserID sets the "user_id" field.
+func (gmc *GuildMemberCreate) SetUserID(i int) *GuildMemberCreate {
+	gmc.mutation.SetUserID(i)
+	return gmc
+}
+
+// SetRank sets the "rank" field.
+func (gmc *GuildMemberCreate) SetRank(gu guildmember.Rank) *GuildMemberCreate {
+	gmc.mutation.SetRank(gu)
+	return gmc
+}
+
+// SetNillableRank sets the "rank" field if the given value is not nil.
+func (gmc *GuildMemberCreate) SetNillableRank(gu *guildmember.Rank) *GuildMemberCreate {
+	if gu != nil {
+		gmc.SetRank(*gu)
+	}
+	return gmc
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (gmc *GuildMemberCreate) SetJoinedAt(t time.Time) *GuildMemberCreate {
+	gmc.mutation.SetJoinedAt(t)
+	return gmc
+}
+
+// SetNillableJoinedAt sets the "joined_at" field if the given value is not nil.
+func (gmc *GuildMemberCreate) SetNillableJoinedAt(t *time.Time) *GuildMemberCreate {
+	if t != nil {
+		gmc.SetJoinedAt(*t)
+	}
+	return gmc
+}
+
+// SetGuild sets the "guild" edge to the Guild entity.
+func (gmc *GuildMemberCreate) SetGuild(g *Guild) *GuildMemberCreate {
+	return gmc.SetGuildID(g.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (gmc *GuildMemberCreate) SetUser(u *User) *GuildMemberCreate {
+	return gmc.SetUserID(u.ID)
+}
+
+// Mutation returns the GuildMemberMutation object of the builder.
+func (gmc *GuildMemberCreate) Mutation() *GuildMemberMutation {
+	return gmc.mutation
+}
+
+// Save creates the GuildMember in the database.
+func (gmc *GuildMemberCreate) Save(ctx context.Context) (*GuildMember, error) {
+	gmc.defaults()
+	return withHooks(ctx, gmc.sqlSave, gmc.mutation, gmc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (gmc *GuildMemberCreate) SaveX(ctx context.Context) *GuildMember {
+	v, err := gmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gmc *GuildMemberCreate) Exec(ctx context.Context) error {
+	_, err := gmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gmc *GuildMemberCreate) ExecX(ctx context.Context) {
+	if err := gmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gmc *GuildMemberCreate) defaults() {
+	if _, ok := gmc.mutation.Rank(); !ok {
+		v := guildmember.DefaultRank
+		gmc.mutation.SetRank(v)
+	}
+	if _, ok := gmc.mutation.JoinedAt(); !ok {
+		v := guildmember.DefaultJoinedAt()
+		gmc.mutation.SetJoinedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gmc *GuildMemberCreate) check() error {
+	if _, ok := gmc.mutation.GuildID(); !ok {
+		return &ValidationError{Name: "guild_id", err: errors.New(`ent: missing required field "GuildMember.guild_id"`)}
+	}
+	if _, ok := gmc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "GuildMember.user_id"`)}
+	}
+	if _, ok := gmc.mutation.Rank(); !ok {
+		return &ValidationError{Name: "rank", err: errors.New(`ent: missing required field "GuildMember.rank"`)}
+	}
+	if v, ok := gmc.mutation.Rank(); ok {
+		if err := guildmember.RankValidator(v); err != nil {
+			return &ValidationError{Name: "rank", err: fmt.Errorf(`ent: validator failed for field "GuildMember.rank": %w`, err)}
+		}
+	}
+	if _, ok := gmc.mutation.JoinedAt(); !ok {
+		return &ValidationError{Name: "joined_at", err: errors.New(`ent: missing required field "GuildMember.joined_at"`)}
+	}
+	if len(gmc.mutation.GuildIDs()) == 0 {
+		return &ValidationError{Name: "guild", err: errors.New(`ent: missing required edge "GuildMember.guild"`)}
+	}
+	if len(gmc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "GuildMember.user"`)}
+	}
+	return nil
+}
+
+func (gmc *GuildMemberCreate) sqlSave(ctx context.Context) (*GuildMember, error) {
+	if err := gmc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := gmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, gmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	gmc.mutation.id = &_node.ID
+	gmc.mutation.done = true
+	return _node, nil
+}
+
+func (gmc *GuildMemberCreate) createSpec() (*GuildMember, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GuildMember{config: gmc.config}
+		_spec = sqlgraph.NewCreateSpec(guildmember.Table, sqlgraph.NewFieldSpec(guildmember.FieldID, field.TypeInt))
+	)
+	if value, ok := gmc.mutation.Rank(); ok {
+		_spec.SetField(guildmember.FieldRank, field.TypeEnum, value)
+		_node.Rank = value
+	}
+	if value, ok := gmc.mutation.JoinedAt(); ok {
+		_spec.SetField(guildmember.FieldJoinedAt, field.TypeTime, value)
+		_node.JoinedAt = value
+	}
+	if nodes := gmc.mutation.GuildIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   guildmember.GuildTable,
+			Columns: []string{guildmember.GuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guild.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GuildID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gmc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   guildmember.UserTable,
+			Columns: []string{guildmember.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// GuildMemberCreateBulk is the builder for creating many GuildMember entities in bulk.
+type GuildMemberCreateBulk struct {
+	config
+	err      error
+	builders []*GuildMemberCreate
+}
+
+// Save creates the GuildMember entities in the database.
+func (gmcb *GuildMemberCreateBulk) Save(ctx context.Context) ([]*GuildMember, error) {
+	if gmcb.err != nil {
+		return nil, gmcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(gmcb.builders))
+	nodes := make([]*GuildMember, len(gmcb.builders))
+	mutators := make([]Mutator, len(gmcb.builders))
+	for i := range gmcb.builders {
+		func(i int, root context.Context) {
+			builder := gmcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GuildMemberMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, gmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, gmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, gmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gmcb *GuildMemberCreateBulk) SaveX(ctx context.Context) []*GuildMember {
+	v, err := gmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gmcb *GuildMemberCreateBulk) Exec(ctx context.Context) error {
+	_, err := gmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gmcb *GuildMemberCreateBulk) ExecX(ctx context.Context) {
+	if err := gmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
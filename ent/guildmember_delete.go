@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/SilverSS/gameserver/ent/guildmember"
+	"github.com/SilverSS/gameserver/ent/predicate"
+)
+
+// GuildMemberDelete is the builder for deleting a GuildMember entity.
+type GuildMemberDelete struct {
+	config
+	hooks    []Hook
+	mutation *GuildMemberMutation
+}
+
+// Where appends a list predicates to the GuildMemberDelete builder.
+func (gmd *GuildMemberDelete) Where(ps ...predicate.GuildMember) *GuildMemberDelete {
+	gmd.mutation.Where(ps...)
+	return gmd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (gmd *GuildMemberDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, gmd.sqlExec, gmd.mutation, gmd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gmd *GuildMemberDelete) ExecX(ctx context.Context) int {
+	n, err := gmd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (gmd *GuildMemberDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(guildmember.Table, sqlgraph.NewFieldSpec(guildmember.FieldID, field.TypeInt))
+	if ps := gmd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, gmd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	gmd.mutation.done = true
+	return affected, err
+}
+
+// GuildMemberDeleteOne is the builder for deleting a single GuildMember entity.
+type GuildMemberDeleteOne struct {
+	gmd *GuildMemberDelete
+}
+
+// Where appends a list predicates to the GuildMemberDelete builder.
+func (gmdo *GuildMemberDeleteOne) Where(ps ...predicate.GuildMember) *GuildMemberDeleteOne {
+	gmdo.gmd.mutation.Where(ps...)
+	return gmdo
+}
+
+// Exec executes the deletion query.
+func (gmdo *GuildMemberDeleteOne) Exec(ctx context.Context) error {
+	n, err := gmdo.gmd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{guildmember.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gmdo *GuildMemberDeleteOne) ExecX(ctx context.Context) {
+	if err := gmdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,681 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/SilverSS/gameserver/ent/guild"
+	"github.com/SilverSS/gameserver/ent/guildmember"
+	"github.com/SilverSS/gameserver/ent/predicate"
+	"github.com/SilverSS/gameserver/ent/user"
+)
+
+// GuildMemberQuery is the builder for querying GuildMember entities.
+type GuildMemberQuery struct {
+	config
+	ctx        *QueryContext
+	order      []guildmember.OrderOption
+	inters     []Interceptor
+	predicates []predicate.GuildMember
+	withGuild  *GuildQuery
+	withUser   *UserQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the GuildMemberQuery builder.
+func (gmq *GuildMemberQuery) Where(ps ...predicate.GuildMember) *GuildMemberQuery {
+	gmq.predicates = append(gmq.predicates, ps...)
+	return gmq
+}
+
+// Limit the number of records to be returned by this query.
+func (gmq *GuildMemberQuery) Limit(limit int) *GuildMemberQuery {
+	gmq.ctx.Limit = &limit
+	return gmq
+}
+
+// Offset to start from.
+func (gmq *GuildMemberQuery) Offset(offset int) *GuildMemberQuery {
+	gmq.ctx.Offset = &offset
+	return gmq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (gmq *GuildMemberQuery) Unique(unique bool) *GuildMemberQuery {
+	gmq.ctx.Unique = &unique
+	return gmq
+}
+
+// Order specifies how the records should be ordered.
+func (gmq *GuildMemberQuery) Order(o ...guildmember.OrderOption) *GuildMemberQuery {
+	gmq.order = append(gmq.order, o...)
+	return gmq
+}
+
+// QueryGuild chains the current query on the "guild" edge.
+func (gmq *GuildMemberQuery) QueryGuild() *GuildQuery {
+	query := (&GuildClient{config: gmq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gmq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(guildmember.Table, guildmember.FieldID, selector),
+			sqlgraph.To(guild.Table, guild.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, guildmember.GuildTable, guildmember.GuildColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gmq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (gmq *GuildMemberQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: gmq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gmq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(guildmember.Table, guildmember.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, guildmember.UserTable, guildmember.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gmq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first GuildMember entity from the query.
+// Returns a *NotFoundError when no GuildMember was found.
+func (gmq *GuildMemberQuery) First(ctx context.Context) (*GuildMember, error) {
+	nodes, err := gmq.Limit(1).All(setContextOp(ctx, gmq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{guildmember.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (gmq *GuildMemberQuery) FirstX(ctx context.Context) *GuildMember {
+	node, err := gmq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first GuildMember ID from the query.
+// Returns a *NotFoundError when no GuildMember ID was found.
+func (gmq *GuildMemberQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = gmq.Limit(1).IDs(setContextOp(ctx, gmq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{guildmember.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (gmq *GuildMemberQuery) FirstIDX(ctx context.Context) int {
+	id, err := gmq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single GuildMember entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one GuildMember entity is found.
+// Returns a *NotFoundError when no GuildMember entities are found.
+func (gmq *GuildMemberQuery) Only(ctx context.Context) (*GuildMember, error) {
+	nodes, err := gmq.Limit(2).All(setContextOp(ctx, gmq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{guildmember.Label}
+	default:
+		return nil, &NotSingularError{guildmember.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (gmq *GuildMemberQuery) OnlyX(ctx context.Context) *GuildMember {
+	node, err := gmq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only GuildMember ID in the query.
+// Returns a *NotSingularError when more than one GuildMember ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (gmq *GuildMemberQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = gmq.Limit(2).IDs(setContextOp(ctx, gmq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{guildmember.Label}
+	default:
+		err = &NotSingularError{guildmember.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (gmq *GuildMemberQuery) OnlyIDX(ctx context.Context) int {
+	id, err := gmq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of GuildMembers.
+func (gmq *GuildMemberQuery) All(ctx context.Context) ([]*GuildMember, error) {
+	ctx = setContextOp(ctx, gmq.ctx, ent.OpQueryAll)
+	if err := gmq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*GuildMember, *GuildMemberQuery]()
+	return withInterceptors[[]*GuildMember](ctx, gmq, qr, gmq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (gmq *GuildMemberQuery) AllX(ctx context.Context) []*GuildMember {
+	nodes, err := gmq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of GuildMember IDs.
+func (gmq *GuildMemberQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if gmq.ctx.Unique == nil && gmq.path != nil {
+		gmq.Unique(true)
+	}
+	ctx = setContextOp(ctx, gmq.ctx, ent.OpQueryIDs)
+	if err = gmq.Select(guildmember.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (gmq *GuildMemberQuery) IDsX(ctx context.Context) []int {
+	ids, err := gmq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (gmq *GuildMemberQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, gmq.ctx, ent.OpQueryCount)
+	if err := gmq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, gmq, querierCount[*GuildMemberQuery](), gmq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (gmq *GuildMemberQuery) CountX(ctx context.Context) int {
+	count, err := gmq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (gmq *GuildMemberQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, gmq.ctx, ent.OpQueryExist)
+	switch _, err := gmq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (gmq *GuildMemberQuery) ExistX(ctx context.Context) bool {
+	exist, err := gmq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the GuildMemberQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (gmq *GuildMemberQuery) Clone() *GuildMemberQuery {
+	if gmq == nil {
+		return nil
+	}
+	return &GuildMemberQuery{
+		config:     gmq.config,
+		ctx:        gmq.ctx.Clone(),
+		order:      append([]guildmember.OrderOption{}, gmq.order...),
+		inters:     append([]Interceptor{}, gmq.inters...),
+		predicates: append([]predicate.GuildMember{}, gmq.predicates...),
+		withGuild:  gmq.withGuild.Clone(),
+		withUser:   gmq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  gmq.sql.Clone(),
+		path: gmq.path,
+	}
+}
+
+// WithGuild tells the query-builder to eager-load the nodes that are connected to
+// the "guild" edge. The optional arguments are used to configure the query builder of the edge.
+func (gmq *GuildMemberQuery) WithGuild(opts ...func(*GuildQuery)) *GuildMemberQuery {
+	query := (&GuildClient{config: gmq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gmq.withGuild = query
+	return gmq
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (gmq *GuildMemberQuery) WithUser(opts ...func(*UserQuery)) *GuildMemberQuery {
+	query := (&UserClient{config: gmq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gmq.withUser = query
+	return gmq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		GuildID int `json:"guild_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.GuildMember.Query().
+//		GroupBy(guildmember.FieldGuildID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (gmq *GuildMemberQuery) GroupBy(field string, fields ...string) *GuildMemberGroupBy {
+	gmq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &GuildMemberGroupBy{build: gmq}
+	grbuild.flds = &gmq.ctx.Fields
+	grbuild.label = guildmember.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		GuildID int `json:"guild_id,omitempty"`
+//	}
+//
+//	client.GuildMember.Query().
+//		Select(guildmember.FieldGuildID).
+//		Scan(ctx, &v)
+func (gmq *GuildMemberQuery) Select(fields ...string) *GuildMemberSelect {
+	gmq.ctx.Fields = append(gmq.ctx.Fields, fields...)
+	sbuild := &GuildMemberSelect{GuildMemberQuery: gmq}
+	sbuild.label = guildmember.Label
+	sbuild.flds, sbuild.scan = &gmq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a GuildMemberSelect configured with the given aggregations.
+func (gmq *GuildMemberQuery) Aggregate(fns ...AggregateFunc) *GuildMemberSelect {
+	return gmq.Select().Aggregate(fns...)
+}
+
+func (gmq *GuildMemberQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range gmq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, gmq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range gmq.ctx.Fields {
+		if !guildmember.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if gmq.path != nil {
+		prev, err := gmq.path(ctx)
+		if err != nil {
+			return err
+		}
+		gmq.sql = prev
+	}
+	return nil
+}
+
+func (gmq *GuildMemberQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*GuildMember, error) {
+	var (
+		nodes       = []*GuildMember{}
+		_spec       = gmq.querySpec()
+		loadedTypes = [2]bool{
+			gmq.withGuild != nil,
+			gmq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*GuildMember).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &GuildMember{config: gmq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, gmq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := gmq.withGuild; query != nil {
+		if err := gmq.loadGuild(ctx, query, nodes, nil,
+			func(n *GuildMember, e *Guild) { n.Edges.Guild = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := gmq.withUser; query != nil {
+		if err := gmq.loadUser(ctx, query, nodes, nil,
+			func(n *GuildMember, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (gmq *GuildMemberQuery) loadGuild(ctx context.Context, query *GuildQuery, nodes []*GuildMember, init func(*GuildMember), assign func(*GuildMember, *Guild)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*GuildMember)
+	for i := range nodes {
+		fk := nodes[i].GuildID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(guild.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "guild_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (gmq *GuildMemberQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*GuildMember, init func(*GuildMember), assign func(*GuildMember, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*GuildMember)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (gmq *GuildMemberQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := gmq.querySpec()
+	_spec.Node.Columns = gmq.ctx.Fields
+	if len(gmq.ctx.Fields) > 0 {
+		_spec.Unique = gmq.ctx.Unique != nil && *gmq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, gmq.driver, _spec)
+}
+
+func (gmq *GuildMemberQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(guildmember.Table, guildmember.Columns, sqlgraph.NewFieldSpec(guildmember.FieldID, field.TypeInt))
+	_spec.From = gmq.sql
+	if unique := gmq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if gmq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := gmq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, guildmember.FieldID)
+		for i := range fields {
+			if fields[i] != guildmember.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if gmq.withGuild != nil {
+			_spec.Node.AddColumnOnce(guildmember.FieldGuildID)
+		}
+		if gmq.withUser != nil {
+			_spec.Node.AddColumnOnce(guildmember.FieldUserID)
+		}
+	}
+	if ps := gmq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := gmq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := gmq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := gmq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (gmq *GuildMemberQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(gmq.driver.Dialect())
+	t1 := builder.Table(guildmember.Table)
+	columns := gmq.ctx.Fields
+	if len(columns) == 0 {
+		columns = guildmember.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if gmq.sql != nil {
+		selector = gmq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if gmq.ctx.Unique != nil && *gmq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range gmq.predicates {
+		p(selector)
+	}
+	for _, p := range gmq.order {
+		p(selector)
+	}
+	if offset := gmq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := gmq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// GuildMemberGroupBy is the group-by builder for GuildMember entities.
+type GuildMemberGroupBy struct {
+	selector
+	build *GuildMemberQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (gmgb *GuildMemberGroupBy) Aggregate(fns ...AggregateFunc) *GuildMemberGroupBy {
+	gmgb.fns = append(gmgb.fns, fns...)
+	return gmgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (gmgb *GuildMemberGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, gmgb.build.ctx, ent.OpQueryGroupBy)
+	if err := gmgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*GuildMemberQuery, *GuildMemberGroupBy](ctx, gmgb.build, gmgb, gmgb.build.inters, v)
+}
+
+func (gmgb *GuildMemberGroupBy) sqlScan(ctx context.Context, root *GuildMemberQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(gmgb.fns))
+	for _, fn := range gmgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*gmgb.flds)+len(gmgb.fns))
+		for _, f := range *gmgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*gmgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := gmgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// GuildMemberSelect is the builder for selecting fields of GuildMember entities.
+type GuildMemberSelect struct {
+	*GuildMemberQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (gms *GuildMemberSelect) Aggregate(fns ...AggregateFunc) *GuildMemberSelect {
+	gms.fns = append(gms.fns, fns...)
+	return gms
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (gms *GuildMemberSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, gms.ctx, ent.OpQuerySelect)
+	if err := gms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*GuildMemberQuery, *GuildMemberSelect](ctx, gms.GuildMemberQuery, gms, gms.inters, v)
+}
+
+func (gms *GuildMemberSelect) sqlScan(ctx context.Context, root *GuildMemberQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(gms.fns))
+	for _, fn := range gms.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*gms.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := gms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
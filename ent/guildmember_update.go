@@ -0,0 +1,465 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/SilverSS/gameserver/ent/guild"
+	"github.com/SilverSS/gameserver/ent/guildmember"
+	"github.com/SilverSS/gameserver/ent/predicate"
+	"github.com/SilverSS/gameserver/ent/user"
+)
+
+// GuildMemberUpdate is the builder for updating GuildMember entities.
+type GuildMemberUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GuildMemberMutation
+}
+
+// Where appends a list predicates to the GuildMemberUpdate builder.
+func (gmu *GuildMemberUpdate) Where(ps ...predicate.GuildMember) *GuildMemberUpdate {
+	gmu.mutation.Where(ps...)
+	return gmu
+}
+
+// SetGuildID sets the "guild_id" field.
+func (gmu *GuildMemberUpdate) SetGuildID(i int) *GuildMemberUpdate {
+	gmu.mutation.SetGuildID(i)
+	return gmu
+}
+
+// SetNillableGuildID sets the "guild_id" field if the given value is not nil.
+func (gmu *GuildMemberUpdate) SetNillableGuildID(i *int) *GuildMemberUpdate {
+	if i != nil {
+		gmu.SetGuildID(*i)
+	}
+	return gmu
+}
+
+// SetUserID sets the "user_id" field.
+func (gmu *GuildMemberUpdate) SetUserID(i int) *GuildMemberUpdate {
+	gmu.mutation.SetUserID(i)
+	return gmu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (gmu *GuildMemberUpdate) SetNillableUserID(i *int) *GuildMemberUpdate {
+	if i != nil {
+		gmu.SetUserID(*i)
+	}
+	return gmu
+}
+
+// SetRank sets the "rank" field.
+func (gmu *GuildMemberUpdate) SetRank(gu guildmember.Rank) *GuildMemberUpdate {
+	gmu.mutation.SetRank(gu)
+	return gmu
+}
+
+// SetNillableRank sets the "rank" field if the given value is not nil.
+func (gmu *GuildMemberUpdate) SetNillableRank(gu *guildmember.Rank) *GuildMemberUpdate {
+	if gu != nil {
+		gmu.SetRank(*gu)
+	}
+	return gmu
+}
+
+// SetGuild sets the "guild" edge to the Guild entity.
+func (gmu *GuildMemberUpdate) SetGuild(g *Guild) *GuildMemberUpdate {
+	return gmu.SetGuildID(g.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (gmu *GuildMemberUpdate) SetUser(u *User) *GuildMemberUpdate {
+	return gmu.SetUserID(u.ID)
+}
+
+// Mutation returns the GuildMemberMutation object of the builder.
+func (gmu *GuildMemberUpdate) Mutation() *GuildMemberMutation {
+	return gmu.mutation
+}
+
+// ClearGuild clears the "guild" edge to the Guild entity.
+func (gmu *GuildMemberUpdate) ClearGuild() *GuildMemberUpdate {
+	gmu.mutation.ClearGuild()
+	return gmu
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (gmu *GuildMemberUpdate) ClearUser() *GuildMemberUpdate {
+	gmu.mutation.ClearUser()
+	return gmu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (gmu *GuildMemberUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, gmu.sqlSave, gmu.mutation, gmu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gmu *GuildMemberUpdate) SaveX(ctx context.Context) int {
+	affected, err := gmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (gmu *GuildMemberUpdate) Exec(ctx context.Context) error {
+	_, err := gmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gmu *GuildMemberUpdate) ExecX(ctx context.Context) {
+	if err := gmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gmu *GuildMemberUpdate) check() error {
+	if v, ok := gmu.mutation.Rank(); ok {
+		if err := guildmember.RankValidator(v); err != nil {
+			return &ValidationError{Name: "rank", err: fmt.Errorf(`ent: validator failed for field "GuildMember.rank": %w`, err)}
+		}
+	}
+	if gmu.mutation.GuildCleared() && len(gmu.mutation.GuildIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GuildMember.guild"`)
+	}
+	if gmu.mutation.UserCleared() && len(gmu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GuildMember.user"`)
+	}
+	return nil
+}
+
+func (gmu *GuildMemberUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := gmu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(guildmember.Table, guildmember.Columns, sqlgraph.NewFieldSpec(guildmember.FieldID, field.TypeInt))
+	if ps := gmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gmu.mutation.Rank(); ok {
+		_spec.SetField(guildmember.FieldRank, field.TypeEnum, value)
+	}
+	if gmu.mutation.GuildCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   guildmember.GuildTable,
+			Columns: []string{guildmember.GuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guild.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gmu.mutation.GuildIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   guildmember.GuildTable,
+			Columns: []string{guildmember.GuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guild.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gmu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   guildmember.UserTable,
+			Columns: []string{guildmember.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gmu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   guildmember.UserTable,
+			Columns: []string{guildmember.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, gmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{guildmember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	gmu.mutation.done = true
+	return n, nil
+}
+
+// GuildMemberUpdateOne is the builder for updating a single GuildMember entity.
+type GuildMemberUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GuildMemberMutation
+}
+
+// SetGuildID sets the "guild_id" field.
+func (gmuo *GuildMemberUpdateOne) SetGuildID(i int) *GuildMemberUpdateOne {
+	gmuo.mutation.SetGuildID(i)
+	return gmuo
+}
+
+// SetNillableGuildID sets the "guild_id" field if the given value is not nil.
+func (gmuo *GuildMemberUpdateOne) SetNillableGuildID(i *int) *GuildMemberUpdateOne {
+	if i != nil {
+		gmuo.SetGuildID(*i)
+	}
+	return gmuo
+}
+
+// SetUserID sets the "user_id" field.
+func (gmuo *GuildMemberUpdateOne) SetUserID(i int) *GuildMemberUpdateOne {
+	gmuo.mutation.SetUserID(i)
+	return gmuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (gmuo *GuildMemberUpdateOne) SetNillableUserID(i *int) *GuildMemberUpdateOne {
+	if i != nil {
+		gmuo.SetUserID(*i)
+	}
+	return gmuo
+}
+
+// SetRank sets the "rank" field.
+func (gmuo *GuildMemberUpdateOne) SetRank(gu guildmember.Rank) *GuildMemberUpdateOne {
+	gmuo.mutation.SetRank(gu)
+	return gmuo
+}
+
+// SetNillableRank sets the "rank" field if the given value is not nil.
+func (gmuo *GuildMemberUpdateOne) SetNillableRank(gu *guildmember.Rank) *GuildMemberUpdateOne {
+	if gu != nil {
+		gmuo.SetRank(*gu)
+	}
+	return gmuo
+}
+
+// SetGuild sets the "guild" edge to the Guild entity.
+func (gmuo *GuildMemberUpdateOne) SetGuild(g *Guild) *GuildMemberUpdateOne {
+	return gmuo.SetGuildID(g.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (gmuo *GuildMemberUpdateOne) SetUser(u *User) *GuildMemberUpdateOne {
+	return gmuo.SetUserID(u.ID)
+}
+
+// Mutation returns the GuildMemberMutation object of the builder.
+func (gmuo *GuildMemberUpdateOne) Mutation() *GuildMemberMutation {
+	return gmuo.mutation
+}
+
+// ClearGuild clears the "guild" edge to the Guild entity.
+func (gmuo *GuildMemberUpdateOne) ClearGuild() *GuildMemberUpdateOne {
+	gmuo.mutation.ClearGuild()
+	return gmuo
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (gmuo *GuildMemberUpdateOne) ClearUser() *GuildMemberUpdateOne {
+	gmuo.mutation.ClearUser()
+	return gmuo
+}
+
+// Where appends a list predicates to the GuildMemberUpdate builder.
+func (gmuo *GuildMemberUpdateOne) Where(ps ...predicate.GuildMember) *GuildMemberUpdateOne {
+	gmuo.mutation.Where(ps...)
+	return gmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (gmuo *GuildMemberUpdateOne) Select(field string, fields ...string) *GuildMemberUpdateOne {
+	gmuo.fields = append([]string{field}, fields...)
+	return gmuo
+}
+
+// Save executes the query and returns the updated GuildMember entity.
+func (gmuo *GuildMemberUpdateOne) Save(ctx context.Context) (*GuildMember, error) {
+	return withHooks(ctx, gmuo.sqlSave, gmuo.mutation, gmuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gmuo *GuildMemberUpdateOne) SaveX(ctx context.Context) *GuildMember {
+	node, err := gmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (gmuo *GuildMemberUpdateOne) Exec(ctx context.Context) error {
+	_, err := gmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gmuo *GuildMemberUpdateOne) ExecX(ctx context.Context) {
+	if err := gmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gmuo *GuildMemberUpdateOne) check() error {
+	if v, ok := gmuo.mutation.Rank(); ok {
+		if err := guildmember.RankValidator(v); err != nil {
+			return &ValidationError{Name: "rank", err: fmt.Errorf(`ent: validator failed for field "GuildMember.rank": %w`, err)}
+		}
+	}
+	if gmuo.mutation.GuildCleared() && len(gmuo.mutation.GuildIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GuildMember.guild"`)
+	}
+	if gmuo.mutation.UserCleared() && len(gmuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GuildMember.user"`)
+	}
+	return nil
+}
+
+func (gmuo *GuildMemberUpdateOne) sqlSave(ctx context.Context) (_node *GuildMember, err error) {
+	if err := gmuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(guildmember.Table, guildmember.Columns, sqlgraph.NewFieldSpec(guildmember.FieldID, field.TypeInt))
+	id, ok := gmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GuildMember.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := gmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, guildmember.FieldID)
+		for _, f := range fields {
+			if !guildmember.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != guildmember.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := gmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gmuo.mutation.Rank(); ok {
+		_spec.SetField(guildmember.FieldRank, field.TypeEnum, value)
+	}
+	if gmuo.mutation.GuildCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   guildmember.GuildTable,
+			Columns: []string{guildmember.GuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guild.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gmuo.mutation.GuildIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   guildmember.GuildTable,
+			Columns: []string{guildmember.GuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guild.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gmuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   guildmember.UserTable,
+			Columns: []string{guildmember.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gmuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   guildmember.UserTable,
+			Columns: []string{guildmember.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &GuildMember{config: gmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, gmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{guildmember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	gmuo.mutation.done = true
+	return _node, nil
+}
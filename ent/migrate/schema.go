@@ -8,10 +8,147 @@ import (
 )
 
 var (
+	// CharactersColumns holds the columns for the "characters" table.
+	CharactersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "level", Type: field.TypeInt, Default: 1},
+		{Name: "rating", Type: field.TypeInt, Default: 1200},
+		{Name: "rated_games", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_characters", Type: field.TypeInt},
+	}
+	// CharactersTable holds the schema information for the "characters" table.
+	CharactersTable = &schema.Table{
+		Name:       "characters",
+		Columns:    CharactersColumns,
+		PrimaryKey: []*schema.Column{CharactersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "characters_users_characters",
+				Columns:    []*schema.Column{CharactersColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// ChatMessagesColumns holds the columns for the "chat_messages" table.
+	ChatMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "channel", Type: field.TypeString},
+		{Name: "zone_id", Type: field.TypeString, Default: ""},
+		{Name: "sender", Type: field.TypeString},
+		{Name: "recipient", Type: field.TypeString, Default: ""},
+		{Name: "text", Type: field.TypeString},
+		{Name: "delivered", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ChatMessagesTable holds the schema information for the "chat_messages" table.
+	ChatMessagesTable = &schema.Table{
+		Name:       "chat_messages",
+		Columns:    ChatMessagesColumns,
+		PrimaryKey: []*schema.Column{ChatMessagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "chatmessage_channel_zone_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ChatMessagesColumns[1], ChatMessagesColumns[2], ChatMessagesColumns[7]},
+			},
+			{
+				Name:    "chatmessage_recipient_delivered",
+				Unique:  false,
+				Columns: []*schema.Column{ChatMessagesColumns[4], ChatMessagesColumns[6]},
+			},
+		},
+	}
+	// FriendshipsColumns holds the columns for the "friendships" table.
+	FriendshipsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"request", "accepted", "blocked"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "friend_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// FriendshipsTable holds the schema information for the "friendships" table.
+	FriendshipsTable = &schema.Table{
+		Name:       "friendships",
+		Columns:    FriendshipsColumns,
+		PrimaryKey: []*schema.Column{FriendshipsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "friendships_users_friend",
+				Columns:    []*schema.Column{FriendshipsColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "friendships_users_friendships",
+				Columns:    []*schema.Column{FriendshipsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "friendship_user_id_friend_id",
+				Unique:  true,
+				Columns: []*schema.Column{FriendshipsColumns[5], FriendshipsColumns[4]},
+			},
+			{
+				Name:    "friendship_friend_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{FriendshipsColumns[4], FriendshipsColumns[1]},
+			},
+		},
+	}
+	// GuildsColumns holds the columns for the "guilds" table.
+	GuildsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "name_key", Type: field.TypeString, Unique: true},
+		{Name: "motd", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// GuildsTable holds the schema information for the "guilds" table.
+	GuildsTable = &schema.Table{
+		Name:       "guilds",
+		Columns:    GuildsColumns,
+		PrimaryKey: []*schema.Column{GuildsColumns[0]},
+	}
+	// GuildMembersColumns holds the columns for the "guild_members" table.
+	GuildMembersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "rank", Type: field.TypeEnum, Enums: []string{"leader", "officer", "member"}, Default: "member"},
+		{Name: "joined_at", Type: field.TypeTime},
+		{Name: "guild_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt, Unique: true},
+	}
+	// GuildMembersTable holds the schema information for the "guild_members" table.
+	GuildMembersTable = &schema.Table{
+		Name:       "guild_members",
+		Columns:    GuildMembersColumns,
+		PrimaryKey: []*schema.Column{GuildMembersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "guild_members_guilds_members",
+				Columns:    []*schema.Column{GuildMembersColumns[3]},
+				RefColumns: []*schema.Column{GuildsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "guild_members_users_guild_membership",
+				Columns:    []*schema.Column{GuildMembersColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "username", Type: field.TypeString, Unique: true},
+		{Name: "username_key", Type: field.TypeString, Unique: true},
 		{Name: "password_hash", Type: field.TypeString},
 		{Name: "created_at", Type: field.TypeTime},
 	}
@@ -23,9 +160,19 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		CharactersTable,
+		ChatMessagesTable,
+		FriendshipsTable,
+		GuildsTable,
+		GuildMembersTable,
 		UsersTable,
 	}
 )
 
 func init() {
+	CharactersTable.ForeignKeys[0].RefTable = UsersTable
+	FriendshipsTable.ForeignKeys[0].RefTable = UsersTable
+	FriendshipsTable.ForeignKeys[1].RefTable = UsersTable
+	GuildMembersTable.ForeignKeys[0].RefTable = GuildsTable
+	GuildMembersTable.ForeignKeys[1].RefTable = UsersTable
 }
@@ -6,5 +6,20 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Character is the predicate function for character builders.
+type Character func(*sql.Selector)
+
+// ChatMessage is the predicate function for chatmessage builders.
+type ChatMessage func(*sql.Selector)
+
+// Friendship is the predicate function for friendship builders.
+type Friendship func(*sql.Selector)
+
+// Guild is the predicate function for guild builders.
+type Guild func(*sql.Selector)
+
+// GuildMember is the predicate function for guildmember builders.
+type GuildMember func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
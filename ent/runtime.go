@@ -5,6 +5,11 @@ package ent
 import (
 	"time"
 
+	"github.com/SilverSS/gameserver/ent/character"
+	"github.com/SilverSS/gameserver/ent/chatmessage"
+	"github.com/SilverSS/gameserver/ent/friendship"
+	"github.com/SilverSS/gameserver/ent/guild"
+	"github.com/SilverSS/gameserver/ent/guildmember"
 	"github.com/SilverSS/gameserver/ent/schema"
 	"github.com/SilverSS/gameserver/ent/user"
 )
@@ -13,10 +18,102 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	characterFields := schema.Character{}.Fields()
+	_ = characterFields
+	// characterDescName is the schema descriptor for name field.
+	characterDescName := characterFields[0].Descriptor()
+	// character.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	character.NameValidator = characterDescName.Validators[0].(func(string) error)
+	// characterDescLevel is the schema descriptor for level field.
+	characterDescLevel := characterFields[1].Descriptor()
+	// character.DefaultLevel holds the default value on creation for the level field.
+	character.DefaultLevel = characterDescLevel.Default.(int)
+	// character.LevelValidator is a validator for the "level" field. It is called by the builders before save.
+	character.LevelValidator = characterDescLevel.Validators[0].(func(int) error)
+	// characterDescRating is the schema descriptor for rating field.
+	characterDescRating := characterFields[2].Descriptor()
+	// character.DefaultRating holds the default value on creation for the rating field.
+	character.DefaultRating = characterDescRating.Default.(int)
+	// characterDescRatedGames is the schema descriptor for rated_games field.
+	characterDescRatedGames := characterFields[3].Descriptor()
+	// character.DefaultRatedGames holds the default value on creation for the rated_games field.
+	character.DefaultRatedGames = characterDescRatedGames.Default.(int)
+	// character.RatedGamesValidator is a validator for the "rated_games" field. It is called by the builders before save.
+	character.RatedGamesValidator = characterDescRatedGames.Validators[0].(func(int) error)
+	// characterDescCreatedAt is the schema descriptor for created_at field.
+	characterDescCreatedAt := characterFields[4].Descriptor()
+	// character.DefaultCreatedAt holds the default value on creation for the created_at field.
+	character.DefaultCreatedAt = characterDescCreatedAt.Default.(func() time.Time)
+	chatmessageFields := schema.ChatMessage{}.Fields()
+	_ = chatmessageFields
+	// chatmessageDescChannel is the schema descriptor for channel field.
+	chatmessageDescChannel := chatmessageFields[0].Descriptor()
+	// chatmessage.ChannelValidator is a validator for the "channel" field. It is called by the builders before save.
+	chatmessage.ChannelValidator = chatmessageDescChannel.Validators[0].(func(string) error)
+	// chatmessageDescZoneID is the schema descriptor for zone_id field.
+	chatmessageDescZoneID := chatmessageFields[1].Descriptor()
+	// chatmessage.DefaultZoneID holds the default value on creation for the zone_id field.
+	chatmessage.DefaultZoneID = chatmessageDescZoneID.Default.(string)
+	// chatmessageDescRecipient is the schema descriptor for recipient field.
+	chatmessageDescRecipient := chatmessageFields[3].Descriptor()
+	// chatmessage.DefaultRecipient holds the default value on creation for the recipient field.
+	chatmessage.DefaultRecipient = chatmessageDescRecipient.Default.(string)
+	// chatmessageDescDelivered is the schema descriptor for delivered field.
+	chatmessageDescDelivered := chatmessageFields[5].Descriptor()
+	// chatmessage.DefaultDelivered holds the default value on creation for the delivered field.
+	chatmessage.DefaultDelivered = chatmessageDescDelivered.Default.(bool)
+	// chatmessageDescCreatedAt is the schema descriptor for created_at field.
+	chatmessageDescCreatedAt := chatmessageFields[6].Descriptor()
+	// chatmessage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	chatmessage.DefaultCreatedAt = chatmessageDescCreatedAt.Default.(func() time.Time)
+	friendshipFields := schema.Friendship{}.Fields()
+	_ = friendshipFields
+	// friendshipDescCreatedAt is the schema descriptor for created_at field.
+	friendshipDescCreatedAt := friendshipFields[3].Descriptor()
+	// friendship.DefaultCreatedAt holds the default value on creation for the created_at field.
+	friendship.DefaultCreatedAt = friendshipDescCreatedAt.Default.(func() time.Time)
+	// friendshipDescUpdatedAt is the schema descriptor for updated_at field.
+	friendshipDescUpdatedAt := friendshipFields[4].Descriptor()
+	// friendship.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	friendship.DefaultUpdatedAt = friendshipDescUpdatedAt.Default.(func() time.Time)
+	// friendship.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	friendship.UpdateDefaultUpdatedAt = friendshipDescUpdatedAt.UpdateDefault.(func() time.Time)
+	guildFields := schema.Guild{}.Fields()
+	_ = guildFields
+	// guildDescName is the schema descriptor for name field.
+	guildDescName := guildFields[0].Descriptor()
+	// guild.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	guild.NameValidator = guildDescName.Validators[0].(func(string) error)
+	// guildDescNameKey is the schema descriptor for name_key field.
+	guildDescNameKey := guildFields[1].Descriptor()
+	// guild.NameKeyValidator is a validator for the "name_key" field. It is called by the builders before save.
+	guild.NameKeyValidator = guildDescNameKey.Validators[0].(func(string) error)
+	// guildDescMotd is the schema descriptor for motd field.
+	guildDescMotd := guildFields[2].Descriptor()
+	// guild.DefaultMotd holds the default value on creation for the motd field.
+	guild.DefaultMotd = guildDescMotd.Default.(string)
+	// guildDescCreatedAt is the schema descriptor for created_at field.
+	guildDescCreatedAt := guildFields[3].Descriptor()
+	// guild.DefaultCreatedAt holds the default value on creation for the created_at field.
+	guild.DefaultCreatedAt = guildDescCreatedAt.Default.(func() time.Time)
+	guildmemberFields := schema.GuildMember{}.Fields()
+	_ = guildmemberFields
+	// guildmemberDescJoinedAt is the schema descriptor for joined_at field.
+	guildmemberDescJoinedAt := guildmemberFields[3].Descriptor()
+	// guildmember.DefaultJoinedAt holds the default value on creation for the joined_at field.
+	guildmember.DefaultJoinedAt = guildmemberDescJoinedAt.Default.(func() time.Time)
 	userFields := schema.User{}.Fields()
 	_ = userFields
+	// userDescUsername is the schema descriptor for username field.
+	userDescUsername := userFields[0].Descriptor()
+	// user.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	user.UsernameValidator = userDescUsername.Validators[0].(func(string) error)
+	// userDescUsernameKey is the schema descriptor for username_key field.
+	userDescUsernameKey := userFields[1].Descriptor()
+	// user.UsernameKeyValidator is a validator for the "username_key" field. It is called by the builders before save.
+	user.UsernameKeyValidator = userDescUsernameKey.Validators[0].(func(string) error)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[2].Descriptor()
+	userDescCreatedAt := userFields[3].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 }
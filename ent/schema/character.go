@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Character holds the schema definition for the Character entity.
+type Character struct {
+	ent.Schema
+}
+
+// Fields of the Character.
+func (Character) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Unique().NotEmpty(),
+		field.Int("level").Default(1).Positive(),
+		// 매치메이킹 Elo 레이팅 (모든 경쟁 모드 공통)
+		field.Int("rating").Default(1200),
+		field.Int("rated_games").Default(0).NonNegative(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the Character.
+func (Character) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).Ref("characters").Unique().Required(),
+	}
+}
@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ChatMessage holds the schema definition for the ChatMessage entity.
+// 글로벌/존 채널 기록과 접속하지 않은 플레이어에게 보낸 귓속말을 저장한다.
+type ChatMessage struct {
+	ent.Schema
+}
+
+// Fields of the ChatMessage.
+func (ChatMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("channel").NotEmpty(),
+		// zone 채널의 존 ID (다른 채널은 빈 문자열)
+		field.String("zone_id").Default(""),
+		field.String("sender"),
+		// whisper 받는 사람 사용자명 (다른 채널은 빈 문자열)
+		field.String("recipient").Default(""),
+		field.String("text"),
+		// 받는 사람이 아직 받지 못한 귓속말이면 false
+		field.Bool("delivered").Default(true),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Indexes of the ChatMessage.
+func (ChatMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("channel", "zone_id", "created_at"),
+		index.Fields("recipient", "delivered"),
+	}
+}
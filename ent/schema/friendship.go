@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Friendship holds the schema definition for the Friendship entity.
+// user 가 friend 에 대해 가진 관계 (방향 있음).
+// request: user 가 friend 에게 친구 요청을 보냄, accepted: 친구 (양쪽에 한 줄씩), blocked: user 가 friend 를 차단함
+type Friendship struct {
+	ent.Schema
+}
+
+// Fields of the Friendship.
+func (Friendship) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.Int("friend_id"),
+		field.Enum("status").Values("request", "accepted", "blocked"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Friendship.
+func (Friendship) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("friendships").Field("user_id").Unique().Required(),
+		edge.To("friend", User.Type).Field("friend_id").Unique().Required(),
+	}
+}
+
+// Indexes of the Friendship.
+func (Friendship) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "friend_id").Unique(),
+		index.Fields("friend_id", "status"),
+	}
+}
@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Guild holds the schema definition for the Guild entity.
+type Guild struct {
+	ent.Schema
+}
+
+// Fields of the Guild.
+func (Guild) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Unique().NotEmpty(),
+		// 대소문자/유니코드 정규화 후의 길드 이름. 대소문자만 다른 중복 이름을 DB 수준에서 막는다.
+		field.String("name_key").Unique().NotEmpty().Immutable(),
+		// 길드 공지 (message of the day)
+		field.String("motd").Default(""),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the Guild.
+func (Guild) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("members", GuildMember.Type),
+	}
+}
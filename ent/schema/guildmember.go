@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// GuildMember holds the schema definition for the GuildMember entity.
+// 계정은 길드 하나에만 속할 수 있다 (user_id 유일).
+type GuildMember struct {
+	ent.Schema
+}
+
+// Fields of the GuildMember.
+func (GuildMember) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("guild_id"),
+		field.Int("user_id").Unique(),
+		field.Enum("rank").Values("leader", "officer", "member").Default("member"),
+		field.Time("joined_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the GuildMember.
+func (GuildMember) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("guild", Guild.Type).Ref("members").Field("guild_id").Unique().Required(),
+		edge.From("user", User.Type).Ref("guild_membership").Field("user_id").Unique().Required(),
+	}
+}
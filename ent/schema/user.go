@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
 
@@ -15,7 +16,9 @@ type User struct {
 // Fields of the User.
 func (User) Fields() []ent.Field {
 	return []ent.Field{
-		field.String("username").Unique(),
+		field.String("username").Unique().NotEmpty(),
+		// 대소문자/유니코드 정규화 후의 사용자명. 대소문자만 다른 중복 가입을 DB 수준에서 막는다.
+		field.String("username_key").Unique().NotEmpty().Immutable(),
 		field.String("password_hash"),
 		field.Time("created_at").Default(time.Now),
 	}
@@ -23,5 +26,9 @@ func (User) Fields() []ent.Field {
 
 // Edges of the User.
 func (User) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.To("characters", Character.Type),
+		edge.To("friendships", Friendship.Type),
+		edge.To("guild_membership", GuildMember.Type).Unique(),
+	}
 }
@@ -12,6 +12,16 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Character is the client for interacting with the Character builders.
+	Character *CharacterClient
+	// ChatMessage is the client for interacting with the ChatMessage builders.
+	ChatMessage *ChatMessageClient
+	// Friendship is the client for interacting with the Friendship builders.
+	Friendship *FriendshipClient
+	// Guild is the client for interacting with the Guild builders.
+	Guild *GuildClient
+	// GuildMember is the client for interacting with the GuildMember builders.
+	GuildMember *GuildMemberClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -145,6 +155,11 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Character = NewCharacterClient(tx.config)
+	tx.ChatMessage = NewChatMessageClient(tx.config)
+	tx.Friendship = NewFriendshipClient(tx.config)
+	tx.Guild = NewGuildClient(tx.config)
+	tx.GuildMember = NewGuildMemberClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
@@ -155,7 +170,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: User.QueryXXX(), the query will be executed
+// applies a query, for example: Character.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
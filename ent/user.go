@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/SilverSS/gameserver/ent/guildmember"
 	"github.com/SilverSS/gameserver/ent/user"
 )
 
@@ -19,13 +20,60 @@ type User struct {
 	ID int `json:"id,omitempty"`
 	// Username holds the value of the "username" field.
 	Username string `json:"username,omitempty"`
+	// UsernameKey holds the value of the "username_key" field.
+	UsernameKey string `json:"username_key,omitempty"`
 	// PasswordHash holds the value of the "password_hash" field.
 	PasswordHash string `json:"password_hash,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
-	CreatedAt    time.Time `json:"created_at,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UserQuery when eager-loading is set.
+	Edges        UserEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// UserEdges holds the relations/edges for other nodes in the graph.
+type UserEdges struct {
+	// Characters holds the value of the characters edge.
+	Characters []*Character `json:"characters,omitempty"`
+	// Friendships holds the value of the friendships edge.
+	Friendships []*Friendship `json:"friendships,omitempty"`
+	// GuildMembership holds the value of the guild_membership edge.
+	GuildMembership *GuildMember `json:"guild_membership,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// CharactersOrErr returns the Characters value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) CharactersOrErr() ([]*Character, error) {
+	if e.loadedTypes[0] {
+		return e.Characters, nil
+	}
+	return nil, &NotLoadedError{edge: "characters"}
+}
+
+// FriendshipsOrErr returns the Friendships value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) FriendshipsOrErr() ([]*Friendship, error) {
+	if e.loadedTypes[1] {
+		return e.Friendships, nil
+	}
+	return nil, &NotLoadedError{edge: "friendships"}
+}
+
+// GuildMembershipOrErr returns the GuildMembership value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) GuildMembershipOrErr() (*GuildMember, error) {
+	if e.GuildMembership != nil {
+		return e.GuildMembership, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: guildmember.Label}
+	}
+	return nil, &NotLoadedError{edge: "guild_membership"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -33,7 +81,7 @@ func (*User) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case user.FieldID:
 			values[i] = new(sql.NullInt64)
-		case user.FieldUsername, user.FieldPasswordHash:
+		case user.FieldUsername, user.FieldUsernameKey, user.FieldPasswordHash:
 			values[i] = new(sql.NullString)
 		case user.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -64,6 +112,12 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.Username = value.String
 			}
+		case user.FieldUsernameKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username_key", values[i])
+			} else if value.Valid {
+				u.UsernameKey = value.String
+			}
 		case user.FieldPasswordHash:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field password_hash", values[i])
@@ -89,6 +143,21 @@ func (u *User) Value(name string) (ent.Value, error) {
 	return u.selectValues.Get(name)
 }
 
+// QueryCharacters queries the "characters" edge of the User entity.
+func (u *User) QueryCharacters() *CharacterQuery {
+	return NewUserClient(u.config).QueryCharacters(u)
+}
+
+// QueryFriendships queries the "friendships" edge of the User entity.
+func (u *User) QueryFriendships() *FriendshipQuery {
+	return NewUserClient(u.config).QueryFriendships(u)
+}
+
+// QueryGuildMembership queries the "guild_membership" edge of the User entity.
+func (u *User) QueryGuildMembership() *GuildMemberQuery {
+	return NewUserClient(u.config).QueryGuildMembership(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -115,6 +184,9 @@ func (u *User) String() string {
 	builder.WriteString("username=")
 	builder.WriteString(u.Username)
 	builder.WriteString(", ")
+	builder.WriteString("username_key=")
+	builder.WriteString(u.UsernameKey)
+	builder.WriteString(", ")
 	builder.WriteString("password_hash=")
 	builder.WriteString(u.PasswordHash)
 	builder.WriteString(", ")
@@ -0,0 +1,398 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/SilverSS/gameserver/types"
+	"github.com/anthdm/hollywood/actor"
+)
+
+// 능력 대상 (abilityDef.Targets)
+const (
+	abilityTargetEnemy = "enemy" // 범위 안의 적대 엔티티
+	abilityTargetSelf  = "self"  // 시전자 자신 (범위 무시)
+)
+
+// 능력 범위 모양 (areaShape.Type). 모두 X/Z 평면에서 판정한다.
+const (
+	shapeSingle = "single" // 대상 하나 (targetID 필수)
+	shapeCircle = "circle" // 조준 지점 중심의 원
+	shapeCone   = "cone"   // 시전자에서 조준 방향으로 펼쳐지는 부채꼴
+	shapeLine   = "line"   // 시전자에서 조준 방향으로 뻗는 직사각형
+)
+
+// 능력 효과 종류 (abilityEffect.Type)
+const (
+	effectDamage = "damage"
+	effectHeal   = "heal"
+	effectStatus = "status" // 상태 효과 걸기
+	effectDispel = "dispel" // 적대 대상은 이로운 효과, 아니면 해로운 효과 해제
+)
+
+// 능력 정의 (data/abilities.json)
+// Range 는 대상/조준 지점까지의 최대 거리로 single, circle 에만 쓴다 (0 이면 시전자 위치).
+type abilityDef struct {
+	ID         string          `json:"id"`
+	Name       string          `json:"name"`
+	CastMs     int             `json:"castMs"` // 0 이면 즉시 시전
+	CooldownMs int             `json:"cooldownMs"`
+	Resource   string          `json:"resource"` // types.Resource*, 비우면 소모 없음
+	Cost       int             `json:"cost"`
+	Range      float32         `json:"range"`
+	Targets    string          `json:"targets"`
+	Shape      areaShape       `json:"shape"`
+	Effects    []abilityEffect `json:"effects"`
+}
+
+type areaShape struct {
+	Type   string  `json:"type"`
+	Radius float32 `json:"radius"` // circle
+	Length float32 `json:"length"` // cone, line
+	Angle  float32 `json:"angle"`  // cone: 전체 각도 (도)
+	Width  float32 `json:"width"`  // line
+}
+
+type abilityEffect struct {
+	Type   string `json:"type"`
+	Amount int    `json:"amount"` // damage, heal
+	Status string `json:"status"` // status: 상태 효과 ID
+}
+
+func (a *abilityDef) castTime() time.Duration {
+	return time.Duration(a.CastMs) * time.Millisecond
+}
+
+func (a *abilityDef) cooldown() time.Duration {
+	return time.Duration(a.CooldownMs) * time.Millisecond
+}
+
+func (a *abilityDef) validate(resources map[string]resourceDef, statuses map[string]*statusDef) error {
+	if a.CastMs < 0 || a.CooldownMs < 0 || a.Cost < 0 || a.Range < 0 {
+		return fmt.Errorf("ability %s: negative castMs, cooldownMs, cost or range", a.ID)
+	}
+	if _, ok := resources[a.Resource]; a.Resource != "" && !ok {
+		return fmt.Errorf("ability %s: unknown resource %q", a.ID, a.Resource)
+	}
+	if a.Targets != abilityTargetEnemy && a.Targets != abilityTargetSelf {
+		return fmt.Errorf("ability %s: unknown targets %q", a.ID, a.Targets)
+	}
+	s := a.Shape
+	switch {
+	case s.Type == shapeSingle:
+	case s.Type == shapeCircle && s.Radius > 0:
+	case s.Type == shapeCone && s.Length > 0 && s.Angle > 0 && s.Angle <= 360:
+	case s.Type == shapeLine && s.Length > 0 && s.Width > 0:
+	default:
+		return fmt.Errorf("ability %s: invalid shape %+v", a.ID, s)
+	}
+	if len(a.Effects) == 0 {
+		return fmt.Errorf("ability %s: no effects", a.ID)
+	}
+	for _, e := range a.Effects {
+		switch {
+		case (e.Type == effectDamage || e.Type == effectHeal) && e.Amount >= 0:
+		case e.Type == effectStatus && statuses[e.Status] != nil:
+		case e.Type == effectDispel:
+		default:
+			return fmt.Errorf("ability %s: invalid effect %+v", a.ID, e)
+		}
+	}
+	return nil
+}
+
+// 능력 정의 전체 로드
+func loadAbilities(resources map[string]resourceDef, statuses map[string]*statusDef) (map[string]*abilityDef, error) {
+	var list []*abilityDef
+	if err := readDataJSON("abilities.json", &list); err != nil {
+		return nil, err
+	}
+	abilities := make(map[string]*abilityDef, len(list))
+	for _, a := range list {
+		if a.ID == "" {
+			return nil, fmt.Errorf("abilities.json: missing id")
+		}
+		if _, dup := abilities[a.ID]; dup {
+			return nil, fmt.Errorf("abilities.json: duplicate ability %q", a.ID)
+		}
+		if err := a.validate(resources, statuses); err != nil {
+			return nil, fmt.Errorf("abilities.json: %w", err)
+		}
+		abilities[a.ID] = a
+	}
+	return abilities, nil
+}
+
+// 시전 중인 능력
+type activeCast struct {
+	ability  *abilityDef
+	targetID int64
+	aim      types.Vector
+	finishAt time.Time
+}
+
+// 능력 사용 요청 (세션 -> 존). Position 이 nil 이면 대상 위치 또는 시전자 위치를 조준한다.
+type (
+	useAbility struct {
+		EntityID  int64
+		AbilityID string
+		TargetID  int64
+		Position  *types.Vector
+	}
+
+	cancelAbility struct {
+		EntityID int64
+	}
+)
+
+// 시전자 상태로 능력을 쓸 수 있는지 검사 (실패하면 오류 코드). 대상/범위는 존이 검사한다.
+func (r *combatRules) checkAbility(e *entity, a *abilityDef, now time.Time) string {
+	switch {
+	case e.dead():
+		return types.AbilityErrDead
+	case e.stunned():
+		return types.AbilityErrStunned
+	case e.combat.cast != nil:
+		return types.AbilityErrCasting
+	case a.CastMs > 0 && e.moving:
+		return types.AbilityErrMoving
+	case now.Before(e.combat.cooldowns[a.ID]):
+		return types.AbilityErrCooldown
+	case a.Resource != "" && e.combat.resources[a.Resource] < float32(a.Cost):
+		return types.AbilityErrNoResource
+	}
+	return ""
+}
+
+// 조준 지점 기준으로 범위 안에 있는지 (X/Z 평면)
+func (s *areaShape) contains(origin, aim, p types.Vector, radius float32) bool {
+	switch s.Type {
+	case shapeCircle:
+		return withinXZ(aim, p, s.Radius+radius)
+	case shapeCone, shapeLine:
+		dir := directionXZ(origin, aim)
+		rel := types.Vector{X: p.X - origin.X, Z: p.Z - origin.Z}
+		along := rel.X*dir.X + rel.Z*dir.Z
+		if s.Type == shapeLine {
+			across := rel.X*dir.Z - rel.Z*dir.X
+			return along >= -radius && along <= s.Length+radius && absf(across) <= s.Width/2+radius
+		}
+		dist := length(rel)
+		if dist > s.Length+radius {
+			return false
+		}
+		if dist <= radius {
+			return true
+		}
+		return along/dist >= float32(math.Cos(float64(s.Angle/2)*math.Pi/180))
+	}
+	return false
+}
+
+// origin 에서 aim 으로 향하는 X/Z 평면 단위 벡터 (같은 위치면 +Z)
+func directionXZ(origin, aim types.Vector) types.Vector {
+	d := normalize(types.Vector{X: aim.X - origin.X, Z: aim.Z - origin.Z})
+	if d.X == 0 && d.Z == 0 {
+		return types.Vector{Z: 1}
+	}
+	return d
+}
+
+// 엔티티 판정 반경 (범위 가장자리에 걸친 엔티티도 맞도록)
+const entityRadius = 0.5
+
+func (z *Zone) useAbility(c *actor.Context, msg useAbility, now time.Time) {
+	e, ok := z.entities[msg.EntityID]
+	if !ok {
+		return
+	}
+	a, ok := z.rules.abilities[msg.AbilityID]
+	if !ok {
+		z.abilityFailed(c, e, msg.AbilityID, types.AbilityErrUnknown, now)
+		return
+	}
+	if code := z.rules.checkAbility(e, a, now); code != "" {
+		z.abilityFailed(c, e, a.ID, code, now)
+		return
+	}
+	aim, code := z.abilityAim(e, a, msg.TargetID, msg.Position)
+	if code != "" {
+		z.abilityFailed(c, e, a.ID, code, now)
+		return
+	}
+	cast := &activeCast{ability: a, targetID: msg.TargetID, aim: aim, finishAt: now.Add(a.castTime())}
+	if a.Shape.Type != shapeSingle || a.Targets == abilityTargetSelf {
+		cast.targetID = 0
+	}
+	if a.CastMs == 0 {
+		z.finishCast(c, e, cast, now)
+		return
+	}
+	e.combat.cast = cast
+	z.sendNear(c, e.state.Position, "castEvent", types.CastEvent{
+		Type:       types.CastEventStart,
+		CasterID:   e.id,
+		AbilityID:  a.ID,
+		CastMs:     a.CastMs,
+		TargetID:   cast.targetID,
+		Position:   &aim,
+		Tick:       z.ticks,
+		ServerTime: z.stateTime(),
+	})
+}
+
+// 조준 지점 결정과 대상/사거리/시야 검사
+func (z *Zone) abilityAim(e *entity, a *abilityDef, targetID int64, pos *types.Vector) (types.Vector, string) {
+	origin := e.state.Position
+	if a.Targets == abilityTargetSelf {
+		return origin, ""
+	}
+	aim := origin
+	switch {
+	case targetID != 0:
+		tgt, ok := z.entities[targetID]
+		if !ok || tgt.dead() || (a.Shape.Type == shapeSingle && !z.hostile(e, tgt)) {
+			return aim, types.AbilityErrInvalidTarget
+		}
+		aim = tgt.state.Position
+	case a.Shape.Type == shapeSingle:
+		return aim, types.AbilityErrInvalidTarget
+	case pos != nil:
+		aim = z.def.onGround(*pos)
+	}
+	if a.Shape.Type == shapeSingle || a.Shape.Type == shapeCircle {
+		if !withinXZ(origin, aim, a.Range) {
+			return aim, types.AbilityErrOutOfRange
+		}
+	}
+	if !z.lineOfSight(origin, aim) {
+		return aim, types.AbilityErrNoLineOfSight
+	}
+	return aim, ""
+}
+
+// 시전 완료: 대상을 다시 확인하고 자원 소모, 재사용 대기, 효과를 적용한다.
+func (z *Zone) finishCast(c *actor.Context, e *entity, cast *activeCast, now time.Time) {
+	a := cast.ability
+	e.combat.cast = nil
+	if cast.targetID != 0 {
+		tgt, ok := z.entities[cast.targetID]
+		if !ok || tgt.dead() {
+			z.interruptCast(c, e, a, types.CastInterruptTargetLost)
+			return
+		}
+		cast.aim = tgt.state.Position
+		if !withinXZ(e.state.Position, cast.aim, a.Range) || !z.lineOfSight(e.state.Position, cast.aim) {
+			z.interruptCast(c, e, a, types.CastInterruptOutOfRange)
+			return
+		}
+	}
+	if a.Resource != "" {
+		e.combat.resources[a.Resource] -= float32(a.Cost)
+		z.sendResource(c, e, a.Resource)
+	}
+	e.combat.cooldowns[a.ID] = now.Add(a.cooldown())
+	aim := cast.aim
+	z.sendNear(c, e.state.Position, "castEvent", types.CastEvent{
+		Type:       types.CastEventFinish,
+		CasterID:   e.id,
+		AbilityID:  a.ID,
+		TargetID:   cast.targetID,
+		Position:   &aim,
+		CooldownMs: a.CooldownMs,
+		Tick:       z.ticks,
+		ServerTime: z.stateTime(),
+	})
+	src := hitSource{entityID: e.id, abilityID: a.ID}
+	for _, tgt := range z.abilityTargets(e, cast) {
+		for _, eff := range a.Effects {
+			if tgt.dead() {
+				break
+			}
+			switch eff.Type {
+			case effectDamage:
+				z.damage(c, src, tgt, eff.Amount, now)
+			case effectHeal:
+				z.heal(c, src, tgt, eff.Amount)
+			case effectStatus:
+				z.applyStatusEffect(c, e, tgt, z.rules.statuses[eff.Status], now)
+			case effectDispel:
+				z.dispel(c, e, tgt, now)
+			}
+		}
+	}
+}
+
+// 효과를 받을 엔티티 (ID 순)
+func (z *Zone) abilityTargets(e *entity, cast *activeCast) []*entity {
+	a := cast.ability
+	switch {
+	case a.Targets == abilityTargetSelf:
+		return []*entity{e}
+	case a.Shape.Type == shapeSingle:
+		return []*entity{z.entities[cast.targetID]}
+	}
+	var hits []*entity
+	for _, other := range z.entities {
+		if other.dead() || !z.hostile(e, other) {
+			continue
+		}
+		if a.Shape.contains(e.state.Position, cast.aim, other.state.Position, entityRadius) && z.lineOfSight(cast.aim, other.state.Position) {
+			hits = append(hits, other)
+		}
+	}
+	sort.Slice(hits, func(i, j int) bool { return hits[i].id < hits[j].id })
+	return hits
+}
+
+// 시전 중단을 주변에 알린다 (시전 상태는 호출한 쪽에서 정리)
+func (z *Zone) interruptCast(c *actor.Context, e *entity, a *abilityDef, reason string) {
+	e.combat.cast = nil
+	z.sendNear(c, e.state.Position, "castEvent", types.CastEvent{
+		Type:       types.CastEventInterrupt,
+		CasterID:   e.id,
+		AbilityID:  a.ID,
+		Reason:     reason,
+		Tick:       z.ticks,
+		ServerTime: z.stateTime(),
+	})
+}
+
+func (z *Zone) cancelAbility(c *actor.Context, msg cancelAbility) {
+	e, ok := z.entities[msg.EntityID]
+	if !ok {
+		return
+	}
+	if e.combat.cast == nil {
+		z.send(c, e, "abilityResult", types.AbilityResult{Success: false, Code: types.AbilityErrNotCasting})
+		return
+	}
+	z.interruptCast(c, e, e.combat.cast.ability, types.CastInterruptCancelled)
+}
+
+// 틱마다 시전 시간이 끝난 능력을 발동 (엔티티 ID 순)
+func (z *Zone) updateCasts(c *actor.Context, now time.Time) {
+	var done []*entity
+	for _, e := range z.entities {
+		if e.combat.cast != nil && !now.Before(e.combat.cast.finishAt) {
+			done = append(done, e)
+		}
+	}
+	sort.Slice(done, func(i, j int) bool { return done[i].id < done[j].id })
+	for _, e := range done {
+		// 앞선 시전의 효과로 죽었으면 이미 중단됨
+		if cast := e.combat.cast; cast != nil {
+			z.finishCast(c, e, cast, now)
+		}
+	}
+}
+
+func (z *Zone) abilityFailed(c *actor.Context, e *entity, abilityID, code string, now time.Time) {
+	res := types.AbilityResult{Success: false, Code: code, AbilityID: abilityID}
+	if code == types.AbilityErrCooldown {
+		res.CooldownMs = int(e.combat.cooldowns[abilityID].Sub(now) / time.Millisecond)
+	}
+	z.send(c, e, "abilityResult", res)
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/SilverSS/gameserver/ent"
+)
+
+// 이미 사용 중인 사용자명으로 가입을 시도한 경우
+var errUsernameTaken = errors.New("username taken")
+
+// 계정 생성: 사용자와 기본 캐릭터를 하나의 트랜잭션으로 만든다.
+// 중복 검사는 DB 유일성 제약에 맡기므로 동시 가입 요청에도 경합이 없다.
+func createAccount(ctx context.Context, client *ent.Client, username, passwordHash string) (*ent.User, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+	u, err := tx.User.Create().
+		SetUsername(username).
+		SetUsernameKey(usernameKey(username)).
+		SetPasswordHash(passwordHash).
+		SetCreatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if _, err := tx.Character.Create().SetName(username).SetOwner(u).Save(ctx); err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, mapConstraintError(fmt.Errorf("committing transaction: %w", err))
+	}
+	return u, nil
+}
+
+// fn 을 하나의 트랜잭션으로 실행한다. fn 이 오류를 돌려주면 롤백한다.
+// rollback 과 달리 유일성 제약 위반을 변환하지 않는다.
+func withTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
+
+// 트랜잭션 롤백 후 원래 오류를 반환한다.
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+	}
+	return mapConstraintError(err)
+}
+
+// 유일성 제약 위반을 errUsernameTaken 으로 변환한다.
+func mapConstraintError(err error) error {
+	if ent.IsConstraintError(err) {
+		return errUsernameTaken
+	}
+	return err
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/SilverSS/gameserver/types"
+	"github.com/anthdm/hollywood/actor"
+)
+
+// 매치가 시작된 뒤 참가자가 입장하기를 기다리는 시간 (지나도 오지 않으면 기권)
+const arenaJoinTimeout = 30 * time.Second
+
+// 매치 인스턴스 설정 (매치메이커 -> 인스턴스 관리자 -> 인스턴스 존)
+type arenaMatch struct {
+	MatchID    int64
+	Teams      [matchTeams][]string // 팀별 사용자명
+	TimeLimit  time.Duration
+	Matchmaker *actor.PID // 결과(matchFinished)를 받을 액터
+}
+
+// 진행 중인 매치. 팀원이 모두 쓰러져 있거나(부활 전) 떠난 팀은 진다.
+// 마지막으로 남은 팀이 이기고, 제한 시간이 지나면 무승부로 끝난다.
+type arenaState struct {
+	arenaMatch
+	startedAt time.Time
+	left      map[string]bool // 나갔거나 접속이 끊긴 참가자 (돌아와도 기권으로 본다)
+	finished  bool
+}
+
+func (z *Zone) startMatch(msg arenaMatch) {
+	z.match = &arenaState{arenaMatch: msg, startedAt: time.Now(), left: make(map[string]bool)}
+	fmt.Printf("zone %s: match %d started %v\n", z.def.ID, msg.MatchID, msg.Teams)
+}
+
+// 참가자가 존을 떠남 (퇴장, 접속 종료, 존 이동)
+func (z *Zone) leftMatch(e *entity) {
+	if z.match != nil && e.session != nil {
+		z.match.left[e.name] = true
+	}
+}
+
+// 틱마다 승패 판정
+func (z *Zone) updateMatch(c *actor.Context, now time.Time) {
+	a := z.match
+	if a == nil || a.finished {
+		return
+	}
+	if now.Sub(a.startedAt) >= a.TimeLimit {
+		z.endMatch(c, -1, types.MatchEndTimeout)
+		return
+	}
+	players := make(map[string]*entity)
+	for _, e := range z.entities {
+		if e.session != nil {
+			players[e.name] = e
+		}
+	}
+	arriving := now.Sub(a.startedAt) < arenaJoinTimeout
+	winner, standing, forfeit := -1, 0, true
+	for team, names := range a.Teams {
+		up, present := false, false
+		for _, name := range names {
+			if a.left[name] {
+				continue
+			}
+			e, ok := players[name]
+			switch {
+			case !ok:
+				up = up || arriving
+			case !e.dead():
+				up, present = true, true
+			default:
+				present = true
+			}
+		}
+		if up {
+			winner = team
+			standing++
+		} else if present {
+			forfeit = false
+		}
+	}
+	switch standing {
+	case 0:
+		z.endMatch(c, -1, matchEndReason(forfeit))
+	case 1:
+		z.endMatch(c, winner, matchEndReason(forfeit))
+	}
+}
+
+// 진 팀이 모두 떠났으면 기권, 한 명이라도 남아 쓰러졌으면 전멸
+func matchEndReason(forfeit bool) string {
+	if forfeit {
+		return types.MatchEndForfeit
+	}
+	return types.MatchEndEliminated
+}
+
+// 결과를 매치메이커에 보고하고 존 안의 참가자에게 알린다. 참가자는 instanceLeave 로 나간다.
+func (z *Zone) endMatch(c *actor.Context, winner int, reason string) {
+	a := z.match
+	a.finished = true
+	c.Send(a.Matchmaker, matchFinished{MatchID: a.MatchID, WinningTeam: winner})
+	ended := types.MatchEnded{MatchID: a.MatchID, WinningTeam: winner, Reason: reason}
+	for _, e := range z.entities {
+		z.send(c, e, "matchEnded", ended)
+	}
+	fmt.Printf("zone %s: match %d ended (winning team %d, %s)\n", z.def.ID, a.MatchID, winner, reason)
+}
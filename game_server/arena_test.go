@@ -0,0 +1,126 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/SilverSS/gameserver/types"
+	"github.com/anthdm/hollywood/actor"
+)
+
+// p1 과 p2 의 1대1 매치. 결과(matchFinished)는 harness 의 session 이 받는다.
+func newArenaZone(h *combatHarness) *Zone {
+	z := h.newZone(testZoneDef("arena", types.Vector{}), testCombatRules())
+	z.startMatch(arenaMatch{
+		MatchID:    7,
+		Teams:      [matchTeams][]string{{"p1"}, {"p2"}},
+		TimeLimit:  time.Minute,
+		Matchmaker: h.session,
+	})
+	return z
+}
+
+func matchResults(msgs []any) []matchFinished {
+	var out []matchFinished
+	for _, m := range msgs {
+		if f, ok := m.(matchFinished); ok {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+func TestArenaMatchResult(t *testing.T) {
+	tests := []struct {
+		name   string
+		play   func(h *combatHarness, z *Zone)
+		winner int
+		reason string
+	}{
+		{"eliminated", func(h *combatHarness, z *Zone) {
+			h.do(func(c *actor.Context) { z.damage(c, hitSource{entityID: 1}, z.entities[2], 150, time.Now()) })
+		}, 0, types.MatchEndEliminated},
+		{"forfeit", func(h *combatHarness, z *Zone) {
+			h.do(func(c *actor.Context) { z.remove(c, 1) })
+		}, 1, types.MatchEndForfeit},
+		{"timeout", func(h *combatHarness, z *Zone) {
+			z.match.startedAt = time.Now().Add(-time.Minute)
+		}, -1, types.MatchEndTimeout},
+		{"both down", func(h *combatHarness, z *Zone) {
+			h.do(func(c *actor.Context) {
+				z.damage(c, hitSource{entityID: 2}, z.entities[1], 150, time.Now())
+				z.remove(c, 2)
+			})
+		}, -1, types.MatchEndEliminated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newCombatHarness(t)
+			z := newArenaZone(h)
+			h.enter(z, 1, types.Vector{})
+			h.enter(z, 2, types.Vector{X: 2})
+			h.do(func(c *actor.Context) { z.updateMatch(c, time.Now()) })
+			if got := matchResults(h.messages()); len(got) != 0 {
+				t.Fatalf("match ended before anything happened: %+v", got)
+			}
+
+			tt.play(h, z)
+			h.do(func(c *actor.Context) { z.updateMatch(c, time.Now()) })
+
+			msgs := h.messages()
+			got := matchResults(msgs)
+			if len(got) != 1 || got[0].MatchID != 7 || got[0].WinningTeam != tt.winner {
+				t.Fatalf("matchFinished = %+v, want winning team %d", got, tt.winner)
+			}
+			ended := wsData[types.MatchEnded](msgs, "matchEnded")
+			if len(ended) == 0 || ended[0].WinningTeam != tt.winner || ended[0].Reason != tt.reason {
+				t.Errorf("matchEnded = %+v, want team %d (%s)", ended, tt.winner, tt.reason)
+			}
+
+			// 결과는 한 번만 보고한다
+			h.do(func(c *actor.Context) { z.updateMatch(c, time.Now()) })
+			if got := matchResults(h.messages()); len(got) != 0 {
+				t.Errorf("result reported again: %+v", got)
+			}
+		})
+	}
+}
+
+func TestArenaWaitsForArrivals(t *testing.T) {
+	h := newCombatHarness(t)
+	z := newArenaZone(h)
+	h.enter(z, 1, types.Vector{})
+
+	// 입장 대기 시간 동안은 아직 오지 않은 팀도 살아 있는 것으로 본다
+	h.do(func(c *actor.Context) { z.updateMatch(c, time.Now()) })
+	if got := matchResults(h.messages()); len(got) != 0 {
+		t.Fatalf("match ended while p2 was still arriving: %+v", got)
+	}
+
+	h.do(func(c *actor.Context) { z.updateMatch(c, z.match.startedAt.Add(arenaJoinTimeout)) })
+	got := matchResults(h.messages())
+	if len(got) != 1 || got[0].WinningTeam != 0 {
+		t.Errorf("matchFinished = %+v, want team 0 to win by forfeit", got)
+	}
+}
+
+func TestArenaLeaverCannotReturn(t *testing.T) {
+	h := newCombatHarness(t)
+	z := newArenaZone(h)
+	z.match.Teams = [matchTeams][]string{{"p1", "p3"}, {"p2"}}
+	h.enter(z, 1, types.Vector{})
+	h.enter(z, 2, types.Vector{X: 2})
+	h.enter(z, 3, types.Vector{X: -2})
+
+	// p3 가 나갔다 돌아와도 팀에 세지 않는다
+	h.do(func(c *actor.Context) { z.remove(c, 3) })
+	h.enter(z, 3, types.Vector{X: -2})
+	h.do(func(c *actor.Context) {
+		z.damage(c, hitSource{entityID: 2}, z.entities[1], 150, time.Now())
+		z.updateMatch(c, time.Now())
+	})
+	got := matchResults(h.messages())
+	if len(got) != 1 || got[0].WinningTeam != 1 {
+		t.Errorf("matchFinished = %+v, want team 1 to win", got)
+	}
+}
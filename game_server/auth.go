@@ -0,0 +1,176 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"time"
+
+	"github.com/SilverSS/gameserver/ent"
+	"github.com/SilverSS/gameserver/ent/user"
+	"github.com/SilverSS/gameserver/types"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var jwtSecret = []byte("your_secret_key")
+
+// 회원가입 사용자명/비밀번호 정책 (main 에서 설정값으로 초기화)
+var credentialPolicy = defaultCredentialPolicy()
+
+// 인증 요청 본문 최대 크기
+const maxAuthBodyBytes = 1 << 16
+
+func hashPassword(pw string) string {
+	h := sha256.Sum256([]byte(pw))
+	return fmt.Sprintf("%x", h[:])
+}
+
+// JWT 토큰 생성
+func createJWT(username string) (string, error) {
+	claims := jwt.MapClaims{
+		"username": username,
+		"exp":      time.Now().Add(time.Hour * 24).Unix(),
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(jwtSecret)
+}
+
+// JWT 토큰 검증
+func verifyJWT(tokenString string) (string, error) {
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method")
+		}
+		return jwtSecret, nil
+	})
+	if err != nil || !token.Valid {
+		return "", fmt.Errorf("invalid token")
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return "", fmt.Errorf("invalid claims")
+	}
+	username, ok := claims["username"].(string)
+	if !ok {
+		return "", fmt.Errorf("username not found in token")
+	}
+	return username, nil
+}
+
+// 요청 본문을 username/password 로 해석한다.
+// Content-Type 이 application/json 이면 JSON 으로, 그 외에는 form 값으로 읽는다.
+func decodeCredentials(w http.ResponseWriter, r *http.Request) (username, password string, err error) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxAuthBodyBytes)
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType == "application/json" {
+		var req types.LoginRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			return "", "", err
+		}
+		return req.Username, req.Password, nil
+	}
+	if err := r.ParseForm(); err != nil {
+		return "", "", err
+	}
+	return r.FormValue("username"), r.FormValue("password"), nil
+}
+
+// 유틸: JSON 응답 전송 (Content-Type, 상태 코드 설정)
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeRegisterError(w http.ResponseWriter, status int, code, msg string) {
+	writeJSON(w, status, types.RegisterResponse{Success: false, Code: code, Message: msg})
+}
+
+func writeLoginError(w http.ResponseWriter, status int, code, msg string) {
+	writeJSON(w, status, types.LoginResponse{Success: false, Code: code, Message: msg})
+}
+
+// 회원가입 HTTP 핸들러
+func handleRegister(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeRegisterError(w, http.StatusMethodNotAllowed, types.ErrCodeMethodNotAllowed, "허용되지 않는 메서드입니다.")
+		return
+	}
+	username, password, err := decodeCredentials(w, r)
+	if err != nil {
+		fmt.Printf("[REGISTER] 요청 본문 해석 실패: %v\n", err)
+		writeRegisterError(w, http.StatusBadRequest, types.ErrCodeInvalidRequest, "잘못된 요청 형식입니다.")
+		return
+	}
+	fmt.Printf("[REGISTER] Content-Type: %s, username: %s\n", r.Header.Get("Content-Type"), username)
+	username, fieldErrs := credentialPolicy.validateRegistration(username, password)
+	if len(fieldErrs) > 0 {
+		writeJSON(w, http.StatusBadRequest, types.RegisterResponse{
+			Success: false,
+			Code:    types.ErrCodeValidation,
+			Message: "입력값이 가입 조건에 맞지 않습니다.",
+			Errors:  fieldErrs,
+		})
+		return
+	}
+	_, err = createAccount(r.Context(), globalDBClient, username, hashPassword(password))
+	if errors.Is(err, errUsernameTaken) {
+		fmt.Printf("[REGISTER] 이미 존재하는 사용자명: %s\n", username)
+		writeRegisterError(w, http.StatusConflict, types.ErrCodeUsernameTaken, "이미 존재하는 사용자명입니다.")
+		return
+	}
+	if err != nil {
+		fmt.Printf("[REGISTER] 계정 생성 실패: %v\n", err)
+		writeRegisterError(w, http.StatusInternalServerError, types.ErrCodeInternal, "일시적인 오류로 가입하지 못했습니다. 잠시 후 다시 시도해 주세요.")
+		return
+	}
+	writeJSON(w, http.StatusCreated, types.RegisterResponse{Success: true, Message: "회원가입 성공!"})
+}
+
+// 로그인 HTTP 핸들러
+func handleLogin(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeLoginError(w, http.StatusMethodNotAllowed, types.ErrCodeMethodNotAllowed, "허용되지 않는 메서드입니다.")
+		return
+	}
+	username, password, err := decodeCredentials(w, r)
+	if err != nil {
+		fmt.Printf("[LOGIN] 요청 본문 해석 실패: %v\n", err)
+		writeLoginError(w, http.StatusBadRequest, types.ErrCodeInvalidRequest, "잘못된 요청 형식입니다.")
+		return
+	}
+	fmt.Printf("[LOGIN] Content-Type: %s, username: %s\n", r.Header.Get("Content-Type"), username)
+	if username == "" || password == "" {
+		fmt.Printf("[LOGIN] 입력값 누락: username=%s\n", username)
+		writeLoginError(w, http.StatusBadRequest, types.ErrCodeMissingFields, "필수 입력값 누락")
+		return
+	}
+	u, err := globalDBClient.User.Query().Where(user.UsernameKeyEQ(usernameKey(username))).First(r.Context())
+	if ent.IsNotFound(err) {
+		fmt.Printf("[LOGIN] 존재하지 않는 계정: %s\n", username)
+		writeLoginError(w, http.StatusUnauthorized, types.ErrCodeUserNotFound, "존재하지 않는 계정입니다.")
+		return
+	}
+	if err != nil {
+		fmt.Printf("[LOGIN] 계정 조회 실패: %v\n", err)
+		writeLoginError(w, http.StatusInternalServerError, types.ErrCodeInternal, "일시적인 오류로 로그인하지 못했습니다. 잠시 후 다시 시도해 주세요.")
+		return
+	}
+	if u.PasswordHash != hashPassword(password) {
+		fmt.Printf("[LOGIN] 비밀번호 불일치: username=%s\n", username)
+		writeLoginError(w, http.StatusUnauthorized, types.ErrCodeWrongPassword, "비밀번호가 일치하지 않습니다.")
+		return
+	}
+	token, err := createJWT(u.Username)
+	if err != nil {
+		fmt.Printf("[LOGIN] 토큰 생성 실패: %v\n", err)
+		writeLoginError(w, http.StatusInternalServerError, types.ErrCodeInternal, "토큰 생성 실패")
+		return
+	}
+	writeJSON(w, http.StatusOK, types.LoginResponse{Success: true, Message: "로그인 성공", Token: token})
+}
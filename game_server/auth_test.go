@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	stdsql "database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/SilverSS/gameserver/ent"
+	"github.com/SilverSS/gameserver/ent/enttest"
+	"github.com/SilverSS/gameserver/ent/user"
+	"github.com/SilverSS/gameserver/types"
+)
+
+// 테스트마다 새로 만드는 메모리 sqlite DB (ent 스키마로 자동 생성).
+// 메모리 DB 는 연결마다 따로 생기므로 고루틴에서 써도 같은 DB 를 보도록 연결을 하나로 묶는다.
+func openTestDB(t *testing.T) *ent.Client {
+	t.Helper()
+	db, err := stdsql.Open(dialect.SQLite, "file:"+t.Name()+"?mode=memory&_fk=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.SetMaxOpenConns(1)
+	client := enttest.NewClient(t, enttest.WithOptions(ent.Driver(sql.OpenDB(dialect.SQLite, db))))
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+// 핸들러가 쓰는 전역 DB 를 테스트 DB 로 바꾼다
+func useTestDB(t *testing.T) *ent.Client {
+	t.Helper()
+	client := openTestDB(t)
+	old := globalDBClient
+	globalDBClient = client
+	t.Cleanup(func() { globalDBClient = old })
+	return client
+}
+
+func postJSON(t *testing.T, h http.HandlerFunc, username, password string, out any) int {
+	t.Helper()
+	body, _ := json.Marshal(types.LoginRequest{Username: username, Password: password})
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(string(body)))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	h(rec, req)
+	if err := json.NewDecoder(rec.Body).Decode(out); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+	return rec.Code
+}
+
+func TestCreateAccount(t *testing.T) {
+	client := openTestDB(t)
+	ctx := context.Background()
+
+	u, err := createAccount(ctx, client, "Alice", "hash")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.UsernameKey != usernameKey("Alice") {
+		t.Errorf("username_key = %q, want %q", u.UsernameKey, usernameKey("Alice"))
+	}
+	chars, err := client.User.QueryCharacters(u).All(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(chars) != 1 || chars[0].Name != "Alice" {
+		t.Errorf("characters = %v, want one named Alice", chars)
+	}
+
+	// 대소문자만 다른 이름은 같은 사용자명으로 보고, 실패한 가입은 아무것도 남기지 않는다
+	if _, err := createAccount(ctx, client, "ALICE", "hash"); !errors.Is(err, errUsernameTaken) {
+		t.Errorf("duplicate: err = %v, want errUsernameTaken", err)
+	}
+	if n := client.User.Query().CountX(ctx); n != 1 {
+		t.Errorf("users = %d, want 1", n)
+	}
+	if n := client.Character.Query().CountX(ctx); n != 1 {
+		t.Errorf("characters = %d, want 1", n)
+	}
+}
+
+func TestRegisterAndLogin(t *testing.T) {
+	client := useTestDB(t)
+
+	var reg types.RegisterResponse
+	if code := postJSON(t, handleRegister, "Alice", "password1", &reg); code != http.StatusCreated || !reg.Success {
+		t.Fatalf("register: %d %+v", code, reg)
+	}
+	if !client.User.Query().Where(user.UsernameKeyEQ(usernameKey("alice"))).ExistX(context.Background()) {
+		t.Error("registered user not stored")
+	}
+	if code := postJSON(t, handleRegister, "alice", "password1", &reg); code != http.StatusConflict || reg.Code != types.ErrCodeUsernameTaken {
+		t.Errorf("duplicate register: %d %+v", code, reg)
+	}
+	if code := postJSON(t, handleRegister, "a", "password1", &reg); code != http.StatusBadRequest || reg.Code != types.ErrCodeValidation {
+		t.Errorf("invalid register: %d %+v", code, reg)
+	}
+
+	tests := []struct {
+		name     string
+		username string
+		password string
+		status   int
+		code     string
+	}{
+		{"ok", "Alice", "password1", http.StatusOK, ""},
+		{"case insensitive", "aLiCe", "password1", http.StatusOK, ""},
+		{"wrong password", "Alice", "password2", http.StatusUnauthorized, types.ErrCodeWrongPassword},
+		{"unknown user", "bob", "password1", http.StatusUnauthorized, types.ErrCodeUserNotFound},
+		{"missing fields", "Alice", "", http.StatusBadRequest, types.ErrCodeMissingFields},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var res types.LoginResponse
+			status := postJSON(t, handleLogin, tt.username, tt.password, &res)
+			if status != tt.status || res.Code != tt.code {
+				t.Fatalf("login: %d %+v, want %d %q", status, res, tt.status, tt.code)
+			}
+			if tt.status != http.StatusOK {
+				return
+			}
+			name, err := verifyJWT(res.Token)
+			if err != nil || name != "Alice" {
+				t.Errorf("token subject = %q, %v; want the canonical username Alice", name, err)
+			}
+		})
+	}
+}
+
+func TestLoginFormBody(t *testing.T) {
+	useTestDB(t)
+	var reg types.RegisterResponse
+	postJSON(t, handleRegister, "Alice", "password1", &reg)
+
+	form := url.Values{"username": {"Alice"}, "password": {"password1"}}
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec := httptest.NewRecorder()
+	handleLogin(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Errorf("form login: %d %s", rec.Code, rec.Body)
+	}
+}
+
+func TestLoginDBError(t *testing.T) {
+	client := useTestDB(t)
+	var reg types.RegisterResponse
+	postJSON(t, handleRegister, "Alice", "password1", &reg)
+	client.Close()
+
+	var res types.LoginResponse
+	if status := postJSON(t, handleLogin, "Alice", "password1", &res); status != http.StatusInternalServerError || res.Code != types.ErrCodeInternal {
+		t.Errorf("login with a closed DB: %d %+v, want 500 %s", status, res, types.ErrCodeInternal)
+	}
+}
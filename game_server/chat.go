@@ -0,0 +1,559 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/SilverSS/gameserver/ent"
+	"github.com/SilverSS/gameserver/types"
+	"github.com/anthdm/hollywood/actor"
+)
+
+// 채팅 제한
+const (
+	maxChatLen          = 200                // 메시지 최대 글자 수
+	chatBurst           = 5                  // 연속으로 보낼 수 있는 메시지 수
+	chatRefill          = time.Second        // 보낼 수 있는 메시지가 하나 회복되는 시간
+	proximityChatRadius = 30                 // proximity 채널 거리 (X/Z 평면)
+	maxMuteDuration     = 7 * 24 * time.Hour // GM mute 최대 시간
+)
+
+// 채팅 기록 저장/정리 주기
+const (
+	chatFlushInterval = time.Second
+	chatPurgeInterval = time.Hour
+	chatDBTimeout     = 5 * time.Second
+)
+
+// 채팅 액터 메시지 (세션 -> 채팅)
+type (
+	chatSend struct {
+		Session  *actor.PID
+		Username string
+		EntityID int64
+		Zone     *actor.PID // 보낸 사람이 있는 존 (존 이동 중이면 nil)
+		ZoneID   string
+		Req      types.ChatSendRequest
+	}
+
+	// 존 입장 알림: 존 채널 기록을 보낸다
+	chatZoneJoined struct {
+		Session *actor.PID
+		ZoneID  string
+	}
+
+	gmCommand struct {
+		Session  *actor.PID
+		Username string
+		Req      types.GMCommandRequest
+	}
+
+	// 서버 공지 (서버 코드 -> 채팅). To 가 비어 있으면 전체.
+	systemMessage struct {
+		To   string
+		Text string
+	}
+
+	// 접속한 플레이어의 차단 관계 (읽기 고루틴 -> 채팅)
+	blocksLoaded struct {
+		Username string
+		Session  *actor.PID
+		Blocked  []string // 이 플레이어가 차단한 사용자들
+		Blockers []string // 이 플레이어를 차단한 사용자들
+		Err      error
+	}
+
+	// 접속하지 않은 플레이어에게 보낸 귓속말 저장 결과 (저장 고루틴 -> 채팅)
+	whisperStored struct {
+		Session *actor.PID
+		Msg     types.ChatMessage
+		Err     error
+	}
+
+	chatFlush struct{}
+	chatPurge struct{}
+)
+
+// DB 에 저장할 채널 기록
+type chatRecord struct {
+	ZoneID string
+	Msg    types.ChatMessage
+}
+
+// 채널 기록 키 (zone 채널은 존마다 따로)
+func chatChannelKey(channel, zoneID string) string {
+	if zoneID == "" {
+		return channel
+	}
+	return channel + ":" + zoneID
+}
+
+// 존 안 채팅 전달 (채팅 -> 존). Radius 가 0 이면 존 전체.
+// Blocked 는 보낸 사람을 차단한 사용자명으로, 존이 이들에게는 보내지 않는다.
+type zoneChat struct {
+	Sender  int64
+	Radius  float32
+	Blocked map[string]bool
+	Msg     types.ChatMessage
+}
+
+// 사용자별 전송 제한 (토큰 버킷)
+type chatLimiter struct {
+	tokens float64
+	last   time.Time
+}
+
+// 메시지를 보낼 수 있으면 토큰 하나를 쓰고 true, 아니면 다음 토큰까지 남은 시간
+func (l *chatLimiter) allow(now time.Time) (bool, time.Duration) {
+	l.tokens = min(chatBurst, l.tokens+float64(now.Sub(l.last))/float64(chatRefill))
+	l.last = now
+	if l.tokens < 1 {
+		return false, time.Duration((1 - l.tokens) * float64(chatRefill))
+	}
+	l.tokens--
+	return true, 0
+}
+
+// 채팅 액터: 글로벌/귓속말은 직접 보내고, 존/근거리 채팅은 검사 후 보낸 사람의 존에, 파티/길드 채팅은 파티/길드 액터에 맡긴다.
+// 채팅 금지와 전송 제한은 이 액터 안에서만 다룬다. 차단은 친구 액터가 DB 에 저장하고, 이 액터는 접속 중인 플레이어의
+// 차단 관계를 접속할 때 읽어 두었다가 blockChanged 로 맞춘다.
+// 글로벌과 상시 존 채널은 최근 기록을 메모리에 두고 모아서 DB 에 저장한다 (인스턴스 존은 저장하지 않음).
+type Chat struct {
+	db        *ent.Client
+	cfg       ChatConfig
+	zones     map[string]*zoneDef // 기록을 남기는 상시 존
+	filter    chatFilter
+	gms       map[string]bool
+	parties   *actor.PID // 파티 채널은 파티 액터가 파티원에게 보낸다
+	guilds    *actor.PID // 길드 채널은 길드 액터가 길드원에게 보낸다
+	online    onlinePlayers
+	names     map[string]string          // usernameKey -> 접속 중인 정식 사용자명
+	blocks    map[string]map[string]bool // 접속 중인 사용자 -> 차단한 사용자들
+	blockedBy map[string]map[string]bool // 접속 중인 사용자 -> 그를 차단한 사용자들
+	muted     map[string]time.Time       // usernameKey -> 채팅 금지 해제 시각
+	limits    map[string]*chatLimiter
+
+	history   map[string][]types.ChatMessage // 채널 키 -> 최근 기록 (오래된 것부터)
+	unsaved   []chatRecord                   // 아직 DB 에 저장하지 않은 기록
+	repeaters []actor.SendRepeater
+}
+
+func newChat(db *ent.Client, cfg ChatConfig, zones map[string]*zoneDef, filter chatFilter, gms map[string]bool, parties, guilds *actor.PID) actor.Producer {
+	return func() actor.Receiver {
+		return &Chat{
+			db:        db,
+			cfg:       cfg,
+			zones:     zones,
+			filter:    filter,
+			gms:       gms,
+			parties:   parties,
+			guilds:    guilds,
+			online:    make(onlinePlayers),
+			names:     make(map[string]string),
+			blocks:    make(map[string]map[string]bool),
+			blockedBy: make(map[string]map[string]bool),
+			muted:     make(map[string]time.Time),
+			limits:    make(map[string]*chatLimiter),
+			history:   make(map[string][]types.ChatMessage),
+		}
+	}
+}
+
+func (ch *Chat) Receive(c *actor.Context) {
+	switch msg := c.Message().(type) {
+	case actor.Started:
+		c.Engine().Subscribe(c.PID())
+		ch.loadHistory()
+		ch.repeaters = append(ch.repeaters,
+			c.SendRepeat(c.PID(), chatFlush{}, chatFlushInterval),
+			c.SendRepeat(c.PID(), chatPurge{}, chatPurgeInterval))
+		c.Send(c.PID(), chatPurge{})
+	case actor.Stopped:
+		for _, r := range ch.repeaters {
+			r.Stop()
+		}
+		c.Engine().Unsubscribe(c.PID())
+		ch.flush(false)
+	case playerOnline:
+		ch.online.apply(msg)
+		ch.names[usernameKey(msg.Username)] = msg.Username
+		ch.welcome(c, msg)
+		ch.loadBlocks(c, msg)
+	case playerOffline:
+		if pid, ok := ch.online[msg.Username]; ok && pid.Equals(msg.Session) {
+			delete(ch.limits, msg.Username)
+			delete(ch.names, usernameKey(msg.Username))
+			delete(ch.blocks, msg.Username)
+			delete(ch.blockedBy, msg.Username)
+		}
+		ch.online.apply(msg)
+	case blocksLoaded:
+		ch.blocksLoaded(msg)
+	case blockChanged:
+		ch.blockChanged(msg)
+	case chatZoneJoined:
+		if _, ok := ch.zones[msg.ZoneID]; ok {
+			c.Send(msg.Session, wsSend{Type: "chatHistory", Data: types.ChatHistory{
+				Channel:  types.ChatZone,
+				ZoneID:   msg.ZoneID,
+				Messages: ch.recent(chatChannelKey(types.ChatZone, msg.ZoneID)),
+			}})
+		}
+	case whisperStored:
+		ch.whisperStored(c, msg)
+	case chatFlush:
+		ch.flush(true)
+	case chatPurge:
+		ch.purge()
+	case chatSend:
+		ch.send(c, msg)
+	case gmCommand:
+		ch.gmCommand(c, msg)
+	case systemMessage:
+		ch.system(c, msg.To, msg.Text)
+	}
+}
+
+func (ch *Chat) send(c *actor.Context, msg chatSend) {
+	text, code := ch.clean(msg.Req.Text)
+	if code != "" {
+		ch.reply(c, msg.Session, types.ChatActionSend, code, 0)
+		return
+	}
+	now := time.Now()
+	if until, ok := ch.muted[usernameKey(msg.Username)]; ok {
+		if now.Before(until) {
+			ch.reply(c, msg.Session, types.ChatActionSend, types.ChatErrMuted, until.Sub(now))
+			return
+		}
+		delete(ch.muted, usernameKey(msg.Username))
+	}
+	l, ok := ch.limits[msg.Username]
+	if !ok {
+		l = &chatLimiter{tokens: chatBurst, last: now}
+		ch.limits[msg.Username] = l
+	}
+	if ok, wait := l.allow(now); !ok {
+		ch.reply(c, msg.Session, types.ChatActionSend, types.ChatErrRateLimited, wait)
+		return
+	}
+
+	out := types.ChatMessage{Channel: msg.Req.Channel, From: msg.Username, Text: ch.filter.Filter(text), Time: now.UnixMilli()}
+	switch msg.Req.Channel {
+	case types.ChatGlobal:
+		for name, pid := range ch.online {
+			if !ch.blocks[name][msg.Username] {
+				c.Send(pid, wsSend{Type: "chatMessage", Data: out})
+			}
+		}
+		ch.record("", out)
+	case types.ChatZone, types.ChatProximity:
+		if msg.Zone == nil {
+			ch.reply(c, msg.Session, types.ChatActionSend, types.ChatErrNotInZone, 0)
+			return
+		}
+		if _, ok := ch.zones[msg.ZoneID]; ok && msg.Req.Channel == types.ChatZone {
+			ch.record(msg.ZoneID, out)
+		}
+		zc := zoneChat{Sender: msg.EntityID, Blocked: ch.blockers(msg.Username), Msg: out}
+		if msg.Req.Channel == types.ChatProximity {
+			zc.Radius = proximityChatRadius
+		}
+		c.Send(msg.Zone, zc)
+	case types.ChatWhisper:
+		out.To = msg.Req.To
+		to, target, ok := ch.lookup(msg.Req.To)
+		if !ok {
+			ch.storeWhisper(c, msg.Session, out)
+			return
+		}
+		out.To = to
+		// 차단당했어도 보낸 사람에게는 알리지 않는다
+		if !ch.blocks[to][msg.Username] {
+			c.Send(target, wsSend{Type: "chatMessage", Data: out})
+		}
+		if !target.Equals(msg.Session) {
+			c.Send(msg.Session, wsSend{Type: "chatMessage", Data: out})
+		}
+	case types.ChatParty:
+		// 파티원이 아니면 파티 액터가 not_in_party 로 응답한다
+		c.Send(ch.parties, partyChat{Session: msg.Session, Username: msg.Username, Blocked: ch.blockers(msg.Username), Msg: out})
+	case types.ChatGuild:
+		// 길드원이 아니면 길드 액터가 not_in_guild 로 응답한다
+		c.Send(ch.guilds, guildChat{Session: msg.Session, Username: msg.Username, Blocked: ch.blockers(msg.Username), Msg: out})
+	default:
+		ch.reply(c, msg.Session, types.ChatActionSend, types.ChatErrUnknownChannel, 0)
+	}
+}
+
+// 접속 중인 플레이어의 정식 사용자명과 세션 (대소문자 등은 usernameKey 로 맞춘다)
+func (ch *Chat) lookup(username string) (string, *actor.PID, bool) {
+	name, ok := ch.names[usernameKey(username)]
+	if !ok {
+		return "", nil, false
+	}
+	pid, ok := ch.online[name]
+	return name, pid, ok
+}
+
+// 보낸 사람을 차단한 사용자들. 차단 목록은 이 액터의 상태이므로 다른 액터에는 복사해서 넘긴다.
+func (ch *Chat) blockers(username string) map[string]bool {
+	blocked := make(map[string]bool, len(ch.blockedBy[username]))
+	for name := range ch.blockedBy[username] {
+		blocked[name] = true
+	}
+	return blocked
+}
+
+// 채널 기록 추가 (메모리는 HistorySize 개만 유지, DB 저장은 chatFlush 에서)
+func (ch *Chat) record(zoneID string, msg types.ChatMessage) {
+	if ch.cfg.HistorySize <= 0 {
+		return
+	}
+	key := chatChannelKey(msg.Channel, zoneID)
+	h := append(ch.history[key], msg)
+	if len(h) > ch.cfg.HistorySize {
+		h = append([]types.ChatMessage(nil), h[len(h)-ch.cfg.HistorySize:]...)
+	}
+	ch.history[key] = h
+	ch.unsaved = append(ch.unsaved, chatRecord{ZoneID: zoneID, Msg: msg})
+}
+
+// 채널 최근 기록 사본 (다른 액터로 보내므로 복사)
+func (ch *Chat) recent(key string) []types.ChatMessage {
+	return append([]types.ChatMessage{}, ch.history[key]...)
+}
+
+// 서버 시작 시 글로벌과 상시 존 채널의 최근 기록을 DB 에서 읽는다.
+func (ch *Chat) loadHistory() {
+	if ch.cfg.HistorySize <= 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), chatDBTimeout)
+	defer cancel()
+	load := func(channel, zoneID string) {
+		msgs, err := loadChatHistory(ctx, ch.db, channel, zoneID, ch.cfg.HistorySize)
+		if err != nil {
+			fmt.Printf("chat: loading %s history failed: %v\n", chatChannelKey(channel, zoneID), err)
+			return
+		}
+		if len(msgs) > 0 {
+			ch.history[chatChannelKey(channel, zoneID)] = msgs
+		}
+	}
+	load(types.ChatGlobal, "")
+	for id := range ch.zones {
+		load(types.ChatZone, id)
+	}
+}
+
+// 모아 둔 기록을 DB 에 저장 (async 면 고루틴에서)
+func (ch *Chat) flush(async bool) {
+	if len(ch.unsaved) == 0 {
+		return
+	}
+	recs := ch.unsaved
+	ch.unsaved = nil
+	save := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), chatDBTimeout)
+		defer cancel()
+		if err := saveChatMessages(ctx, ch.db, recs); err != nil {
+			fmt.Printf("chat: saving %d messages failed: %v\n", len(recs), err)
+		}
+	}
+	if async {
+		go save()
+		return
+	}
+	save()
+}
+
+// 보관 기간이 지난 기록 삭제
+func (ch *Chat) purge() {
+	db, cfg := ch.db, ch.cfg
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), chatDBTimeout)
+		defer cancel()
+		n, err := purgeChatMessages(ctx, db, time.Now(), cfg.Retention, cfg.WhisperRetention)
+		if err != nil {
+			fmt.Printf("chat: purging old messages failed: %v\n", err)
+			return
+		}
+		if n > 0 {
+			fmt.Printf("chat: purged %d old messages\n", n)
+		}
+	}()
+}
+
+// 접속 직후: 글로벌 채널 기록과 접속하지 않은 동안 받은 귓속말을 보낸다.
+func (ch *Chat) welcome(c *actor.Context, msg playerOnline) {
+	c.Send(msg.Session, wsSend{Type: "chatHistory", Data: types.ChatHistory{
+		Channel:  types.ChatGlobal,
+		Messages: ch.recent(types.ChatGlobal),
+	}})
+	db, engine := ch.db, c.Engine()
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), chatDBTimeout)
+		defer cancel()
+		msgs, err := takeOfflineWhispers(ctx, db, msg.Username)
+		if err != nil {
+			fmt.Printf("chat: loading offline whispers for %s failed: %v\n", msg.Username, err)
+			return
+		}
+		if len(msgs) > 0 {
+			engine.Send(msg.Session, wsSend{Type: "chatHistory", Data: types.ChatHistory{Channel: types.ChatWhisper, Messages: msgs}})
+		}
+	}()
+}
+
+// 접속하지 않은 플레이어에게 보낸 귓속말은 DB 에 저장해 두고 다음 접속 때 전달한다.
+func (ch *Chat) storeWhisper(c *actor.Context, session *actor.PID, msg types.ChatMessage) {
+	db, max, self, engine := ch.db, ch.cfg.MaxOfflineWhispers, c.PID(), c.Engine()
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), chatDBTimeout)
+		defer cancel()
+		to, err := storeOfflineWhisper(ctx, db, msg, max)
+		if err == nil {
+			msg.To = to
+		}
+		msg.Offline = true
+		engine.Send(self, whisperStored{Session: session, Msg: msg, Err: err})
+	}()
+}
+
+func (ch *Chat) whisperStored(c *actor.Context, msg whisperStored) {
+	switch {
+	case msg.Err == nil:
+		c.Send(msg.Session, wsSend{Type: "chatMessage", Data: msg.Msg})
+	case errors.Is(msg.Err, errUnknownPlayer):
+		ch.reply(c, msg.Session, types.ChatActionSend, types.ChatErrUnknownPlayer, 0)
+	case errors.Is(msg.Err, errMailboxFull):
+		ch.reply(c, msg.Session, types.ChatActionSend, types.ChatErrMailboxFull, 0)
+	default:
+		fmt.Printf("chat: storing offline whisper to %s failed: %v\n", msg.Msg.To, msg.Err)
+		ch.reply(c, msg.Session, types.ChatActionSend, types.ErrCodeInternal, 0)
+	}
+}
+
+// 제어 문자를 공백으로 바꾸고 앞뒤 공백을 없앤 뒤 길이 검사
+func (ch *Chat) clean(text string) (string, string) {
+	text = strings.TrimSpace(strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return ' '
+		}
+		return r
+	}, text))
+	switch n := utf8.RuneCountInString(text); {
+	case n == 0:
+		return "", types.ChatErrEmpty
+	case n > maxChatLen:
+		return "", types.ChatErrTooLong
+	}
+	return text, ""
+}
+
+// 접속한 플레이어의 차단 관계를 읽는다
+func (ch *Chat) loadBlocks(c *actor.Context, msg playerOnline) {
+	db, self, engine := ch.db, c.PID(), c.Engine()
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), chatDBTimeout)
+		defer cancel()
+		blocked, blockers, err := loadBlocks(ctx, db, msg.Username)
+		engine.Send(self, blocksLoaded{Username: msg.Username, Session: msg.Session, Blocked: blocked, Blockers: blockers, Err: err})
+	}()
+}
+
+func (ch *Chat) blocksLoaded(msg blocksLoaded) {
+	if pid, ok := ch.online[msg.Username]; !ok || !pid.Equals(msg.Session) {
+		return
+	}
+	if msg.Err != nil {
+		fmt.Printf("chat: loading blocks of %s failed: %v\n", msg.Username, msg.Err)
+		return
+	}
+	for _, name := range msg.Blocked {
+		setAdd(ch.blocks, msg.Username, name)
+	}
+	for _, name := range msg.Blockers {
+		setAdd(ch.blockedBy, msg.Username, name)
+	}
+}
+
+// 차단 관계는 접속 중인 쪽만 기억한다 (접속하지 않은 쪽은 접속할 때 DB 에서 읽음)
+func (ch *Chat) blockChanged(msg blockChanged) {
+	update := setRemove
+	if msg.Block {
+		update = setAdd
+	}
+	if _, ok := ch.online[msg.Username]; ok {
+		update(ch.blocks, msg.Username, msg.Target)
+	}
+	if _, ok := ch.online[msg.Target]; ok {
+		update(ch.blockedBy, msg.Target, msg.Username)
+	}
+}
+
+func (ch *Chat) gmCommand(c *actor.Context, msg gmCommand) {
+	if !ch.gms[msg.Username] {
+		ch.reply(c, msg.Session, types.ChatActionGM, types.ChatErrNotGM, 0)
+		return
+	}
+	req := msg.Req
+	switch {
+	case req.Command == "notice" && strings.TrimSpace(req.Text) != "":
+		ch.system(c, "", req.Text)
+	case req.Command == "mute" && req.Target != "" && req.Minutes > 0:
+		d := min(time.Duration(req.Minutes)*time.Minute, maxMuteDuration)
+		ch.muted[usernameKey(req.Target)] = time.Now().Add(d)
+		ch.system(c, req.Target, "채팅이 금지되었습니다.")
+	case req.Command == "unmute" && req.Target != "":
+		delete(ch.muted, usernameKey(req.Target))
+		ch.system(c, req.Target, "채팅 금지가 해제되었습니다.")
+	default:
+		ch.reply(c, msg.Session, types.ChatActionGM, types.ChatErrInvalidCommand, 0)
+		return
+	}
+	ch.reply(c, msg.Session, types.ChatActionGM, "", 0)
+}
+
+// 시스템 메시지 (to 가 비어 있으면 전체, 차단/필터 적용 안 함)
+func (ch *Chat) system(c *actor.Context, to, text string) {
+	out := wsSend{Type: "chatMessage", Data: types.ChatMessage{Channel: types.ChatSystem, Text: text, Time: time.Now().UnixMilli()}}
+	if to != "" {
+		if _, pid, ok := ch.lookup(to); ok {
+			c.Send(pid, out)
+		}
+		return
+	}
+	for _, pid := range ch.online {
+		c.Send(pid, out)
+	}
+}
+
+func (ch *Chat) reply(c *actor.Context, session *actor.PID, action, code string, retryAfter time.Duration) {
+	c.Send(session, wsSend{Type: "chatResult", Data: types.ChatResult{
+		Action:       action,
+		Success:      code == "",
+		Code:         code,
+		RetryAfterMs: retryAfter.Milliseconds(),
+	}})
+}
+
+func setAdd(m map[string]map[string]bool, key, v string) {
+	if m[key] == nil {
+		m[key] = make(map[string]bool)
+	}
+	m[key][v] = true
+}
+
+func setRemove(m map[string]map[string]bool, key, v string) {
+	delete(m[key], v)
+	if len(m[key]) == 0 {
+		delete(m, key)
+	}
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// 채팅 금칙어 필터. 서버 시작 시 -chat-filter 로 구현을 고른다.
+type chatFilter interface {
+	Filter(text string) string
+}
+
+// 이름 -> 필터 생성 함수 (새 필터는 여기에 등록)
+var chatFilters = map[string]func() (chatFilter, error){
+	"none": func() (chatFilter, error) { return noFilter{}, nil },
+	"mask": newMaskFilter,
+}
+
+func newChatFilter(name string) (chatFilter, error) {
+	newFilter, ok := chatFilters[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown chat filter %q", name)
+	}
+	return newFilter()
+}
+
+// 필터를 쓰지 않음
+type noFilter struct{}
+
+func (noFilter) Filter(text string) string { return text }
+
+// 금칙어를 글자 수만큼 '*' 로 가린다 (대소문자 구분 없음, 부분 일치)
+type maskFilter struct {
+	words [][]rune // 소문자로 바꾼 금칙어
+}
+
+// profanity.txt 의 금칙어로 마스킹 필터 생성
+func newMaskFilter() (chatFilter, error) {
+	lines, err := readDataLines("profanity.txt")
+	if err != nil {
+		return nil, fmt.Errorf("profanity.txt: %w", err)
+	}
+	f := &maskFilter{}
+	for _, w := range lines {
+		f.words = append(f.words, []rune(strings.ToLower(w)))
+	}
+	return f, nil
+}
+
+func (f *maskFilter) Filter(text string) string {
+	runes := []rune(text)
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+	masked := false
+	for _, w := range f.words {
+		for i := 0; i+len(w) <= len(lower); i++ {
+			if !runesEqual(lower[i:i+len(w)], w) {
+				continue
+			}
+			for j := i; j < i+len(w); j++ {
+				runes[j] = '*'
+			}
+			masked = true
+		}
+	}
+	if !masked {
+		return text
+	}
+	return string(runes)
+}
+
+func runesEqual(a, b []rune) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
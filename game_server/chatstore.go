@@ -0,0 +1,144 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/SilverSS/gameserver/ent"
+	"github.com/SilverSS/gameserver/ent/chatmessage"
+	"github.com/SilverSS/gameserver/ent/friendship"
+	"github.com/SilverSS/gameserver/ent/user"
+	"github.com/SilverSS/gameserver/types"
+)
+
+// 귓속말 받는 사람이 없는 계정인 경우
+var errUnknownPlayer = errors.New("unknown player")
+
+// 받는 사람이 아직 받지 않은 귓속말이 너무 많은 경우
+var errMailboxFull = errors.New("offline mailbox full")
+
+// 채널 기록 저장 (글로벌/존 채널)
+func saveChatMessages(ctx context.Context, client *ent.Client, msgs []chatRecord) error {
+	builders := make([]*ent.ChatMessageCreate, len(msgs))
+	for i, m := range msgs {
+		builders[i] = client.ChatMessage.Create().
+			SetChannel(m.Msg.Channel).
+			SetZoneID(m.ZoneID).
+			SetSender(m.Msg.From).
+			SetText(m.Msg.Text).
+			SetCreatedAt(time.UnixMilli(m.Msg.Time))
+	}
+	return client.ChatMessage.CreateBulk(builders...).Exec(ctx)
+}
+
+// 채널의 최근 기록 n 개 (오래된 것부터)
+func loadChatHistory(ctx context.Context, client *ent.Client, channel, zoneID string, n int) ([]types.ChatMessage, error) {
+	rows, err := client.ChatMessage.Query().
+		Where(chatmessage.ChannelEQ(channel), chatmessage.ZoneIDEQ(zoneID)).
+		Order(ent.Desc(chatmessage.FieldCreatedAt), ent.Desc(chatmessage.FieldID)).
+		Limit(n).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msgs := make([]types.ChatMessage, len(rows))
+	for i, r := range rows {
+		msgs[len(rows)-1-i] = types.ChatMessage{Channel: r.Channel, From: r.Sender, Text: r.Text, Time: r.CreatedAt.UnixMilli()}
+	}
+	return msgs, nil
+}
+
+// 접속하지 않은 플레이어에게 보낸 귓속말 저장. 받는 사람의 정식 사용자명을 돌려준다.
+// 받는 사람이 보낸 사람을 차단했으면 저장하지 않지만, 보낸 사람에게는 알리지 않도록 성공으로 돌려준다.
+func storeOfflineWhisper(ctx context.Context, client *ent.Client, msg types.ChatMessage, maxPending int) (string, error) {
+	u, err := client.User.Query().Where(user.UsernameKeyEQ(usernameKey(msg.To))).Only(ctx)
+	if ent.IsNotFound(err) {
+		return "", errUnknownPlayer
+	}
+	if err != nil {
+		return "", err
+	}
+	blocked, err := client.Friendship.Query().
+		Where(
+			friendship.UserID(u.ID),
+			friendship.StatusEQ(friendship.StatusBlocked),
+			friendship.HasFriendWith(user.UsernameKeyEQ(usernameKey(msg.From))),
+		).
+		Exist(ctx)
+	if err != nil {
+		return "", err
+	}
+	if blocked {
+		return u.Username, nil
+	}
+	pending, err := client.ChatMessage.Query().
+		Where(chatmessage.RecipientEQ(u.Username), chatmessage.DeliveredEQ(false)).
+		Count(ctx)
+	if err != nil {
+		return "", err
+	}
+	if pending >= maxPending {
+		return "", errMailboxFull
+	}
+	err = client.ChatMessage.Create().
+		SetChannel(types.ChatWhisper).
+		SetSender(msg.From).
+		SetRecipient(u.Username).
+		SetText(msg.Text).
+		SetDelivered(false).
+		SetCreatedAt(time.UnixMilli(msg.Time)).
+		Exec(ctx)
+	return u.Username, err
+}
+
+// 받지 않은 귓속말을 꺼내고 받은 것으로 표시 (오래된 것부터).
+// 저장된 뒤에 차단한 사용자가 보낸 것은 돌려주지 않고 받은 것으로만 표시한다.
+func takeOfflineWhispers(ctx context.Context, client *ent.Client, username string) ([]types.ChatMessage, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+	blocked, _, err := loadBlocks(ctx, tx.Client(), username)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	rows, err := tx.ChatMessage.Query().
+		Where(chatmessage.RecipientEQ(username), chatmessage.DeliveredEQ(false)).
+		Order(ent.Asc(chatmessage.FieldCreatedAt), ent.Asc(chatmessage.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if len(rows) == 0 {
+		return nil, tx.Commit()
+	}
+	ids := make([]int, len(rows))
+	msgs := make([]types.ChatMessage, 0, len(rows))
+	for i, r := range rows {
+		ids[i] = r.ID
+		if slices.Contains(blocked, r.Sender) {
+			continue
+		}
+		msgs = append(msgs, types.ChatMessage{Channel: r.Channel, From: r.Sender, To: r.Recipient, Text: r.Text, Time: r.CreatedAt.UnixMilli(), Offline: true})
+	}
+	if err := tx.ChatMessage.Update().Where(chatmessage.IDIn(ids...)).SetDelivered(true).Exec(ctx); err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+	return msgs, nil
+}
+
+// 보관 기간이 지난 채팅 삭제. 채널 기록과 귓속말(받았든 못 받았든)은 보관 기간을 따로 둔다.
+func purgeChatMessages(ctx context.Context, client *ent.Client, now time.Time, retention, whisperRetention time.Duration) (int, error) {
+	return client.ChatMessage.Delete().
+		Where(chatmessage.Or(
+			chatmessage.And(chatmessage.ChannelNEQ(types.ChatWhisper), chatmessage.CreatedAtLT(now.Add(-retention))),
+			chatmessage.And(chatmessage.ChannelEQ(types.ChatWhisper), chatmessage.CreatedAtLT(now.Add(-whisperRetention))),
+		)).
+		Exec(ctx)
+}
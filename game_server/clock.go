@@ -0,0 +1,15 @@
+package main
+
+import "time"
+
+// 서버 시각의 기준 (프로세스 시작 시각). 클라이언트에게 보내는 시각(serverTime)은 모두 여기서부터 지난 ms 이다.
+// time.Now 의 단조 시계로 재므로 시스템 시계를 바꿔도 거꾸로 가지 않는다.
+var serverEpoch = time.Now()
+
+func serverTime(t time.Time) int64 {
+	return t.Sub(serverEpoch).Milliseconds()
+}
+
+func fromServerTime(ms int64) time.Time {
+	return serverEpoch.Add(time.Duration(ms) * time.Millisecond)
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/SilverSS/gameserver/types"
+)
+
+// 정적 충돌체 종류 (colliderDef.Type)
+const (
+	colliderBox    = "box"
+	colliderCircle = "circle"
+)
+
+// 존의 정적 충돌체 (벽, 바위, 건물). X/Z 평면에서만 판정하고 높이는 끝이 없다고 본다.
+type colliderDef struct {
+	Type   string       `json:"type"`
+	Min    types.Vector `json:"min"`    // box
+	Max    types.Vector `json:"max"`    // box
+	Center types.Vector `json:"center"` // circle
+	Radius float32      `json:"radius"` // circle
+}
+
+func (c *colliderDef) validate() error {
+	switch {
+	case c.Type == colliderBox && c.Min.X < c.Max.X && c.Min.Z < c.Max.Z:
+	case c.Type == colliderCircle && c.Radius > 0:
+	default:
+		return fmt.Errorf("invalid collider %+v", *c)
+	}
+	return nil
+}
+
+// p 가 충돌체를 margin 만큼 부풀린 영역 안에 있는지
+func (c *colliderDef) contains(p types.Vector, margin float32) bool {
+	if c.Type == colliderCircle {
+		return withinXZ(c.Center, p, c.Radius+margin)
+	}
+	return p.X > c.Min.X-margin && p.X < c.Max.X+margin && p.Z > c.Min.Z-margin && p.Z < c.Max.Z+margin
+}
+
+// 선분 a-b 가 충돌체를 지나는지
+func (c *colliderDef) intersects(a, b types.Vector) bool {
+	d := types.Vector{X: b.X - a.X, Z: b.Z - a.Z}
+	if c.Type == colliderCircle {
+		// 선분 위에서 원의 중심에 가장 가까운 점
+		t := float32(0)
+		if l := d.X*d.X + d.Z*d.Z; l > 0 {
+			t = clampf(((c.Center.X-a.X)*d.X+(c.Center.Z-a.Z)*d.Z)/l, 0, 1)
+		}
+		return withinXZ(c.Center, types.Vector{X: a.X + d.X*t, Z: a.Z + d.Z*t}, c.Radius)
+	}
+	// 슬랩 방식: 두 축의 진입/이탈 구간이 겹치면 지난다
+	lo, hi := float32(0), float32(1)
+	for _, s := range [][4]float32{{a.X, d.X, c.Min.X, c.Max.X}, {a.Z, d.Z, c.Min.Z, c.Max.Z}} {
+		origin, dir, smin, smax := s[0], s[1], s[2], s[3]
+		if dir == 0 {
+			if origin <= smin || origin >= smax {
+				return false
+			}
+			continue
+		}
+		t1, t2 := (smin-origin)/dir, (smax-origin)/dir
+		if t1 > t2 {
+			t1, t2 = t2, t1
+		}
+		lo, hi = max(lo, t1), min(hi, t2)
+		if lo >= hi {
+			return false
+		}
+	}
+	return true
+}
+
+// 충돌체와 겹치는 셀을 막는다 (엔티티 반경만큼 여유를 둬서 경로가 벽에 스치지 않게)
+func (g *navGrid) blockColliders(colliders []colliderDef) {
+	for z := 0; z < g.height; z++ {
+		for x := 0; x < g.width; x++ {
+			center := g.center(navCell{x, z}, 0)
+			for i := range colliders {
+				if colliders[i].contains(center, entityRadius+g.CellSize/2) {
+					g.blocked[z*g.width+x] = true
+					break
+				}
+			}
+		}
+	}
+}
+
+// p 가 어느 충돌체 안에 있는지 (엔티티 반경 포함)
+func (z *Zone) insideCollider(p types.Vector) bool {
+	for i := range z.def.Colliders {
+		if z.def.Colliders[i].contains(p, entityRadius) {
+			return true
+		}
+	}
+	return false
+}
+
+// e 가 prev 에서 pos 로 갈 수 없는지. 충돌체 안으로 들어가거나, 엔티티 충돌이 켜진 존에서
+// 살아 있는 다른 엔티티와 겹치게 되면 막힌다. 이미 겹쳐 있던 상태에서 벗어나는 이동은 막지 않는다.
+func (z *Zone) blocked(e *entity, pos, prev types.Vector) bool {
+	if z.insideCollider(pos) && !z.insideCollider(prev) {
+		return true
+	}
+	if !z.def.EntityCollision {
+		return false
+	}
+	for _, other := range z.entities {
+		if other.id == e.id || other.dead() {
+			continue
+		}
+		if withinXZ(other.state.Position, pos, 2*entityRadius) && !withinXZ(other.state.Position, prev, 2*entityRadius) {
+			return true
+		}
+	}
+	return false
+}
+
+// 경유지를 따라 step 만큼 이동하되, 막히면 X 축이나 Z 축으로만 미끄러져 본다.
+// 그래도 거의 나아가지 못하면 제자리에서 멈춘다.
+func (z *Zone) moveStep(e *entity, step float32) {
+	prev := e.state.Position
+	stepMovement(e, step)
+	next := e.state.Position
+	if !z.blocked(e, next, prev) {
+		return
+	}
+	for _, p := range []types.Vector{{X: next.X, Y: next.Y, Z: prev.Z}, {X: prev.X, Y: next.Y, Z: next.Z}} {
+		if !z.blocked(e, p, prev) && distance(prev, p) >= step*0.1 {
+			e.state.Position = p
+			return
+		}
+	}
+	e.state.Position = prev
+	e.stopMoving()
+}
+
+// 두 위치 사이에 시야를 가리는 충돌체가 없는지
+func (z *Zone) lineOfSight(from, to types.Vector) bool {
+	for i := range z.def.Colliders {
+		if z.def.Colliders[i].intersects(from, to) {
+			return false
+		}
+	}
+	return true
+}
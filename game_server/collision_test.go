@@ -0,0 +1,214 @@
+package main
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/SilverSS/gameserver/types"
+)
+
+// 테스트 동안만 쓰는 게임 데이터 파일 시스템 (경로 -> 내용)
+func useTestData(t *testing.T, files map[string]string) {
+	t.Helper()
+	fsys := fstest.MapFS{}
+	for name, data := range files {
+		fsys[name] = &fstest.MapFile{Data: []byte(data)}
+	}
+	old := dataFS
+	dataFS = fsys
+	t.Cleanup(func() { dataFS = old })
+}
+
+func collisionZone(def *zoneDef, es ...*entity) *Zone {
+	z := &Zone{def: def, entities: make(map[int64]*entity)}
+	for _, e := range es {
+		z.entities[e.id] = e
+	}
+	return z
+}
+
+// X = 2~3 에 Z 방향으로 길게 놓인 벽
+var testWall = colliderDef{Type: colliderBox, Min: types.Vector{X: 2, Z: -10}, Max: types.Vector{X: 3, Z: 10}}
+
+// target 을 향해 steps 틱 동안 이동한다 (틱마다 0.2m)
+func walk(z *Zone, e *entity, target types.Vector, steps int) {
+	e.setPath([]types.Vector{target})
+	for i := 0; i < steps && e.moving; i++ {
+		z.moveStep(e, 0.2)
+	}
+}
+
+func TestMoveStepStopsAtWall(t *testing.T) {
+	e := testEntity(1, types.Vector{})
+	z := collisionZone(&zoneDef{Colliders: []colliderDef{testWall}}, e)
+
+	walk(z, e, types.Vector{X: 5}, 50)
+
+	if e.moving {
+		t.Fatal("still moving into the wall")
+	}
+	if limit := testWall.Min.X - entityRadius; e.state.Position.X > limit {
+		t.Errorf("X = %v, want <= %v (clipped at the wall)", e.state.Position.X, limit)
+	}
+	if e.state.Position.X < testWall.Min.X-entityRadius-0.2 {
+		t.Errorf("X = %v, stopped too early", e.state.Position.X)
+	}
+}
+
+func TestMoveStepSlidesAlongWall(t *testing.T) {
+	e := testEntity(1, types.Vector{X: 1.3})
+	z := collisionZone(&zoneDef{Colliders: []colliderDef{testWall}}, e)
+
+	// 벽을 비스듬히 향해 가면 X 는 막히고 Z 로만 미끄러진다
+	walk(z, e, types.Vector{X: 6, Z: 5}, 5)
+
+	if e.state.Position.X > testWall.Min.X-entityRadius {
+		t.Errorf("X = %v, went into the wall", e.state.Position.X)
+	}
+	if e.state.Position.Z < 0.5 {
+		t.Errorf("Z = %v, want sliding along the wall", e.state.Position.Z)
+	}
+	if !e.moving {
+		t.Error("stopped while it could still slide")
+	}
+}
+
+func TestMoveStepLeavesCollider(t *testing.T) {
+	// 이미 충돌체 안에 있으면 (스폰, 순간 이동) 빠져나가는 이동은 막지 않는다
+	e := testEntity(1, types.Vector{X: 2.5})
+	z := collisionZone(&zoneDef{Colliders: []colliderDef{testWall}}, e)
+
+	walk(z, e, types.Vector{X: 0}, 20)
+
+	if e.state.Position.X != 0 {
+		t.Errorf("X = %v, want 0", e.state.Position.X)
+	}
+}
+
+func TestLineOfSight(t *testing.T) {
+	rock := colliderDef{Type: colliderCircle, Center: types.Vector{X: 0, Z: 5}, Radius: 1}
+	z := collisionZone(&zoneDef{Colliders: []colliderDef{testWall, rock}})
+
+	tests := []struct {
+		name     string
+		from, to types.Vector
+		want     bool
+	}{
+		{"through wall", types.Vector{X: 0}, types.Vector{X: 5}, false},
+		{"along wall", types.Vector{X: 1, Z: -5}, types.Vector{X: 1, Z: 0}, true},
+		{"ends before wall", types.Vector{X: 0}, types.Vector{X: 1.9}, true},
+		{"around wall end", types.Vector{X: 0, Z: 11}, types.Vector{X: 5, Z: 11}, true},
+		{"through rock", types.Vector{X: -3, Z: 5}, types.Vector{X: 3, Z: 5}, false},
+		{"past rock", types.Vector{X: -3, Z: 6.5}, types.Vector{X: 1.5, Z: 6.5}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := z.lineOfSight(tt.from, tt.to); got != tt.want {
+				t.Errorf("lineOfSight(%v, %v) = %v, want %v", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEntityCollision(t *testing.T) {
+	tests := []struct {
+		name      string
+		collision bool
+		dead      bool
+		wantX     float32 // 멈춘 위치의 최댓값 (0 이면 끝까지 감)
+	}{
+		{"enabled", true, false, 2 - 2*entityRadius},
+		{"disabled", false, false, 0},
+		{"dead entity", true, true, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := testEntity(1, types.Vector{})
+			other := testEntity(2, types.Vector{X: 2})
+			if tt.dead {
+				other.state.Health = 0
+			}
+			z := collisionZone(&zoneDef{EntityCollision: tt.collision}, e, other)
+
+			walk(z, e, types.Vector{X: 4}, 50)
+
+			if tt.wantX == 0 {
+				if e.state.Position.X != 4 {
+					t.Errorf("X = %v, want to pass through to 4", e.state.Position.X)
+				}
+				return
+			}
+			if e.moving || e.state.Position.X > tt.wantX {
+				t.Errorf("X = %v moving=%v, want stopped at <= %v", e.state.Position.X, e.moving, tt.wantX)
+			}
+		})
+	}
+}
+
+func TestNavGridPathAroundWall(t *testing.T) {
+	useTestData(t, map[string]string{"nav/test.json": `{
+		"origin": { "X": 0, "Y": 0, "Z": 0 },
+		"cellSize": 1,
+		"rows": [
+			".....",
+			".....",
+			"####.",
+			".....",
+			"....."
+		]
+	}`})
+	g, err := loadNavGrid("nav/test.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	from, to := types.Vector{X: 0.5, Z: 0.5}, types.Vector{X: 0.5, Z: 4.5}
+
+	path, ok := g.findPath(from, to)
+	if !ok {
+		t.Fatal("no path")
+	}
+	if path[len(path)-1] != to {
+		t.Errorf("path ends at %v, want %v", path[len(path)-1], to)
+	}
+	prev, gap := from, false
+	for _, p := range path {
+		if !g.clearLine(prev, p) {
+			t.Errorf("segment %v -> %v crosses a blocked cell", prev, p)
+		}
+		if p.X >= 4 {
+			gap = true
+		}
+		prev = p
+	}
+	if !gap {
+		t.Errorf("path %v does not go through the gap at x=4", path)
+	}
+}
+
+func TestNavGridBlocksColliders(t *testing.T) {
+	useTestData(t, map[string]string{"nav/open.json": `{
+		"origin": { "X": 0, "Y": 0, "Z": 0 },
+		"cellSize": 1,
+		"rows": ["..........", "..........", "..........", "..........", ".........."]
+	}`})
+	g, err := loadNavGrid("nav/open.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	g.blockColliders([]colliderDef{{Type: colliderBox, Min: types.Vector{X: 4.5, Z: -1}, Max: types.Vector{X: 5.5, Z: 6}}})
+
+	// 충돌체에 엔티티 반경과 반 셀을 더한 범위에 중심이 들어가는 셀이 막힌다
+	for z := 0; z < 5; z++ {
+		for _, x := range []int{4, 5} {
+			if g.walkable(navCell{x, z}) {
+				t.Errorf("cell (%d,%d) under the collider is walkable", x, z)
+			}
+		}
+	}
+	if !g.walkable(navCell{0, 0}) || !g.walkable(navCell{9, 4}) {
+		t.Error("cells far from the collider were blocked")
+	}
+	if _, ok := g.findPath(types.Vector{X: 0.5, Z: 2.5}, types.Vector{X: 9.5, Z: 2.5}); ok {
+		t.Error("found a path through the blocked column")
+	}
+}
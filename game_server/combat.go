@@ -0,0 +1,446 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/SilverSS/gameserver/types"
+	"github.com/anthdm/hollywood/actor"
+)
+
+// 전투 규칙 (data/combat.json, 능력은 data/abilities.json, 상태 효과는 data/status_effects.json,
+// 능력치는 data/stats.json, NPC 는 data/npcs.json)
+type combatRules struct {
+	RegenPerSec       float32                `json:"regenPerSec"`       // 초당 자연 회복량
+	RegenDelayMs      int                    `json:"regenDelayMs"`      // 마지막으로 피해를 받은 뒤 회복이 시작되기까지
+	RespawnMs         int                    `json:"respawnMs"`         // 사망 후 부활까지
+	LagCompensationMs int                    `json:"lagCompensationMs"` // 공격 판정에서 대상 위치를 되돌릴 수 있는 최대 시간 (0 이면 보상 안 함)
+	Resources         map[string]resourceDef `json:"resources"`         // 능력이 소모하는 자원 (types.Resource*)
+	Attack            attackDef              `json:"attack"`
+
+	abilities map[string]*abilityDef
+	statuses  map[string]*statusDef
+	stats     *statRules
+	npcs      map[string]*npcDef
+}
+
+// 자원 정의 (마나, 기력). 피해를 받아도 회복은 멈추지 않는다.
+type resourceDef struct {
+	Max         int     `json:"max"`
+	RegenPerSec float32 `json:"regenPerSec"`
+}
+
+// 기본 공격 정의 (플레이어는 combat.json, NPC 는 정의마다). 사거리는 X/Z 평면 거리, 높이 차이는 따로 제한한다.
+// 피해는 Damage 에 공격자의 공격력을 더한 값이다.
+type attackDef struct {
+	Damage        int     `json:"damage"`
+	Range         float32 `json:"range"`
+	MaxHeightDiff float32 `json:"maxHeightDiff"`
+	CooldownMs    int     `json:"cooldownMs"`
+}
+
+func (r *combatRules) regenDelay() time.Duration {
+	return time.Duration(r.RegenDelayMs) * time.Millisecond
+}
+
+func (r *combatRules) respawnDelay() time.Duration {
+	return time.Duration(r.RespawnMs) * time.Millisecond
+}
+
+func (a *attackDef) cooldown() time.Duration {
+	return time.Duration(a.CooldownMs) * time.Millisecond
+}
+
+func (r *combatRules) validate() error {
+	if r.RegenPerSec < 0 || r.RegenDelayMs < 0 || r.RespawnMs < 0 {
+		return fmt.Errorf("invalid regen or respawn")
+	}
+	if r.LagCompensationMs < 0 {
+		return fmt.Errorf("invalid lagCompensationMs")
+	}
+	for kind, res := range r.Resources {
+		if kind != types.ResourceMana && kind != types.ResourceEnergy {
+			return fmt.Errorf("unknown resource %q", kind)
+		}
+		if res.Max < 1 || res.RegenPerSec < 0 {
+			return fmt.Errorf("resource %s: invalid max or regenPerSec", kind)
+		}
+	}
+	return r.Attack.validate()
+}
+
+func (a *attackDef) validate() error {
+	if a.Damage < 0 || a.Range <= 0 || a.MaxHeightDiff < 0 || a.CooldownMs < 0 {
+		return fmt.Errorf("invalid attack")
+	}
+	return nil
+}
+
+func loadCombatRules() (*combatRules, error) {
+	var r combatRules
+	if err := readDataJSON("combat.json", &r); err != nil {
+		return nil, err
+	}
+	if err := r.validate(); err != nil {
+		return nil, fmt.Errorf("combat.json: %w", err)
+	}
+	stats, err := loadStatRules()
+	if err != nil {
+		return nil, err
+	}
+	statuses, err := loadStatusEffects()
+	if err != nil {
+		return nil, err
+	}
+	abilities, err := loadAbilities(r.Resources, statuses)
+	if err != nil {
+		return nil, err
+	}
+	npcs, err := loadNPCDefs()
+	if err != nil {
+		return nil, err
+	}
+	r.abilities = abilities
+	r.statuses = statuses
+	r.stats = stats
+	r.npcs = npcs
+	return &r, nil
+}
+
+// 엔티티의 전투 상태 (체력 자체는 state.Health). 존을 옮겨도 유지된다.
+type combatState struct {
+	lastAttack  time.Time
+	lastDamaged time.Time
+	respawnAt   time.Time // 죽어 있는 동안만 의미 있음
+	regen       float32   // 아직 체력에 반영되지 않은 소수점 회복량
+
+	resources map[string]float32   // 자원 종류 -> 현재 값
+	cooldowns map[string]time.Time // 능력 ID -> 다시 쓸 수 있는 시각
+	cast      *activeCast          // 시전 중인 능력 (존을 옮기면 취소)
+	effects   []*statusEffect      // 걸린 순서
+}
+
+func (e *entity) dead() bool {
+	return e.state.Health <= 0
+}
+
+// 공격 가능 여부 검사 (실패하면 오류 코드). 사거리는 대상이 tgtPos 에 있다고 보고 판정한다
+// (지연 보상으로 되돌린 위치일 수 있음). 시야/존 규칙은 존이 따로 검사한다.
+func (a *attackDef) check(att, tgt *entity, tgtPos types.Vector, now time.Time) string {
+	switch {
+	case tgt == nil || tgt.id == att.id:
+		return types.CombatErrInvalidTarget
+	case att.dead():
+		return types.CombatErrDead
+	case att.stunned():
+		return types.CombatErrStunned
+	case tgt.dead():
+		return types.CombatErrTargetDead
+	case now.Sub(att.combat.lastAttack) < a.cooldown():
+		return types.CombatErrCooldown
+	case !a.inRange(att.state.Position, tgtPos):
+		return types.CombatErrOutOfRange
+	}
+	return ""
+}
+
+func (a *attackDef) inRange(from, to types.Vector) bool {
+	return withinXZ(from, to, a.Range) && absf(from.Y-to.Y) <= a.MaxHeightDiff
+}
+
+// 남은 공격 대기 시간
+func (a *attackDef) cooldownLeft(e *entity, now time.Time) time.Duration {
+	return max(a.cooldown()-now.Sub(e.combat.lastAttack), 0)
+}
+
+// 피해 적용. 실제로 줄어든 체력과 이번 피해로 죽었는지를 돌려준다.
+// 죽으면 이동을 멈추고 상태 효과를 모두 지운 뒤 부활 시각을 정한다.
+func (r *combatRules) applyDamage(e *entity, amount int, now time.Time) (dealt int, killed bool) {
+	if e.dead() || amount <= 0 {
+		return 0, false
+	}
+	dealt = min(amount, e.state.Health)
+	e.state.Health -= dealt
+	e.combat.lastDamaged = now
+	e.combat.regen = 0
+	if !e.dead() {
+		return dealt, false
+	}
+	e.stopMoving()
+	e.combat.cast = nil
+	e.combat.effects = nil
+	e.combat.respawnAt = now.Add(r.respawnDelay())
+	return dealt, true
+}
+
+// 자연 회복 (dt 초 경과). 체력이 바뀌었으면 true.
+func (r *combatRules) regenerate(e *entity, dt float32, now time.Time) bool {
+	if e.dead() || e.state.Health >= e.state.MaxHealth || now.Sub(e.combat.lastDamaged) < r.regenDelay() {
+		return false
+	}
+	e.combat.regen += r.RegenPerSec * dt
+	gain := int(e.combat.regen)
+	if gain == 0 {
+		return false
+	}
+	e.combat.regen -= float32(gain)
+	e.state.Health = min(e.state.Health+gain, e.state.MaxHealth)
+	return true
+}
+
+// 회복 효과. 실제로 늘어난 체력을 돌려준다.
+func (r *combatRules) applyHeal(e *entity, amount int) int {
+	if e.dead() || amount <= 0 {
+		return 0
+	}
+	healed := min(amount, e.state.MaxHealth-e.state.Health)
+	e.state.Health += healed
+	return healed
+}
+
+// 자원 자연 회복 (dt 초 경과). 정수 값이 바뀐 자원 종류를 돌려준다.
+func (r *combatRules) regenerateResources(e *entity, dt float32) []string {
+	if e.dead() {
+		return nil
+	}
+	var changed []string
+	for _, kind := range []string{types.ResourceMana, types.ResourceEnergy} {
+		def, ok := r.Resources[kind]
+		if !ok {
+			continue
+		}
+		cur := e.combat.resources[kind]
+		next := min(cur+def.RegenPerSec*dt, float32(def.Max))
+		e.combat.resources[kind] = next
+		if int(next) != int(cur) {
+			changed = append(changed, kind)
+		}
+	}
+	return changed
+}
+
+// 부활 시각이 되었으면 pos 에서 체력과 자원을 모두 채워 살린다.
+func (r *combatRules) respawn(e *entity, pos types.Vector, now time.Time) bool {
+	if !e.dead() || now.Before(e.combat.respawnAt) {
+		return false
+	}
+	e.state.Health = e.state.MaxHealth
+	e.state.Position = pos
+	e.state.Target = pos
+	e.target = pos
+	e.combat.regen = 0
+	for kind, def := range r.Resources {
+		e.combat.resources[kind] = float32(def.Max)
+	}
+	return true
+}
+
+// 존에 들어오는 엔티티의 전투 상태 초기화. 처음이면 자원을 가득 채운다.
+func (r *combatRules) initCombat(cs *combatState) {
+	cs.cast = nil
+	if cs.resources == nil {
+		cs.resources = make(map[string]float32, len(r.Resources))
+		for kind, def := range r.Resources {
+			cs.resources[kind] = float32(def.Max)
+		}
+	}
+	if cs.cooldowns == nil {
+		cs.cooldowns = make(map[string]time.Time)
+	}
+}
+
+func absf(v float32) float32 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// 공격 요청 (세션 -> 존). ViewTime 은 공격자가 보던 화면의 서버 시각 (zero 면 지연 보상 안 함).
+type attackEntity struct {
+	EntityID int64
+	TargetID int64
+	ViewTime time.Time
+}
+
+func (z *Zone) attack(c *actor.Context, msg attackEntity, now time.Time) {
+	att, ok := z.entities[msg.EntityID]
+	if !ok {
+		return
+	}
+	tgt := z.entities[msg.TargetID]
+	var tgtPos types.Vector
+	if tgt != nil {
+		tgtPos = z.rewind(tgt, msg.ViewTime, now)
+	}
+	code := z.rules.Attack.check(att, tgt, tgtPos, now)
+	switch {
+	case code != "":
+	case tgt.kind == types.EntityKindNPC:
+		code = types.CombatErrInvalidTarget
+	case !z.hostile(att, tgt):
+		code = types.CombatErrPvPDisabled
+	case !z.lineOfSight(att.state.Position, tgtPos):
+		code = types.CombatErrNoLineOfSight
+	}
+	if code != "" {
+		res := types.AttackResult{Success: false, Code: code, TargetID: msg.TargetID}
+		if code == types.CombatErrCooldown {
+			res.CooldownMs = int(z.rules.Attack.cooldownLeft(att, now) / time.Millisecond)
+		}
+		z.send(c, att, "attackResult", res)
+		return
+	}
+	att.combat.lastAttack = now
+	z.damage(c, hitSource{entityID: att.id}, tgt, z.rules.Attack.Damage+att.stats.AttackPower, now)
+}
+
+// 피해/회복의 출처. 능력도 상태 효과도 아니면 기본 공격이다.
+type hitSource struct {
+	entityID  int64 // 공격하거나 회복시킨 엔티티 (존을 떠났을 수도 있음)
+	abilityID string
+	statusID  string
+}
+
+// src 가 tgt 를 공격할 수 있는지. 우호 NPC 는 누구와도 싸우지 않고 몬스터끼리도 싸우지 않으며,
+// PvP 가 꺼진 존에서는 플레이어끼리 싸울 수 없다.
+func (z *Zone) hostile(src, tgt *entity) bool {
+	switch {
+	case src.id == tgt.id, src.kind == types.EntityKindNPC, tgt.kind == types.EntityKindNPC:
+		return false
+	case src.kind == types.EntityKindPlayer && tgt.kind == types.EntityKindPlayer:
+		return z.def.PvP
+	}
+	return src.kind != tgt.kind
+}
+
+// 보호막이 먼저 흡수한 뒤 남은 피해를 주고 주변에 알린다. NPC 는 공격자에 대한 위협 수치가 오른다.
+// 대상이 죽으면 사라진 상태 효과를 알리고 시전을 중단시킨 뒤 사망 이벤트와 부활 안내를 보낸다.
+func (z *Zone) damage(c *actor.Context, src hitSource, tgt *entity, amount int, now time.Time) {
+	if tgt.dead() || tgt.evading() {
+		return
+	}
+	cast := tgt.combat.cast
+	absorbed, depleted := absorbDamage(tgt, amount)
+	z.statusesRemoved(c, tgt, depleted, types.StatusRemovedDepleted, now)
+	effects := tgt.combat.effects // 죽으면 applyDamage 가 지운다
+	dealt, killed := z.rules.applyDamage(tgt, amount-absorbed, now)
+	z.sendNear(c, tgt.state.Position, "combatEvent", types.CombatEvent{
+		Type:       types.CombatEventDamage,
+		SourceID:   src.entityID,
+		TargetID:   tgt.id,
+		AbilityID:  src.abilityID,
+		StatusID:   src.statusID,
+		Amount:     dealt,
+		Absorbed:   absorbed,
+		Health:     tgt.state.Health,
+		Tick:       z.ticks,
+		ServerTime: z.stateTime(),
+	})
+	z.sendHealth(c, tgt)
+	if !killed {
+		z.addThreat(tgt, src.entityID, dealt+absorbed)
+		return
+	}
+	z.statusesRemoved(c, tgt, effects, types.StatusRemovedDied, now)
+	if cast != nil {
+		z.interruptCast(c, tgt, cast.ability, types.CastInterruptDied)
+	}
+	z.sendNear(c, tgt.state.Position, "combatEvent", types.CombatEvent{
+		Type:       types.CombatEventDeath,
+		SourceID:   src.entityID,
+		TargetID:   tgt.id,
+		Tick:       z.ticks,
+		ServerTime: z.stateTime(),
+	})
+	z.send(c, tgt, "playerDied", types.PlayerDied{KillerID: src.entityID, RespawnInMs: z.rules.RespawnMs})
+	fmt.Printf("zone %s: entity %d (%s) killed by %d\n", z.def.ID, tgt.id, tgt.name, src.entityID)
+}
+
+func (z *Zone) heal(c *actor.Context, src hitSource, tgt *entity, amount int) {
+	if tgt.dead() {
+		return
+	}
+	healed := z.rules.applyHeal(tgt, amount)
+	z.sendNear(c, tgt.state.Position, "combatEvent", types.CombatEvent{
+		Type:       types.CombatEventHeal,
+		SourceID:   src.entityID,
+		TargetID:   tgt.id,
+		AbilityID:  src.abilityID,
+		StatusID:   src.statusID,
+		Amount:     healed,
+		Health:     tgt.state.Health,
+		Tick:       z.ticks,
+		ServerTime: z.stateTime(),
+	})
+	z.sendHealth(c, tgt)
+}
+
+// 틱마다 상태 효과, 시전 완료, 자연 회복, 부활 처리 (NPC 의 회복과 부활은 AI 와 스폰 지점이 맡는다)
+func (z *Zone) updateCombat(c *actor.Context, dt float32, now time.Time) {
+	z.updateStatuses(c, now)
+	z.updateCasts(c, now)
+	for _, e := range z.entities {
+		if e.npc != nil {
+			continue
+		}
+		if z.rules.respawn(e, z.def.onGround(z.def.Spawn), now) {
+			e.history.reset()
+			pos := e.state.Position
+			z.sendNear(c, pos, "combatEvent", types.CombatEvent{
+				Type:       types.CombatEventRespawn,
+				TargetID:   e.id,
+				Health:     e.state.Health,
+				Position:   &pos,
+				Tick:       z.ticks,
+				ServerTime: z.stateTime(),
+			})
+			z.sendHealth(c, e)
+			z.sendResources(c, e)
+			continue
+		}
+		if z.rules.regenerate(e, dt, now) {
+			z.sendHealth(c, e)
+		}
+		for _, kind := range z.rules.regenerateResources(e, dt) {
+			z.sendResource(c, e, kind)
+		}
+	}
+}
+
+func (z *Zone) sendHealth(c *actor.Context, e *entity) {
+	z.send(c, e, "healthUpdate", types.HealthUpdate{
+		Health:     e.state.Health,
+		MaxHealth:  e.state.MaxHealth,
+		Tick:       z.ticks,
+		ServerTime: z.stateTime(),
+	})
+}
+
+func (z *Zone) sendResource(c *actor.Context, e *entity, kind string) {
+	z.send(c, e, "resourceUpdate", types.ResourceUpdate{
+		Resource:   kind,
+		Value:      int(e.combat.resources[kind]),
+		Max:        z.rules.Resources[kind].Max,
+		Tick:       z.ticks,
+		ServerTime: z.stateTime(),
+	})
+}
+
+// 자원 전체 전송 (존 입장, 부활)
+func (z *Zone) sendResources(c *actor.Context, e *entity) {
+	for _, kind := range []string{types.ResourceMana, types.ResourceEnergy} {
+		if _, ok := z.rules.Resources[kind]; ok {
+			z.sendResource(c, e, kind)
+		}
+	}
+}
+
+// pos 가 시야 안에 있는 플레이어 모두에게 전송
+func (z *Zone) sendNear(c *actor.Context, pos types.Vector, msgType string, v interface{}) {
+	for _, e := range z.entities {
+		if z.def.ViewRadius <= 0 || withinXZ(e.state.Position, pos, z.def.ViewRadius) {
+			z.send(c, e, msgType, v)
+		}
+	}
+}
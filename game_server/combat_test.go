@@ -0,0 +1,335 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/SilverSS/gameserver/types"
+	"github.com/anthdm/hollywood/actor"
+)
+
+// 최대 체력 100, 공격력 10 (기본 공격 피해 30 + 10)
+func testCombatRules() *combatRules {
+	return &combatRules{
+		RespawnMs: 5000,
+		Attack:    attackDef{Damage: 30, Range: 3, MaxHeightDiff: 2, CooldownMs: 1000},
+		stats: &statRules{
+			Base:             statBase{Stamina: 10, Strength: 5, MoveSpeed: 5},
+			HealthPerStamina: 10,
+			PowerPerStrength: 2,
+		},
+	}
+}
+
+func testZoneDef(id string, spawn types.Vector) *zoneDef {
+	return &zoneDef{
+		ID:        id,
+		BoundsMin: types.Vector{X: -50, Z: -50},
+		BoundsMax: types.Vector{X: 50, Y: 10, Z: 50},
+		Spawn:     spawn,
+		PvP:       true,
+	}
+}
+
+// 존 메서드를 액터 컨텍스트 안에서 직접 호출하는 테스트 도구.
+// 세션과 GameServer 자리에는 받은 메시지를 모으는 액터를 둔다.
+type combatHarness struct {
+	t       *testing.T
+	engine  *actor.Engine
+	runner  *actor.PID
+	session *actor.PID
+}
+
+// runner 가 실행할 함수 (끝나면 응답)
+type harnessCall func(c *actor.Context)
+
+// session 이 지금까지 받은 메시지를 돌려주고 비운다
+type takeMessages struct{}
+
+func newCombatHarness(t *testing.T) *combatHarness {
+	t.Helper()
+	e, err := actor.NewEngine(actor.NewEngineConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &combatHarness{t: t, engine: e}
+	h.runner = e.SpawnFunc(func(c *actor.Context) {
+		if f, ok := c.Message().(harnessCall); ok {
+			f(c)
+			c.Respond(true)
+		}
+	}, "runner")
+	var received []any
+	h.session = e.SpawnFunc(func(c *actor.Context) {
+		switch msg := c.Message().(type) {
+		case actor.Initialized, actor.Started, actor.Stopped:
+		case takeMessages:
+			c.Respond(received)
+			received = nil
+		default:
+			received = append(received, msg)
+		}
+	}, "session")
+	t.Cleanup(func() {
+		e.Poison(h.runner).Wait()
+		e.Poison(h.session).Wait()
+	})
+	return h
+}
+
+func (h *combatHarness) request(pid *actor.PID, msg any) any {
+	h.t.Helper()
+	res, err := h.engine.Request(pid, msg, time.Second).Result()
+	if err != nil {
+		h.t.Fatal(err)
+	}
+	return res
+}
+
+func (h *combatHarness) do(f func(c *actor.Context)) {
+	h.t.Helper()
+	h.request(h.runner, harnessCall(f))
+}
+
+// 세션이 받은 메시지 (do 안에서 보낸 것은 모두 도착해 있다)
+func (h *combatHarness) messages() []any {
+	h.t.Helper()
+	msgs, _ := h.request(h.session, takeMessages{}).([]any)
+	return msgs
+}
+
+func (h *combatHarness) newZone(def *zoneDef, rules *combatRules) *Zone {
+	return &Zone{def: def, rules: rules, server: h.session, entities: make(map[int64]*entity)}
+}
+
+// 세션이 연결된 플레이어 p<id> 를 pos 에 입장시킨다
+func (h *combatHarness) enter(z *Zone, id int64, pos types.Vector) *entity {
+	h.do(func(c *actor.Context) {
+		z.enter(c, enterZone{EntityID: id, Name: fmt.Sprintf("p%d", id), Session: h.session, Position: &pos})
+	})
+	return z.entities[id]
+}
+
+// msgs 에서 msgType 인 웹소켓 메시지의 데이터
+func wsData[T any](msgs []any, msgType string) []T {
+	var out []T
+	for _, m := range msgs {
+		if ws, ok := m.(wsSend); ok && ws.Type == msgType {
+			out = append(out, ws.Data.(T))
+		}
+	}
+	return out
+}
+
+func TestAttackDamagesTarget(t *testing.T) {
+	h := newCombatHarness(t)
+	z := h.newZone(testZoneDef("a", types.Vector{}), testCombatRules())
+	att := h.enter(z, 1, types.Vector{})
+	tgt := h.enter(z, 2, types.Vector{X: 2})
+	h.messages()
+
+	now := time.Now()
+	h.do(func(c *actor.Context) { z.attack(c, attackEntity{EntityID: 1, TargetID: 2}, now) })
+
+	if tgt.state.Health != 60 {
+		t.Errorf("target health = %d, want 60", tgt.state.Health)
+	}
+	if !att.combat.lastAttack.Equal(now) || !tgt.combat.lastDamaged.Equal(now) {
+		t.Error("lastAttack/lastDamaged not recorded")
+	}
+	events := wsData[types.CombatEvent](h.messages(), "combatEvent")
+	if len(events) != 2 { // 두 플레이어 모두 시야 안
+		t.Fatalf("got %d combat events, want 2", len(events))
+	}
+	if ev := events[0]; ev.Type != types.CombatEventDamage || ev.SourceID != 1 || ev.TargetID != 2 || ev.Amount != 40 || ev.Health != 60 {
+		t.Errorf("combat event = %+v", ev)
+	}
+}
+
+func TestAttackRejected(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(z *Zone, att, tgt *entity, now time.Time)
+		code  string
+	}{
+		{"cooldown", func(z *Zone, att, tgt *entity, now time.Time) {
+			att.combat.lastAttack = now.Add(-400 * time.Millisecond)
+		}, types.CombatErrCooldown},
+		{"out of range", func(z *Zone, att, tgt *entity, now time.Time) {
+			tgt.state.Position.X = 10
+		}, types.CombatErrOutOfRange},
+		{"target dead", func(z *Zone, att, tgt *entity, now time.Time) {
+			tgt.state.Health = 0
+		}, types.CombatErrTargetDead},
+		{"attacker dead", func(z *Zone, att, tgt *entity, now time.Time) {
+			att.state.Health = 0
+		}, types.CombatErrDead},
+		{"pvp disabled", func(z *Zone, att, tgt *entity, now time.Time) {
+			z.def.PvP = false
+		}, types.CombatErrPvPDisabled},
+		{"wall between", func(z *Zone, att, tgt *entity, now time.Time) {
+			z.def.Colliders = []colliderDef{{Type: colliderBox, Min: types.Vector{X: 0.8, Z: -5}, Max: types.Vector{X: 1.2, Z: 5}}}
+		}, types.CombatErrNoLineOfSight},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newCombatHarness(t)
+			z := h.newZone(testZoneDef("a", types.Vector{}), testCombatRules())
+			att := h.enter(z, 1, types.Vector{})
+			tgt := h.enter(z, 2, types.Vector{X: 2})
+			h.messages()
+			now := time.Now()
+			tt.setup(z, att, tgt, now)
+			health := tgt.state.Health
+
+			h.do(func(c *actor.Context) { z.attack(c, attackEntity{EntityID: 1, TargetID: 2}, now) })
+
+			results := wsData[types.AttackResult](h.messages(), "attackResult")
+			if len(results) != 1 || results[0].Success || results[0].Code != tt.code {
+				t.Fatalf("attackResult = %+v, want code %s", results, tt.code)
+			}
+			if tt.code == types.CombatErrCooldown && results[0].CooldownMs != 600 {
+				t.Errorf("cooldownMs = %d, want 600", results[0].CooldownMs)
+			}
+			if tgt.state.Health != health {
+				t.Errorf("target health changed to %d", tgt.state.Health)
+			}
+		})
+	}
+}
+
+func TestDamageKillsAndRespawns(t *testing.T) {
+	h := newCombatHarness(t)
+	spawn := types.Vector{X: -10, Z: 5}
+	z := h.newZone(testZoneDef("a", spawn), testCombatRules())
+	h.enter(z, 1, types.Vector{})
+	tgt := h.enter(z, 2, types.Vector{X: 2})
+	tgt.setPath([]types.Vector{{X: 8}})
+	h.messages()
+
+	now := time.Now()
+	h.do(func(c *actor.Context) { z.damage(c, hitSource{entityID: 1}, tgt, 150, now) })
+
+	if !tgt.dead() || tgt.state.Health != 0 {
+		t.Fatalf("health = %d, want dead", tgt.state.Health)
+	}
+	if tgt.moving {
+		t.Error("dead entity still moving")
+	}
+	if want := now.Add(5 * time.Second); !tgt.combat.respawnAt.Equal(want) {
+		t.Errorf("respawnAt = %v, want %v", tgt.combat.respawnAt, want)
+	}
+	msgs := h.messages()
+	var death bool
+	for _, ev := range wsData[types.CombatEvent](msgs, "combatEvent") {
+		if ev.Type == types.CombatEventDeath && ev.TargetID == 2 && ev.SourceID == 1 {
+			death = true
+		}
+	}
+	if !death {
+		t.Error("no death event")
+	}
+	died := wsData[types.PlayerDied](msgs, "playerDied")
+	if len(died) != 1 || died[0].KillerID != 1 || died[0].RespawnInMs != 5000 {
+		t.Errorf("playerDied = %+v", died)
+	}
+
+	// 죽은 대상은 더 피해를 받지 않는다
+	h.do(func(c *actor.Context) { z.damage(c, hitSource{entityID: 1}, tgt, 10, now) })
+	if len(wsData[types.CombatEvent](h.messages(), "combatEvent")) != 0 {
+		t.Error("damage to a dead entity was reported")
+	}
+
+	// 부활 시각 전에는 그대로, 부활 시각이 되면 스폰 위치에서 체력을 채워 살아난다
+	h.do(func(c *actor.Context) { z.updateCombat(c, 0.2, now.Add(4*time.Second)) })
+	if !tgt.dead() {
+		t.Fatal("respawned early")
+	}
+	h.do(func(c *actor.Context) { z.updateCombat(c, 0.2, now.Add(5*time.Second)) })
+	if tgt.state.Health != tgt.state.MaxHealth || tgt.state.Position != spawn {
+		t.Errorf("after respawn: health %d/%d at %v, want full at %v", tgt.state.Health, tgt.state.MaxHealth, tgt.state.Position, spawn)
+	}
+	var respawn bool
+	for _, ev := range wsData[types.CombatEvent](h.messages(), "combatEvent") {
+		if ev.Type == types.CombatEventRespawn && ev.TargetID == 2 && ev.Position != nil && *ev.Position == spawn {
+			respawn = true
+		}
+	}
+	if !respawn {
+		t.Error("no respawn event")
+	}
+}
+
+func TestDeathRemovesStatuses(t *testing.T) {
+	h := newCombatHarness(t)
+	z := h.newZone(testZoneDef("a", types.Vector{}), testCombatRules())
+	tgt := h.enter(z, 2, types.Vector{})
+	now := time.Now()
+	buff := &statusDef{ID: "fortitude", Kind: types.StatusKindStats, DurationMs: 60000,
+		Modifiers: []statModifier{{Stat: types.StatStamina, Add: 5, Mul: 1}}}
+	h.do(func(c *actor.Context) {
+		applyStatus(tgt, buff, 2, now)
+		z.refreshStats(c, tgt)
+	})
+	if tgt.state.MaxHealth != 150 {
+		t.Fatalf("maxHealth with buff = %d, want 150", tgt.state.MaxHealth)
+	}
+	h.messages()
+
+	h.do(func(c *actor.Context) { z.damage(c, hitSource{entityID: 1}, tgt, 500, now) })
+
+	if len(tgt.combat.effects) != 0 {
+		t.Errorf("effects left after death: %d", len(tgt.combat.effects))
+	}
+	msgs := h.messages()
+	removed := wsData[types.StatusEvent](msgs, "statusEvent")
+	if len(removed) != 1 || removed[0].Type != types.StatusEventRemoved || removed[0].Reason != types.StatusRemovedDied ||
+		removed[0].Effect.ID != "fortitude" {
+		t.Errorf("statusEvent = %+v, want fortitude removed (died)", removed)
+	}
+	if stats := wsData[types.StatsUpdate](msgs, "statsUpdate"); len(stats) != 1 || stats[0].Stats.MaxHealth != 100 {
+		t.Errorf("statsUpdate = %+v, want maxHealth back to 100", stats)
+	}
+}
+
+func TestDeadPlayerStaysDeadAcrossTransfer(t *testing.T) {
+	h := newCombatHarness(t)
+	rules := testCombatRules()
+	from := h.newZone(testZoneDef("a", types.Vector{}), rules)
+	spawn := types.Vector{X: 20, Z: 20}
+	to := h.newZone(testZoneDef("b", spawn), rules)
+	e := h.enter(from, 2, types.Vector{})
+	now := time.Now()
+	h.do(func(c *actor.Context) { from.damage(c, hitSource{entityID: 1}, e, 500, now) })
+	respawnAt := e.combat.respawnAt
+	h.messages()
+
+	h.do(func(c *actor.Context) { from.transfer(c, e, "b", nil) })
+	var moved *transferEntity
+	for _, m := range h.messages() {
+		if msg, ok := m.(transferEntity); ok {
+			moved = &msg
+		}
+	}
+	if moved == nil {
+		t.Fatal("no transferEntity sent to the server")
+	}
+	h.do(func(c *actor.Context) {
+		to.enter(c, enterZone{EntityID: moved.EntityID, Name: moved.Name, Session: moved.Session, State: moved.State, Combat: moved.Combat})
+	})
+
+	arrived := to.entities[2]
+	if !arrived.dead() || !arrived.combat.respawnAt.Equal(respawnAt) {
+		t.Fatalf("after transfer: health %d respawnAt %v, want dead until %v", arrived.state.Health, arrived.combat.respawnAt, respawnAt)
+	}
+	died := wsData[types.PlayerDied](h.messages(), "playerDied")
+	if len(died) != 1 || died[0].RespawnInMs <= 0 || died[0].RespawnInMs > 5000 {
+		t.Errorf("playerDied on enter = %+v, want the remaining respawn time", died)
+	}
+
+	h.do(func(c *actor.Context) { to.updateCombat(c, 0.2, respawnAt) })
+	if arrived.dead() || arrived.state.Position != spawn {
+		t.Errorf("health %d at %v, want respawned at the new zone's spawn %v", arrived.state.Health, arrived.state.Position, spawn)
+	}
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect"
+)
+
+// 서버 설정 (명령행 플래그로 지정)
+type Config struct {
+	Port         string
+	DataDir      string        // 비우면 내장 데이터 사용
+	StartZone    string        // 접속 직후 들어가는 존
+	MaxInstances int           // 동시에 실행할 수 있는 인스턴스 수 (0 이면 제한 없음)
+	PartyGrace   time.Duration // 접속이 끊긴 파티원이 자리를 유지하는 시간
+	GMUsers      string        // GM 명령을 쓸 수 있는 사용자명 (쉼표로 구분)
+	Chat         ChatConfig
+	DB           DBConfig
+	Credential   CredentialPolicy
+}
+
+func loadConfig() Config {
+	cfg := Config{Credential: defaultCredentialPolicy(), Chat: defaultChatConfig()}
+	flag.StringVar(&cfg.Port, "port", "9160", "<portNumber>")
+	flag.StringVar(&cfg.DataDir, "data", "", "게임 데이터 디렉토리 (비우면 내장 데이터 사용)")
+	flag.StringVar(&cfg.StartZone, "start-zone", "town", "접속 직후 들어가는 존 ID")
+	flag.IntVar(&cfg.MaxInstances, "max-instances", 100, "동시에 실행할 수 있는 인스턴스 수 (0 이면 제한 없음)")
+	flag.DurationVar(&cfg.PartyGrace, "party-grace", time.Minute, "접속이 끊긴 파티원을 파티에서 빼기까지 기다리는 시간 (그 안에 다시 접속하면 유지)")
+	flag.StringVar(&cfg.Chat.Filter, "chat-filter", cfg.Chat.Filter, "채팅 금칙어 필터 (mask | none)")
+	flag.IntVar(&cfg.Chat.HistorySize, "chat-history", cfg.Chat.HistorySize, "채널별로 보관하고 입장 시 보내는 최근 채팅 수")
+	flag.DurationVar(&cfg.Chat.Retention, "chat-retention", cfg.Chat.Retention, "채널 채팅 DB 보관 기간")
+	flag.DurationVar(&cfg.Chat.WhisperRetention, "whisper-retention", cfg.Chat.WhisperRetention, "귓속말 DB 보관 기간 (받지 못한 귓속말도 지나면 삭제)")
+	flag.IntVar(&cfg.Chat.MaxOfflineWhispers, "offline-whispers", cfg.Chat.MaxOfflineWhispers, "플레이어별로 쌓아 둘 수 있는 받지 않은 귓속말 수")
+	flag.StringVar(&cfg.GMUsers, "gm-users", os.Getenv("GAMESERVER_GM_USERS"), "GM 사용자명 목록 (쉼표로 구분)")
+	flag.StringVar(&cfg.DB.Driver, "db-driver", envOr("GAMESERVER_DB_DRIVER", dialect.SQLite), "DB 드라이버 (sqlite3 | postgres)")
+	flag.StringVar(&cfg.DB.DSN, "db-dsn", os.Getenv("GAMESERVER_DB_DSN"), "DB 접속 문자열 (비우면 드라이버별 기본값, sqlite 메모리 DB: file:gameserver?mode=memory&cache=shared)")
+	flag.IntVar(&cfg.Credential.MinUsernameLen, "username-min", cfg.Credential.MinUsernameLen, "사용자명 최소 글자 수")
+	flag.IntVar(&cfg.Credential.MaxUsernameLen, "username-max", cfg.Credential.MaxUsernameLen, "사용자명 최대 글자 수")
+	flag.StringVar(&cfg.Credential.UsernameSymbols, "username-symbols", cfg.Credential.UsernameSymbols, "사용자명에 허용할 글자/숫자 외 문자")
+	flag.IntVar(&cfg.Credential.MinPasswordLen, "password-min", cfg.Credential.MinPasswordLen, "비밀번호 최소 글자 수")
+	flag.IntVar(&cfg.Credential.MaxPasswordLen, "password-max", cfg.Credential.MaxPasswordLen, "비밀번호 최대 바이트 수")
+	flag.Parse()
+	return cfg
+}
+
+// 채팅 설정
+type ChatConfig struct {
+	Filter             string        // 채팅 금칙어 필터 (chatFilters 에 등록된 이름)
+	HistorySize        int           // 채널별 최근 기록 수
+	Retention          time.Duration // 채널 기록 보관 기간
+	WhisperRetention   time.Duration // 귓속말 보관 기간
+	MaxOfflineWhispers int           // 받지 않은 귓속말 최대 수
+}
+
+func defaultChatConfig() ChatConfig {
+	return ChatConfig{
+		Filter:             "mask",
+		HistorySize:        50,
+		Retention:          30 * 24 * time.Hour,
+		WhisperRetention:   30 * 24 * time.Hour,
+		MaxOfflineWhispers: 100,
+	}
+}
+
+// 환경변수 값 (없으면 기본값)
+func envOr(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
+
+// GM 사용자명 집합
+func (c Config) gmSet() map[string]bool {
+	gms := make(map[string]bool)
+	for _, name := range strings.Split(c.GMUsers, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			gms[name] = true
+		}
+	}
+	return gms
+}
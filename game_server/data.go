@@ -0,0 +1,100 @@
+package main
+
+import (
+	"bufio"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+)
+
+// 서버에 내장된 기본 게임 데이터
+//
+//go:embed data
+var embeddedData embed.FS
+
+// 게임 데이터 파일 시스템. -data 플래그로 외부 디렉토리를 지정하면 그쪽을 사용한다.
+var dataFS fs.FS
+
+func initDataFS(dir string) error {
+	if dir == "" {
+		sub, err := fs.Sub(embeddedData, "data")
+		if err != nil {
+			return err
+		}
+		dataFS = sub
+		return nil
+	}
+	if _, err := os.Stat(dir); err != nil {
+		return err
+	}
+	dataFS = os.DirFS(dir)
+	return nil
+}
+
+// 줄 단위 목록 파일 읽기 (빈 줄과 # 주석은 건너뜀)
+func readDataLines(name string) ([]string, error) {
+	f, err := dataFS.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var lines []string
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines, sc.Err()
+}
+
+// JSON 데이터 파일 읽기 (정의에 없는 필드는 오류)
+func readDataJSON(name string, v interface{}) error {
+	f, err := dataFS.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	dec := json.NewDecoder(f)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return fmt.Errorf("%s: %w", path.Base(name), err)
+	}
+	return nil
+}
+
+// 서버 시작 시 읽는 게임 정의 전체 (읽기 전용으로 여러 액터가 공유)
+type gameData struct {
+	zones      map[string]*zoneDef
+	instances  map[string]*instanceTemplate
+	matchModes map[string]*matchMode
+	combat     *combatRules
+}
+
+// 게임 정의 로드. 서로 참조하는 정의는 참조 대상을 먼저 읽는다.
+func loadGameData() (*gameData, error) {
+	combat, err := loadCombatRules()
+	if err != nil {
+		return nil, fmt.Errorf("전투 규칙: %w", err)
+	}
+	zones, err := loadZoneDefs(combat.npcs)
+	if err != nil {
+		return nil, fmt.Errorf("존 정의: %w", err)
+	}
+	instances, err := loadInstanceTemplates(zones, combat.npcs)
+	if err != nil {
+		return nil, fmt.Errorf("인스턴스 템플릿: %w", err)
+	}
+	modes, err := loadMatchModes(instances)
+	if err != nil {
+		return nil, fmt.Errorf("매치 모드: %w", err)
+	}
+	return &gameData{zones: zones, instances: instances, matchModes: modes, combat: combat}, nil
+}
@@ -2,28 +2,106 @@ package main
 
 import (
 	"context"
+	stdsql "database/sql"
 	"fmt"
-	"log"
+	"strings"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"github.com/SilverSS/gameserver/ent"
 	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
 )
 
-// DB 연결 및 마이그레이션 함수
-func InitDB() (*ent.Client, error) {
-	// 환경변수 또는 설정 파일로 분리 가능
-	dsn := "host=localhost port=21483 user=eos password=SYRius214!@ dbname=gameserverdb sslmode=disable"
-	drv, err := sql.Open(dialect.Postgres, dsn)
+// 저장소 설정
+// 개발/테스트: sqlite3 (파일 또는 메모리), 운영: postgres
+type DBConfig struct {
+	Driver string // dialect.SQLite("sqlite3") 또는 dialect.Postgres("postgres")
+	DSN    string // 비우면 드라이버별 기본값 사용
+}
+
+// 드라이버별 기본 DSN
+func defaultDSN(driver string) string {
+	switch driver {
+	case dialect.Postgres:
+		return "host=localhost port=21483 user=eos password=SYRius214!@ dbname=gameserverdb sslmode=disable"
+	default:
+		return "file:gameserver.db?cache=shared"
+	}
+}
+
+// 메모리 DB 여부 (프로세스 종료 시 내용이 사라짐)
+func (c DBConfig) inMemory() bool {
+	return c.Driver == dialect.SQLite && strings.Contains(c.DSN, "mode=memory")
+}
+
+// 드라이버별 DSN 보정. sqlite 는 외래 키 제약을 켜야 ent 가 동작한다.
+func (c DBConfig) dsn() string {
+	dsn := c.DSN
+	if dsn == "" {
+		dsn = defaultDSN(c.Driver)
+	}
+	if c.Driver == dialect.SQLite && !strings.Contains(dsn, "_fk=") {
+		sep := "?"
+		if strings.Contains(dsn, "?") {
+			sep = "&"
+		}
+		dsn += sep + "_fk=1"
+	}
+	return dsn
+}
+
+// DB 연결 (database/sql)
+func openSQL(cfg DBConfig) (*stdsql.DB, error) {
+	switch cfg.Driver {
+	case dialect.SQLite, dialect.Postgres:
+	default:
+		return nil, fmt.Errorf("unsupported db driver %q (sqlite3 or postgres)", cfg.Driver)
+	}
+	db, err := stdsql.Open(cfg.Driver, cfg.dsn())
 	if err != nil {
-		return nil, fmt.Errorf("failed opening connection to postgres: %w", err)
+		return nil, fmt.Errorf("failed opening connection to %s: %w", cfg.Driver, err)
+	}
+	if cfg.inMemory() {
+		// 메모리 DB 는 모든 연결이 닫히면 사라지므로 연결 하나를 계속 유지한다.
+		db.SetMaxIdleConns(1)
+		db.SetConnMaxLifetime(0)
+	}
+	return db, nil
+}
+
+// DB 연결 (ent)
+func openDB(cfg DBConfig) (*ent.Client, error) {
+	db, err := openSQL(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return ent.NewClient(ent.Driver(sql.OpenDB(cfg.Driver, db))), nil
+}
+
+// DB 연결 및 스키마 버전 확인
+// 스키마 변경은 `migrate up` 으로만 적용한다. 메모리 DB 는 매번 비어 있으므로 시작할 때 바로 적용한다.
+func InitDB(cfg DBConfig) (*ent.Client, error) {
+	db, err := openSQL(cfg)
+	if err != nil {
+		return nil, err
+	}
+	m, err := newMigrator(db, cfg.Driver)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	ctx := context.Background()
+	if cfg.inMemory() {
+		if _, err := m.up(ctx, 0); err != nil {
+			db.Close()
+			return nil, fmt.Errorf("failed applying migrations: %w", err)
+		}
 	}
-	client := ent.NewClient(ent.Driver(drv))
-	// 마이그레이션 실행
-	if err := client.Schema.Create(context.Background()); err != nil {
-		return nil, fmt.Errorf("failed creating schema resources: %w", err)
+	if err := m.checkCurrent(ctx); err != nil {
+		db.Close()
+		return nil, err
 	}
-	log.Println("DB 연결 및 마이그레이션 성공!")
-	return client, nil
+	fmt.Printf("DB 연결 및 스키마 확인 성공! (driver: %s)\n", cfg.Driver)
+	return ent.NewClient(ent.Driver(sql.OpenDB(cfg.Driver, db))), nil
 }
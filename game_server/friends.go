@@ -0,0 +1,363 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/SilverSS/gameserver/ent"
+	"github.com/SilverSS/gameserver/types"
+	"github.com/anthdm/hollywood/actor"
+)
+
+const (
+	friendDBTimeout    = 5 * time.Second
+	friendQueryTimeout = 2 * time.Second // /friends 가 친구 액터에 접속 상태를 묻는 제한 시간
+)
+
+// 친구 액터 메시지
+type (
+	// 친구 요청/수락/거절/삭제/차단/차단 해제 (세션 -> 친구). Action 은 types.FriendAction*
+	// Chat 이면 chatBlock/chatUnblock 으로 온 차단으로, 결과를 chatResult 로 답한다.
+	friendAction struct {
+		Session  *actor.PID
+		Username string
+		Target   string
+		Action   string
+		Chat     bool
+	}
+
+	// DB 처리 결과 (처리 고루틴 -> 친구). Target 은 대상의 정식 사용자명.
+	// Changed: request 는 바로 친구가 됨, remove/block 은 친구였음
+	friendActionDone struct {
+		Session  *actor.PID
+		Username string
+		Target   string
+		Action   string
+		Chat     bool
+		Changed  bool
+		Err      error
+	}
+
+	// 접속한 플레이어의 친구 목록 (읽기 고루틴 -> 친구)
+	friendsLoaded struct {
+		Username string
+		Session  *actor.PID
+		Friends  []string
+		Err      error
+	}
+
+	// 접속 상태 조회 (/friends 핸들러 -> 친구, Request/Respond). 응답은 []types.FriendPresence
+	friendPresenceQuery struct {
+		Usernames []string
+	}
+
+	// 차단/차단 해제가 DB 에 반영됨 (친구 액터가 엔진 이벤트 스트림으로 방송). 이름은 모두 정식 사용자명.
+	blockChanged struct {
+		Username string
+		Target   string
+		Block    bool
+	}
+)
+
+type friendStatus struct {
+	session *actor.PID
+	zoneID  string
+}
+
+// 친구 액터: 친구 관계 변경을 DB 에 반영하고, 접속/접속 종료/존 이동을 친구들에게 알린다.
+// 접속 중인 플레이어의 친구 목록만 메모리에 둔다 (접속할 때 DB 에서 읽음).
+type Friends struct {
+	db      *ent.Client
+	online  map[string]*friendStatus
+	friends map[string]map[string]bool // 접속 중인 사용자 -> 친구들
+}
+
+func newFriends(db *ent.Client) actor.Producer {
+	return func() actor.Receiver {
+		return &Friends{
+			db:      db,
+			online:  make(map[string]*friendStatus),
+			friends: make(map[string]map[string]bool),
+		}
+	}
+}
+
+func (f *Friends) Receive(c *actor.Context) {
+	switch msg := c.Message().(type) {
+	case actor.Started:
+		c.Engine().Subscribe(c.PID())
+	case actor.Stopped:
+		c.Engine().Unsubscribe(c.PID())
+	case playerOnline:
+		f.online[msg.Username] = &friendStatus{session: msg.Session}
+		f.load(c, msg)
+	case playerOffline:
+		if st, ok := f.online[msg.Username]; ok && st.session.Equals(msg.Session) {
+			delete(f.online, msg.Username)
+			f.notify(c, msg.Username)
+			delete(f.friends, msg.Username)
+		}
+	case playerZoneChanged:
+		if st, ok := f.online[msg.Username]; ok && st.session.Equals(msg.Session) {
+			st.zoneID = msg.ZoneID
+			f.notify(c, msg.Username)
+		}
+	case friendsLoaded:
+		f.loaded(c, msg)
+	case friendAction:
+		f.action(c, msg)
+	case friendActionDone:
+		f.actionDone(c, msg)
+	case friendPresenceQuery:
+		presence := make([]types.FriendPresence, len(msg.Usernames))
+		for i, name := range msg.Usernames {
+			presence[i] = f.presence(name)
+		}
+		c.Respond(presence)
+	}
+}
+
+// 접속한 플레이어의 친구 목록을 읽는다
+func (f *Friends) load(c *actor.Context, msg playerOnline) {
+	db, self, engine := f.db, c.PID(), c.Engine()
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), friendDBTimeout)
+		defer cancel()
+		l, err := listFriends(ctx, db, msg.Username)
+		loaded := friendsLoaded{Username: msg.Username, Session: msg.Session, Err: err}
+		if err == nil {
+			loaded.Friends = l.Friends
+		}
+		engine.Send(self, loaded)
+	}()
+}
+
+// 친구 목록을 받으면 친구들에게 접속을 알리고, 접속한 플레이어에게는 접속 중인 친구들을 알린다.
+func (f *Friends) loaded(c *actor.Context, msg friendsLoaded) {
+	st, ok := f.online[msg.Username]
+	if !ok || !st.session.Equals(msg.Session) {
+		return
+	}
+	if msg.Err != nil {
+		fmt.Printf("friends: loading friends of %s failed: %v\n", msg.Username, msg.Err)
+		return
+	}
+	set := make(map[string]bool, len(msg.Friends))
+	for _, name := range msg.Friends {
+		set[name] = true
+	}
+	f.friends[msg.Username] = set
+	f.notify(c, msg.Username)
+	for name := range set {
+		if _, ok := f.online[name]; ok {
+			c.Send(st.session, wsSend{Type: "friendPresence", Data: f.presence(name)})
+		}
+	}
+}
+
+func (f *Friends) presence(username string) types.FriendPresence {
+	st, ok := f.online[username]
+	if !ok {
+		return types.FriendPresence{Username: username}
+	}
+	return types.FriendPresence{Username: username, Online: true, ZoneID: st.zoneID}
+}
+
+// 접속 중인 친구들에게 username 의 현재 상태를 보낸다
+func (f *Friends) notify(c *actor.Context, username string) {
+	p := f.presence(username)
+	for name := range f.friends[username] {
+		if st, ok := f.online[name]; ok {
+			c.Send(st.session, wsSend{Type: "friendPresence", Data: p})
+		}
+	}
+}
+
+// DB 작업은 고루틴에서 하고 결과는 friendActionDone 으로 받는다
+func (f *Friends) action(c *actor.Context, msg friendAction) {
+	done := friendActionDone{Session: msg.Session, Username: msg.Username, Action: msg.Action, Chat: msg.Chat}
+	if msg.Target == "" || usernameKey(msg.Target) == usernameKey(msg.Username) {
+		done.Err = errInvalidFriend
+		f.actionDone(c, done)
+		return
+	}
+	db, self, engine := f.db, c.PID(), c.Engine()
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), friendDBTimeout)
+		defer cancel()
+		switch msg.Action {
+		case types.FriendActionRequest:
+			done.Target, done.Changed, done.Err = requestFriend(ctx, db, msg.Username, msg.Target)
+		case types.FriendActionAccept:
+			done.Target, done.Err = acceptFriend(ctx, db, msg.Username, msg.Target)
+		case types.FriendActionDecline:
+			done.Target, done.Err = declineFriend(ctx, db, msg.Username, msg.Target)
+		case types.FriendActionRemove:
+			done.Target, done.Changed, done.Err = removeFriend(ctx, db, msg.Username, msg.Target)
+		case types.FriendActionBlock:
+			done.Target, done.Changed, done.Err = blockUser(ctx, db, msg.Username, msg.Target)
+		case types.FriendActionUnblock:
+			done.Target, done.Err = unblockUser(ctx, db, msg.Username, msg.Target)
+		}
+		engine.Send(self, done)
+	}()
+}
+
+func (f *Friends) actionDone(c *actor.Context, msg friendActionDone) {
+	if msg.Chat {
+		f.replyChat(c, msg)
+	}
+	if msg.Err != nil {
+		code := friendErrCode(msg.Err)
+		if code == types.ErrCodeInternal {
+			fmt.Printf("friends: %s %s -> %s failed: %v\n", msg.Action, msg.Username, msg.Target, msg.Err)
+		}
+		if !msg.Chat {
+			f.reply(c, msg.Session, msg.Action, code, "")
+		}
+		return
+	}
+	if !msg.Chat {
+		f.reply(c, msg.Session, msg.Action, "", msg.Target)
+	}
+	switch msg.Action {
+	case types.FriendActionRequest:
+		if msg.Changed {
+			f.befriend(c, msg.Username, msg.Target)
+		} else if st, ok := f.online[msg.Target]; ok {
+			c.Send(st.session, wsSend{Type: "friendRequestReceived", Data: types.FriendRequestReceived{From: msg.Username}})
+		}
+	case types.FriendActionAccept:
+		f.befriend(c, msg.Username, msg.Target)
+	case types.FriendActionRemove, types.FriendActionBlock:
+		if msg.Changed {
+			f.unfriend(c, msg.Username, msg.Target)
+		}
+	}
+	if msg.Action == types.FriendActionBlock || msg.Action == types.FriendActionUnblock {
+		c.Engine().BroadcastEvent(blockChanged{Username: msg.Username, Target: msg.Target, Block: msg.Action == types.FriendActionBlock})
+	}
+}
+
+// 친구가 됨: 접속 중이면 목록에 넣고 서로의 상태를 알린다
+func (f *Friends) befriend(c *actor.Context, a, b string) {
+	for _, pair := range [][2]string{{a, b}, {b, a}} {
+		st, ok := f.online[pair[0]]
+		if !ok {
+			continue
+		}
+		if set, ok := f.friends[pair[0]]; ok {
+			set[pair[1]] = true
+		}
+		c.Send(st.session, wsSend{Type: "friendAdded", Data: f.presence(pair[1])})
+	}
+}
+
+// a 가 b 를 친구에서 삭제(또는 차단)함
+func (f *Friends) unfriend(c *actor.Context, a, b string) {
+	delete(f.friends[a], b)
+	delete(f.friends[b], a)
+	if st, ok := f.online[b]; ok {
+		c.Send(st.session, wsSend{Type: "friendRemoved", Data: types.FriendRemoved{Username: a}})
+	}
+}
+
+func (f *Friends) reply(c *actor.Context, session *actor.PID, action, code, username string) {
+	c.Send(session, wsSend{Type: "friendResult", Data: types.FriendResult{
+		Action:   action,
+		Success:  code == "",
+		Code:     code,
+		Username: username,
+	}})
+}
+
+// chatBlock/chatUnblock 의 결과. 차단하지 않은 사용자의 차단 해제는 이전처럼 성공으로 답한다.
+func (f *Friends) replyChat(c *actor.Context, msg friendActionDone) {
+	action := types.ChatActionUnblock
+	if msg.Action == types.FriendActionBlock {
+		action = types.ChatActionBlock
+	}
+	code := ""
+	switch {
+	case msg.Err == nil, errors.Is(msg.Err, errNotBlocked):
+	case errors.Is(msg.Err, errUnknownPlayer):
+		code = types.ChatErrUnknownPlayer
+	case errors.Is(msg.Err, errInvalidFriend):
+		code = types.ChatErrInvalidCommand
+	default:
+		code = types.ErrCodeInternal
+	}
+	c.Send(msg.Session, wsSend{Type: "chatResult", Data: types.ChatResult{Action: action, Success: code == "", Code: code}})
+}
+
+func friendErrCode(err error) string {
+	switch {
+	case errors.Is(err, errUnknownPlayer):
+		return types.FriendErrUnknownPlayer
+	case errors.Is(err, errInvalidFriend):
+		return types.FriendErrInvalidTarget
+	case errors.Is(err, errAlreadyFriends):
+		return types.FriendErrAlreadyFriends
+	case errors.Is(err, errAlreadyRequested):
+		return types.FriendErrAlreadyRequested
+	case errors.Is(err, errFriendUnavailable):
+		return types.FriendErrUnavailable
+	case errors.Is(err, errNoFriendRequest):
+		return types.FriendErrNoRequest
+	case errors.Is(err, errNotFriends):
+		return types.FriendErrNotFriends
+	case errors.Is(err, errNotBlocked):
+		return types.FriendErrNotBlocked
+	}
+	return types.ErrCodeInternal
+}
+
+// 요청의 JWT (Authorization: Bearer 헤더, 없으면 token 쿼리)
+func requestToken(r *http.Request) string {
+	if h := r.Header.Get("Authorization"); strings.HasPrefix(h, "Bearer ") {
+		return strings.TrimPrefix(h, "Bearer ")
+	}
+	return r.URL.Query().Get("token")
+}
+
+// 친구 목록 조회 HTTP 핸들러 (접속 상태 포함)
+func (s *GameServer) handleFriends(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		writeJSON(w, http.StatusMethodNotAllowed, types.FriendListResponse{Code: types.ErrCodeMethodNotAllowed})
+		return
+	}
+	username, err := verifyJWT(requestToken(r))
+	if err != nil {
+		writeJSON(w, http.StatusUnauthorized, types.FriendListResponse{Code: types.FriendErrUnauthorized})
+		return
+	}
+	l, err := listFriends(r.Context(), s.dbClient, username)
+	if errors.Is(err, errUnknownPlayer) {
+		writeJSON(w, http.StatusNotFound, types.FriendListResponse{Code: types.FriendErrUnknownPlayer})
+		return
+	}
+	if err != nil {
+		fmt.Printf("[FRIENDS] 친구 목록 조회 실패 (%s): %v\n", username, err)
+		writeJSON(w, http.StatusInternalServerError, types.FriendListResponse{Code: types.ErrCodeInternal})
+		return
+	}
+	res, err := s.ctx.Engine().Request(s.friends, friendPresenceQuery{Usernames: l.Friends}, friendQueryTimeout).Result()
+	presence, ok := res.([]types.FriendPresence)
+	if err != nil || !ok {
+		fmt.Printf("[FRIENDS] 접속 상태 조회 실패 (%s): %v\n", username, err)
+		writeJSON(w, http.StatusInternalServerError, types.FriendListResponse{Code: types.ErrCodeInternal})
+		return
+	}
+	writeJSON(w, http.StatusOK, types.FriendListResponse{
+		Success:  true,
+		Friends:  presence,
+		Incoming: l.Incoming,
+		Outgoing: l.Outgoing,
+		Blocked:  l.Blocked,
+	})
+}
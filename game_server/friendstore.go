@@ -0,0 +1,284 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/SilverSS/gameserver/ent"
+	"github.com/SilverSS/gameserver/ent/friendship"
+	"github.com/SilverSS/gameserver/ent/user"
+)
+
+// 친구 관계 변경 실패 사유 (없는 계정은 errUnknownPlayer)
+var (
+	errInvalidFriend     = errors.New("cannot befriend yourself")
+	errAlreadyFriends    = errors.New("already friends")
+	errAlreadyRequested  = errors.New("friend request already sent")
+	errFriendUnavailable = errors.New("blocked")
+	errNoFriendRequest   = errors.New("no friend request")
+	errNotFriends        = errors.New("not friends")
+	errNotBlocked        = errors.New("not blocked")
+)
+
+// 친구 목록 (정식 사용자명, 이름순)
+type friendList struct {
+	Friends  []string
+	Incoming []string // 나에게 온 요청
+	Outgoing []string // 내가 보낸 요청
+	Blocked  []string
+}
+
+func findUser(ctx context.Context, client *ent.Client, username string) (*ent.User, error) {
+	u, err := client.User.Query().Where(user.UsernameKeyEQ(usernameKey(username))).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, errUnknownPlayer
+	}
+	return u, err
+}
+
+// 요청한 사용자와 대상 사용자 조회 (같은 계정이면 errInvalidFriend)
+func friendPair(ctx context.Context, client *ent.Client, username, target string) (*ent.User, *ent.User, error) {
+	u, err := findUser(ctx, client, username)
+	if err != nil {
+		return nil, nil, err
+	}
+	t, err := findUser(ctx, client, target)
+	if err != nil {
+		return nil, nil, err
+	}
+	if u.ID == t.ID {
+		return nil, nil, errInvalidFriend
+	}
+	return u, t, nil
+}
+
+// from 이 to 에 대해 가진 관계 (없으면 nil)
+func friendRelation(ctx context.Context, client *ent.Client, from, to int) (*ent.Friendship, error) {
+	f, err := client.Friendship.Query().Where(friendship.UserID(from), friendship.FriendID(to)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return f, err
+}
+
+// from 이 to 에 대해 가진 관계를 status 로 만든다
+func setFriendRelation(ctx context.Context, client *ent.Client, cur *ent.Friendship, from, to int, status friendship.Status) error {
+	if cur != nil {
+		return cur.Update().SetStatus(status).Exec(ctx)
+	}
+	return client.Friendship.Create().SetUserID(from).SetFriendID(to).SetStatus(status).Exec(ctx)
+}
+
+// 친구 요청. 상대가 이미 요청을 보냈으면 바로 친구가 되고 accepted 가 true.
+func requestFriend(ctx context.Context, client *ent.Client, username, target string) (name string, accepted bool, err error) {
+	err = withTx(ctx, client, func(tx *ent.Tx) error {
+		c := tx.Client()
+		u, t, err := friendPair(ctx, c, username, target)
+		if err != nil {
+			return err
+		}
+		name = t.Username
+		mine, err := friendRelation(ctx, c, u.ID, t.ID)
+		if err != nil {
+			return err
+		}
+		theirs, err := friendRelation(ctx, c, t.ID, u.ID)
+		if err != nil {
+			return err
+		}
+		switch {
+		case mine != nil && mine.Status == friendship.StatusBlocked, theirs != nil && theirs.Status == friendship.StatusBlocked:
+			return errFriendUnavailable
+		case mine != nil && mine.Status == friendship.StatusAccepted:
+			return errAlreadyFriends
+		case mine != nil:
+			return errAlreadyRequested
+		case theirs != nil:
+			accepted = true
+			if err := setFriendRelation(ctx, c, theirs, t.ID, u.ID, friendship.StatusAccepted); err != nil {
+				return err
+			}
+			return setFriendRelation(ctx, c, nil, u.ID, t.ID, friendship.StatusAccepted)
+		}
+		return setFriendRelation(ctx, c, nil, u.ID, t.ID, friendship.StatusRequest)
+	})
+	return name, accepted, err
+}
+
+// from 이 보낸 친구 요청 수락
+func acceptFriend(ctx context.Context, client *ent.Client, username, from string) (name string, err error) {
+	err = withTx(ctx, client, func(tx *ent.Tx) error {
+		c := tx.Client()
+		u, f, err := friendPair(ctx, c, username, from)
+		if err != nil {
+			return err
+		}
+		name = f.Username
+		theirs, err := friendRelation(ctx, c, f.ID, u.ID)
+		if err != nil {
+			return err
+		}
+		if theirs == nil || theirs.Status != friendship.StatusRequest {
+			return errNoFriendRequest
+		}
+		mine, err := friendRelation(ctx, c, u.ID, f.ID)
+		if err != nil {
+			return err
+		}
+		if err := setFriendRelation(ctx, c, theirs, f.ID, u.ID, friendship.StatusAccepted); err != nil {
+			return err
+		}
+		return setFriendRelation(ctx, c, mine, u.ID, f.ID, friendship.StatusAccepted)
+	})
+	return name, err
+}
+
+// from 이 보낸 친구 요청 거절
+func declineFriend(ctx context.Context, client *ent.Client, username, from string) (string, error) {
+	u, f, err := friendPair(ctx, client, username, from)
+	if err != nil {
+		return "", err
+	}
+	n, err := client.Friendship.Delete().
+		Where(friendship.UserID(f.ID), friendship.FriendID(u.ID), friendship.StatusEQ(friendship.StatusRequest)).
+		Exec(ctx)
+	if err != nil {
+		return "", err
+	}
+	if n == 0 {
+		return "", errNoFriendRequest
+	}
+	return f.Username, nil
+}
+
+// 친구 삭제 또는 보낸 요청 취소. 친구였으면 wasFriend 가 true.
+func removeFriend(ctx context.Context, client *ent.Client, username, target string) (name string, wasFriend bool, err error) {
+	err = withTx(ctx, client, func(tx *ent.Tx) error {
+		c := tx.Client()
+		u, t, err := friendPair(ctx, c, username, target)
+		if err != nil {
+			return err
+		}
+		name = t.Username
+		mine, err := friendRelation(ctx, c, u.ID, t.ID)
+		if err != nil {
+			return err
+		}
+		if mine == nil || mine.Status == friendship.StatusBlocked {
+			return errNotFriends
+		}
+		wasFriend = mine.Status == friendship.StatusAccepted
+		_, err = c.Friendship.Delete().
+			Where(friendship.Or(
+				friendship.ID(mine.ID),
+				friendship.And(friendship.UserID(t.ID), friendship.FriendID(u.ID), friendship.StatusEQ(friendship.StatusAccepted)),
+			)).
+			Exec(ctx)
+		return err
+	})
+	return name, wasFriend, err
+}
+
+// 차단: 친구 관계와 상대가 보낸 요청을 지운다. 친구였으면 wasFriend 가 true.
+func blockUser(ctx context.Context, client *ent.Client, username, target string) (name string, wasFriend bool, err error) {
+	err = withTx(ctx, client, func(tx *ent.Tx) error {
+		c := tx.Client()
+		u, t, err := friendPair(ctx, c, username, target)
+		if err != nil {
+			return err
+		}
+		name = t.Username
+		mine, err := friendRelation(ctx, c, u.ID, t.ID)
+		if err != nil {
+			return err
+		}
+		wasFriend = mine != nil && mine.Status == friendship.StatusAccepted
+		_, err = c.Friendship.Delete().
+			Where(friendship.UserID(t.ID), friendship.FriendID(u.ID), friendship.StatusIn(friendship.StatusRequest, friendship.StatusAccepted)).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		return setFriendRelation(ctx, c, mine, u.ID, t.ID, friendship.StatusBlocked)
+	})
+	return name, wasFriend, err
+}
+
+func unblockUser(ctx context.Context, client *ent.Client, username, target string) (string, error) {
+	u, t, err := friendPair(ctx, client, username, target)
+	if err != nil {
+		return "", err
+	}
+	n, err := client.Friendship.Delete().
+		Where(friendship.UserID(u.ID), friendship.FriendID(t.ID), friendship.StatusEQ(friendship.StatusBlocked)).
+		Exec(ctx)
+	if err != nil {
+		return "", err
+	}
+	if n == 0 {
+		return "", errNotBlocked
+	}
+	return t.Username, nil
+}
+
+// 사용자의 친구 목록
+func listFriends(ctx context.Context, client *ent.Client, username string) (*friendList, error) {
+	u, err := findUser(ctx, client, username)
+	if err != nil {
+		return nil, err
+	}
+	mine, err := client.Friendship.Query().Where(friendship.UserID(u.ID)).WithFriend().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	incoming, err := client.Friendship.Query().
+		Where(friendship.FriendID(u.ID), friendship.StatusEQ(friendship.StatusRequest)).
+		WithUser().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	l := &friendList{Friends: []string{}, Incoming: []string{}, Outgoing: []string{}, Blocked: []string{}}
+	for _, f := range mine {
+		switch f.Status {
+		case friendship.StatusAccepted:
+			l.Friends = append(l.Friends, f.Edges.Friend.Username)
+		case friendship.StatusRequest:
+			l.Outgoing = append(l.Outgoing, f.Edges.Friend.Username)
+		case friendship.StatusBlocked:
+			l.Blocked = append(l.Blocked, f.Edges.Friend.Username)
+		}
+	}
+	for _, f := range incoming {
+		l.Incoming = append(l.Incoming, f.Edges.User.Username)
+	}
+	for _, names := range [][]string{l.Friends, l.Incoming, l.Outgoing, l.Blocked} {
+		sort.Strings(names)
+	}
+	return l, nil
+}
+
+// 사용자가 차단한 사용자들과 사용자를 차단한 사용자들 (정식 사용자명)
+func loadBlocks(ctx context.Context, client *ent.Client, username string) (blocked, blockers []string, err error) {
+	u, err := findUser(ctx, client, username)
+	if err != nil {
+		return nil, nil, err
+	}
+	rows, err := client.Friendship.Query().
+		Where(friendship.Or(friendship.UserID(u.ID), friendship.FriendID(u.ID)), friendship.StatusEQ(friendship.StatusBlocked)).
+		WithUser().
+		WithFriend().
+		All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, f := range rows {
+		if f.UserID == u.ID {
+			blocked = append(blocked, f.Edges.Friend.Username)
+		} else {
+			blockers = append(blockers, f.Edges.User.Username)
+		}
+	}
+	return blocked, blockers, nil
+}
@@ -0,0 +1,574 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/SilverSS/gameserver/ent"
+	"github.com/SilverSS/gameserver/types"
+	"github.com/anthdm/hollywood/actor"
+)
+
+// 길드 제한
+const (
+	maxGuildMembers    = 100
+	minGuildNameLen    = 2
+	maxGuildNameLen    = 24
+	maxGuildMotdLen    = 200
+	guildInviteTimeout = 5 * time.Minute
+	guildDBTimeout     = 5 * time.Second
+	guildWriteQueueLen = 256
+	guildActionWrites  = 2 // 요청 하나가 큐에 넣는 최대 쓰기 수 (길드장 위임은 두 사람의 등급을 바꾼다)
+)
+
+// 등급별 권한
+type guildPerm int
+
+const (
+	guildPermInvite guildPerm = 1 << iota
+	guildPermKick             // 자기보다 낮은 등급만
+	guildPermMotd
+	guildPermSetRank
+	guildPermDisband
+)
+
+var guildRankPerms = map[string]guildPerm{
+	types.GuildRankLeader:  guildPermInvite | guildPermKick | guildPermMotd | guildPermSetRank | guildPermDisband,
+	types.GuildRankOfficer: guildPermInvite | guildPermKick | guildPermMotd,
+	types.GuildRankMember:  0,
+}
+
+// 등급 순서 (클수록 높음)
+var guildRankOrder = map[string]int{
+	types.GuildRankLeader:  3,
+	types.GuildRankOfficer: 2,
+	types.GuildRankMember:  1,
+}
+
+// 길드 액터 메시지
+type (
+	// 길드 요청 (세션 -> 길드). Action 은 types.GuildAction*, 나머지는 요청에 따라 쓴다.
+	guildRequest struct {
+		Session  *actor.PID
+		Username string
+		Action   string
+		Target   string // invite, kick, setRank 대상
+		GuildID  int    // accept, decline
+		Rank     string // setRank
+		Text     string // create 의 길드 이름, motd
+	}
+
+	guildRosterRequest struct {
+		Session  *actor.PID
+		Username string
+	}
+
+	// 길드 채팅 전달 (채팅 -> 길드). Blocked 는 보낸 사람을 차단한 사용자명.
+	guildChat struct {
+		Session  *actor.PID
+		Username string
+		Blocked  map[string]bool
+		Msg      types.ChatMessage
+	}
+
+	// 길드 생성 결과 (DB 쓰기 고루틴 -> 길드)
+	guildCreated struct {
+		Session  *actor.PID
+		Username string
+		NameKey  string
+		Guild    *ent.Guild
+		Err      error
+	}
+)
+
+// DB 쓰기 작업 (쓰기 고루틴이 들어온 순서대로 실행)
+type guildWrite struct {
+	desc string
+	fn   func(ctx context.Context) error
+}
+
+type guildState struct {
+	id      int
+	name    string
+	motd    string
+	members map[string]string // 사용자명 -> 등급
+}
+
+// 길드 액터: 서버 시작 시 모든 길드를 읽어 메모리에 두고, 변경은 메모리에 바로 반영한 뒤
+// 쓰기 고루틴 하나가 순서대로 DB 에 저장한다 (길드 생성만 DB 결과를 기다린다).
+type Guilds struct {
+	db       *ent.Client
+	guilds   map[int]*guildState
+	names    map[string]bool // 사용 중이거나 만드는 중인 길드 이름 키
+	byMember map[string]*guildState
+	creating map[string]bool // 길드를 만드는 중인 사용자
+	invites  map[string]map[int]time.Time
+	online   onlinePlayers
+	players  map[string]string // usernameKey -> 접속 중인 정식 사용자명
+	writes   chan guildWrite
+}
+
+func newGuilds(db *ent.Client) actor.Producer {
+	return func() actor.Receiver {
+		return &Guilds{
+			db:       db,
+			guilds:   make(map[int]*guildState),
+			names:    make(map[string]bool),
+			byMember: make(map[string]*guildState),
+			creating: make(map[string]bool),
+			invites:  make(map[string]map[int]time.Time),
+			online:   make(onlinePlayers),
+			players:  make(map[string]string),
+		}
+	}
+}
+
+func (g *Guilds) Receive(c *actor.Context) {
+	switch msg := c.Message().(type) {
+	case actor.Started:
+		g.load()
+		g.writes = make(chan guildWrite, guildWriteQueueLen)
+		go g.writer(g.writes)
+		c.Engine().Subscribe(c.PID())
+	case actor.Stopped:
+		c.Engine().Unsubscribe(c.PID())
+		close(g.writes)
+	case playerOnline:
+		g.online.apply(msg)
+		g.players[usernameKey(msg.Username)] = msg.Username
+		if gs, ok := g.byMember[msg.Username]; ok {
+			g.broadcast(c, gs, types.GuildEvent{Type: types.GuildEventOnline, Username: msg.Username})
+			c.Send(msg.Session, wsSend{Type: "guildRoster", Data: g.roster(gs)})
+		}
+	case playerOffline:
+		pid, ok := g.online[msg.Username]
+		g.online.apply(msg)
+		if _, still := g.online[msg.Username]; !still {
+			delete(g.players, usernameKey(msg.Username))
+		}
+		if gs, member := g.byMember[msg.Username]; member && ok && pid.Equals(msg.Session) {
+			g.broadcast(c, gs, types.GuildEvent{Type: types.GuildEventOffline, Username: msg.Username})
+		}
+	case guildRequest:
+		g.handle(c, msg)
+	case guildCreated:
+		g.created(c, msg)
+	case guildRosterRequest:
+		gs, ok := g.byMember[msg.Username]
+		if !ok {
+			g.reply(c, msg.Session, types.GuildActionRoster, types.GuildErrNotInGuild, 0)
+			return
+		}
+		c.Send(msg.Session, wsSend{Type: "guildRoster", Data: g.roster(gs)})
+	case guildChat:
+		g.chat(c, msg)
+	}
+}
+
+// 서버 시작 시 모든 길드를 읽는다
+func (g *Guilds) load() {
+	ctx, cancel := context.WithTimeout(context.Background(), guildDBTimeout)
+	defer cancel()
+	rows, err := loadGuilds(ctx, g.db)
+	if err != nil {
+		fmt.Printf("guilds: loading guilds failed: %v\n", err)
+		return
+	}
+	for _, row := range rows {
+		gs := &guildState{id: row.ID, name: row.Name, motd: row.Motd, members: make(map[string]string, len(row.Edges.Members))}
+		for _, m := range row.Edges.Members {
+			gs.members[m.Edges.User.Username] = string(m.Rank)
+			g.byMember[m.Edges.User.Username] = gs
+		}
+		g.guilds[gs.id] = gs
+		g.names[row.NameKey] = true
+	}
+	fmt.Printf("guilds: %d loaded\n", len(g.guilds))
+}
+
+// 쓰기 고루틴: 큐에 들어온 순서대로 DB 에 반영한다
+func (g *Guilds) writer(writes <-chan guildWrite) {
+	for w := range writes {
+		ctx, cancel := context.WithTimeout(context.Background(), guildDBTimeout)
+		if err := w.fn(ctx); err != nil {
+			fmt.Printf("guilds: %s failed: %v\n", w.desc, err)
+		}
+		cancel()
+	}
+}
+
+// 쓰기 큐에 넣는다. handle 이 먼저 writeQueueFull 로 자리를 확인하므로 막히지 않는다.
+func (g *Guilds) persist(desc string, fn func(ctx context.Context) error) {
+	g.writes <- guildWrite{desc: desc, fn: fn}
+}
+
+// DB 가 느려 쓰기가 밀려 있는지. 큐에는 이 액터만 넣으므로 자리가 있으면 이번 요청의 쓰기는 모두 들어간다.
+func (g *Guilds) writeQueueFull() bool {
+	return len(g.writes)+guildActionWrites > cap(g.writes)
+}
+
+func (g *Guilds) handle(c *actor.Context, msg guildRequest) {
+	// 큐가 찼을 때 기다리면 길드 액터가 멈추고, 저장하지 않고 메모리만 바꾸면 DB 와 어긋나므로 변경 요청을 거절한다
+	switch msg.Action {
+	case types.GuildActionInvite, types.GuildActionDecline:
+	default:
+		if g.writeQueueFull() {
+			g.reply(c, msg.Session, msg.Action, types.GuildErrBusy, 0)
+			return
+		}
+	}
+	switch msg.Action {
+	case types.GuildActionCreate:
+		g.create(c, msg)
+		return
+	case types.GuildActionAccept, types.GuildActionDecline:
+		g.respond(c, msg)
+		return
+	}
+	gs, ok := g.byMember[msg.Username]
+	if !ok {
+		g.reply(c, msg.Session, msg.Action, types.GuildErrNotInGuild, 0)
+		return
+	}
+	rank := gs.members[msg.Username]
+	need := map[string]guildPerm{
+		types.GuildActionDisband: guildPermDisband,
+		types.GuildActionInvite:  guildPermInvite,
+		types.GuildActionKick:    guildPermKick,
+		types.GuildActionSetRank: guildPermSetRank,
+		types.GuildActionMotd:    guildPermMotd,
+	}[msg.Action]
+	if guildRankPerms[rank]&need != need {
+		g.reply(c, msg.Session, msg.Action, types.GuildErrNoPermission, gs.id)
+		return
+	}
+	msg.Target = g.resolve(gs, msg.Target)
+	switch msg.Action {
+	case types.GuildActionDisband:
+		g.reply(c, msg.Session, msg.Action, "", gs.id)
+		g.disband(c, gs, msg.Username)
+	case types.GuildActionInvite:
+		g.invite(c, gs, msg)
+	case types.GuildActionLeave:
+		g.leave(c, gs, msg)
+	case types.GuildActionKick:
+		g.kick(c, gs, msg)
+	case types.GuildActionSetRank:
+		g.setRank(c, gs, msg)
+	case types.GuildActionMotd:
+		g.setMotd(c, gs, msg)
+	}
+}
+
+// 대상의 정식 사용자명. 길드원이면 접속하지 않았어도 찾고, 아니면 접속 중인 플레이어에서 찾는다.
+// 대소문자 등은 usernameKey 로 맞추고, 못 찾으면 그대로 돌려준다.
+func (g *Guilds) resolve(gs *guildState, username string) string {
+	key := usernameKey(username)
+	for name := range gs.members {
+		if usernameKey(name) == key {
+			return name
+		}
+	}
+	if name, ok := g.players[key]; ok {
+		return name
+	}
+	return username
+}
+
+func (g *Guilds) create(c *actor.Context, msg guildRequest) {
+	if _, ok := g.byMember[msg.Username]; ok {
+		g.reply(c, msg.Session, msg.Action, types.GuildErrAlreadyInGuild, 0)
+		return
+	}
+	if g.creating[msg.Username] {
+		g.reply(c, msg.Session, msg.Action, types.GuildErrBusy, 0)
+		return
+	}
+	name := strings.TrimSpace(msg.Text)
+	if !validGuildName(name) {
+		g.reply(c, msg.Session, msg.Action, types.GuildErrInvalidName, 0)
+		return
+	}
+	key := usernameKey(name)
+	if g.names[key] {
+		g.reply(c, msg.Session, msg.Action, types.GuildErrNameTaken, 0)
+		return
+	}
+	g.names[key] = true
+	g.creating[msg.Username] = true
+	db, self, engine := g.db, c.PID(), c.Engine()
+	g.persist("creating guild "+name, func(ctx context.Context) error {
+		row, err := createGuild(ctx, db, name, msg.Username)
+		engine.Send(self, guildCreated{Session: msg.Session, Username: msg.Username, NameKey: key, Guild: row, Err: err})
+		return nil
+	})
+}
+
+// 길드 이름: 글자, 숫자, 공백만 (연속 공백 불가)
+func validGuildName(name string) bool {
+	if n := utf8.RuneCountInString(name); n < minGuildNameLen || n > maxGuildNameLen {
+		return false
+	}
+	if strings.Contains(name, "  ") {
+		return false
+	}
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != ' ' {
+			return false
+		}
+	}
+	return true
+}
+
+func (g *Guilds) created(c *actor.Context, msg guildCreated) {
+	delete(g.creating, msg.Username)
+	if msg.Err != nil {
+		delete(g.names, msg.NameKey)
+		code := types.GuildErrNameTaken
+		if !errors.Is(msg.Err, errGuildNameTaken) {
+			fmt.Printf("guilds: creating guild for %s failed: %v\n", msg.Username, msg.Err)
+			code = types.ErrCodeInternal
+		}
+		g.reply(c, msg.Session, types.GuildActionCreate, code, 0)
+		return
+	}
+	gs := &guildState{id: msg.Guild.ID, name: msg.Guild.Name, members: map[string]string{msg.Username: types.GuildRankLeader}}
+	g.guilds[gs.id] = gs
+	g.byMember[msg.Username] = gs
+	fmt.Printf("guild %d (%s) created by %s\n", gs.id, gs.name, msg.Username)
+	g.reply(c, msg.Session, types.GuildActionCreate, "", gs.id)
+	c.Send(msg.Session, wsSend{Type: "guildRoster", Data: g.roster(gs)})
+}
+
+func (g *Guilds) disband(c *actor.Context, gs *guildState, by string) {
+	g.broadcast(c, gs, types.GuildEvent{Type: types.GuildEventDisbanded, By: by})
+	for name := range gs.members {
+		delete(g.byMember, name)
+	}
+	delete(g.guilds, gs.id)
+	delete(g.names, usernameKey(gs.name))
+	db, id := g.db, gs.id
+	g.persist(fmt.Sprintf("deleting guild %d", id), func(ctx context.Context) error {
+		return deleteGuild(ctx, db, id)
+	})
+	fmt.Printf("guild %d (%s) disbanded by %s\n", gs.id, gs.name, by)
+}
+
+func (g *Guilds) invite(c *actor.Context, gs *guildState, msg guildRequest) {
+	if msg.Target == "" || msg.Target == msg.Username {
+		g.reply(c, msg.Session, msg.Action, types.GuildErrInvalidTarget, gs.id)
+		return
+	}
+	target, ok := g.online[msg.Target]
+	if !ok {
+		g.reply(c, msg.Session, msg.Action, types.GuildErrPlayerOffline, gs.id)
+		return
+	}
+	if _, ok := g.byMember[msg.Target]; ok {
+		g.reply(c, msg.Session, msg.Action, types.GuildErrAlreadyInGuild, gs.id)
+		return
+	}
+	if len(gs.members) >= maxGuildMembers {
+		g.reply(c, msg.Session, msg.Action, types.GuildErrFull, gs.id)
+		return
+	}
+	now := time.Now()
+	pending := g.invites[msg.Target]
+	if pending == nil {
+		pending = make(map[int]time.Time)
+		g.invites[msg.Target] = pending
+	}
+	for id, expires := range pending {
+		if now.After(expires) {
+			delete(pending, id)
+		}
+	}
+	pending[gs.id] = now.Add(guildInviteTimeout)
+	c.Send(target, wsSend{Type: "guildInvitation", Data: types.GuildInvitation{GuildID: gs.id, Name: gs.name, From: msg.Username}})
+	g.reply(c, msg.Session, msg.Action, "", gs.id)
+}
+
+func (g *Guilds) respond(c *actor.Context, msg guildRequest) {
+	expires, ok := g.invites[msg.Username][msg.GuildID]
+	if ok {
+		delete(g.invites[msg.Username], msg.GuildID)
+		if len(g.invites[msg.Username]) == 0 {
+			delete(g.invites, msg.Username)
+		}
+	}
+	gs, exists := g.guilds[msg.GuildID]
+	if !ok || !exists || time.Now().After(expires) {
+		g.reply(c, msg.Session, msg.Action, types.GuildErrNoInvitation, msg.GuildID)
+		return
+	}
+	if msg.Action == types.GuildActionDecline {
+		g.reply(c, msg.Session, msg.Action, "", gs.id)
+		return
+	}
+	if _, ok := g.byMember[msg.Username]; ok || g.creating[msg.Username] {
+		g.reply(c, msg.Session, msg.Action, types.GuildErrAlreadyInGuild, gs.id)
+		return
+	}
+	if len(gs.members) >= maxGuildMembers {
+		g.reply(c, msg.Session, msg.Action, types.GuildErrFull, gs.id)
+		return
+	}
+	gs.members[msg.Username] = types.GuildRankMember
+	g.byMember[msg.Username] = gs
+	db, id, name := g.db, gs.id, msg.Username
+	g.persist(fmt.Sprintf("adding %s to guild %d", name, id), func(ctx context.Context) error {
+		return addGuildMember(ctx, db, id, name)
+	})
+	g.reply(c, msg.Session, msg.Action, "", gs.id)
+	g.broadcast(c, gs, types.GuildEvent{Type: types.GuildEventJoined, Username: msg.Username, Rank: types.GuildRankMember})
+	c.Send(msg.Session, wsSend{Type: "guildRoster", Data: g.roster(gs)})
+}
+
+// 탈퇴. 길드장은 혼자 남았을 때만 나갈 수 있고, 그러면 길드가 해산된다.
+func (g *Guilds) leave(c *actor.Context, gs *guildState, msg guildRequest) {
+	if gs.members[msg.Username] == types.GuildRankLeader {
+		if len(gs.members) > 1 {
+			g.reply(c, msg.Session, msg.Action, types.GuildErrLeaderCannotLeave, gs.id)
+			return
+		}
+		g.reply(c, msg.Session, msg.Action, "", gs.id)
+		g.disband(c, gs, msg.Username)
+		return
+	}
+	g.reply(c, msg.Session, msg.Action, "", gs.id)
+	g.broadcast(c, gs, types.GuildEvent{Type: types.GuildEventLeft, Username: msg.Username})
+	g.removeMember(gs, msg.Username)
+}
+
+func (g *Guilds) kick(c *actor.Context, gs *guildState, msg guildRequest) {
+	code := g.checkTarget(gs, msg)
+	if code == "" && guildRankOrder[gs.members[msg.Target]] >= guildRankOrder[gs.members[msg.Username]] {
+		code = types.GuildErrNoPermission
+	}
+	if code != "" {
+		g.reply(c, msg.Session, msg.Action, code, gs.id)
+		return
+	}
+	g.reply(c, msg.Session, msg.Action, "", gs.id)
+	// 추방당한 사람도 이벤트를 받은 뒤 빠진다
+	g.broadcast(c, gs, types.GuildEvent{Type: types.GuildEventKicked, Username: msg.Target, By: msg.Username})
+	g.removeMember(gs, msg.Target)
+}
+
+func (g *Guilds) removeMember(gs *guildState, username string) {
+	delete(gs.members, username)
+	delete(g.byMember, username)
+	db, id := g.db, gs.id
+	g.persist(fmt.Sprintf("removing %s from guild %d", username, id), func(ctx context.Context) error {
+		return removeGuildMember(ctx, db, id, username)
+	})
+}
+
+// 등급 변경. leader 로 바꾸면 길드장을 넘기고 자신은 officer 가 된다.
+func (g *Guilds) setRank(c *actor.Context, gs *guildState, msg guildRequest) {
+	code := g.checkTarget(gs, msg)
+	if _, ok := guildRankOrder[msg.Rank]; code == "" && !ok {
+		code = types.GuildErrInvalidRank
+	}
+	if code != "" {
+		g.reply(c, msg.Session, msg.Action, code, gs.id)
+		return
+	}
+	g.reply(c, msg.Session, msg.Action, "", gs.id)
+	g.changeRank(c, gs, msg.Target, msg.Rank, msg.Username)
+	if msg.Rank == types.GuildRankLeader {
+		g.changeRank(c, gs, msg.Username, types.GuildRankOfficer, msg.Username)
+	}
+}
+
+func (g *Guilds) changeRank(c *actor.Context, gs *guildState, username, rank, by string) {
+	gs.members[username] = rank
+	db, id := g.db, gs.id
+	g.persist(fmt.Sprintf("setting rank of %s in guild %d", username, id), func(ctx context.Context) error {
+		return setGuildRank(ctx, db, id, username, rank)
+	})
+	g.broadcast(c, gs, types.GuildEvent{Type: types.GuildEventRank, Username: username, Rank: rank, By: by})
+}
+
+// kick/setRank 대상 검사 (같은 길드의 다른 길드원)
+func (g *Guilds) checkTarget(gs *guildState, msg guildRequest) string {
+	if msg.Target == "" || msg.Target == msg.Username {
+		return types.GuildErrInvalidTarget
+	}
+	if _, ok := gs.members[msg.Target]; !ok {
+		return types.GuildErrNotMember
+	}
+	return ""
+}
+
+func (g *Guilds) setMotd(c *actor.Context, gs *guildState, msg guildRequest) {
+	text := strings.TrimSpace(msg.Text)
+	if utf8.RuneCountInString(text) > maxGuildMotdLen {
+		g.reply(c, msg.Session, msg.Action, types.GuildErrTooLong, gs.id)
+		return
+	}
+	gs.motd = text
+	db, id := g.db, gs.id
+	g.persist(fmt.Sprintf("setting motd of guild %d", id), func(ctx context.Context) error {
+		return setGuildMotd(ctx, db, id, text)
+	})
+	g.reply(c, msg.Session, msg.Action, "", gs.id)
+	g.broadcast(c, gs, types.GuildEvent{Type: types.GuildEventMotd, Text: text, By: msg.Username})
+}
+
+func (g *Guilds) chat(c *actor.Context, msg guildChat) {
+	gs, ok := g.byMember[msg.Username]
+	if !ok {
+		c.Send(msg.Session, wsSend{Type: "chatResult", Data: types.ChatResult{
+			Action: types.ChatActionSend,
+			Code:   types.ChatErrNotInGuild,
+		}})
+		return
+	}
+	for name := range gs.members {
+		if pid, ok := g.online[name]; ok && !msg.Blocked[name] {
+			c.Send(pid, wsSend{Type: "chatMessage", Data: msg.Msg})
+		}
+	}
+}
+
+// 길드 명단 (등급순, 같은 등급은 이름순)
+func (g *Guilds) roster(gs *guildState) types.GuildRoster {
+	members := make([]types.GuildMemberInfo, 0, len(gs.members))
+	for name, rank := range gs.members {
+		_, online := g.online[name]
+		members = append(members, types.GuildMemberInfo{Username: name, Rank: rank, Online: online})
+	}
+	sort.Slice(members, func(i, j int) bool {
+		a, b := guildRankOrder[members[i].Rank], guildRankOrder[members[j].Rank]
+		if a != b {
+			return a > b
+		}
+		return members[i].Username < members[j].Username
+	})
+	return types.GuildRoster{GuildID: gs.id, Name: gs.name, Motd: gs.motd, Members: members}
+}
+
+// 접속 중인 길드원 모두에게 이벤트 전송
+func (g *Guilds) broadcast(c *actor.Context, gs *guildState, ev types.GuildEvent) {
+	for name := range gs.members {
+		if pid, ok := g.online[name]; ok {
+			c.Send(pid, wsSend{Type: "guildEvent", Data: ev})
+		}
+	}
+}
+
+func (g *Guilds) reply(c *actor.Context, session *actor.PID, action, code string, guildID int) {
+	c.Send(session, wsSend{Type: "guildResult", Data: types.GuildResult{
+		Action:  action,
+		Success: code == "",
+		Code:    code,
+		GuildID: guildID,
+	}})
+}
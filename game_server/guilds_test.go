@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SilverSS/gameserver/types"
+	"github.com/anthdm/hollywood/actor"
+)
+
+func waitGuildResult(t *testing.T, s *inbox, action, code string) types.GuildResult {
+	t.Helper()
+	res := waitForWS[types.GuildResult](s, "guildResult")
+	if res.Action != action || res.Code != code {
+		t.Fatalf("%s: guildResult = %+v, want %s %q", s.name, res, action, code)
+	}
+	return res
+}
+
+// 초대/추방/등급 변경 대상은 대소문자가 달라도 같은 계정으로 본다 (접속하지 않은 길드원 포함)
+func TestGuildTargetCaseInsensitive(t *testing.T) {
+	db := openTestDB(t)
+	names := []string{"alice", "Bob", "carol"}
+	for _, name := range names {
+		if _, err := createAccount(context.Background(), db, name, "hash"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	e, err := actor.NewEngine(actor.NewEngineConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	guilds := e.Spawn(newGuilds(db), "guilds")
+	t.Cleanup(func() { e.Poison(guilds).Wait() })
+	s := make(map[string]*inbox)
+	for _, name := range names {
+		s[name] = newInbox(t, e, name)
+		e.Send(guilds, playerOnline{Username: name, Session: s[name].pid})
+	}
+	alice, bob, carol := s["alice"], s["Bob"], s["carol"]
+	request := func(from *inbox, action, target string, guildID int) {
+		e.Send(guilds, guildRequest{Session: from.pid, Username: from.name, Action: action, Target: target, GuildID: guildID, Rank: types.GuildRankOfficer, Text: "Test Guild"})
+	}
+
+	request(alice, types.GuildActionCreate, "", 0)
+	id := waitGuildResult(t, alice, types.GuildActionCreate, "").GuildID
+
+	request(alice, types.GuildActionInvite, "ALICE", 0)
+	waitGuildResult(t, alice, types.GuildActionInvite, types.GuildErrInvalidTarget)
+	for _, to := range []struct {
+		target string
+		inbox  *inbox
+	}{{"bob", bob}, {"CAROL", carol}} {
+		request(alice, types.GuildActionInvite, to.target, 0)
+		waitGuildResult(t, alice, types.GuildActionInvite, "")
+		waitForWS[types.GuildInvitation](to.inbox, "guildInvitation")
+		request(to.inbox, types.GuildActionAccept, "", id)
+		waitGuildResult(t, to.inbox, types.GuildActionAccept, "")
+	}
+
+	request(alice, types.GuildActionSetRank, "BOB", 0)
+	waitGuildResult(t, alice, types.GuildActionSetRank, "")
+	e.Send(guilds, playerOffline{Username: "carol", Session: carol.pid})
+	request(alice, types.GuildActionKick, "Carol", 0)
+	waitGuildResult(t, alice, types.GuildActionKick, "")
+
+	e.Send(guilds, guildRosterRequest{Session: alice.pid, Username: "alice"})
+	roster := waitForWS[types.GuildRoster](alice, "guildRoster")
+	for roster.GuildID != id || len(roster.Members) != 2 {
+		roster = waitForWS[types.GuildRoster](alice, "guildRoster")
+	}
+	want := []types.GuildMemberInfo{
+		{Username: "alice", Rank: types.GuildRankLeader, Online: true},
+		{Username: "Bob", Rank: types.GuildRankOfficer, Online: true},
+	}
+	for i, m := range roster.Members {
+		if m != want[i] {
+			t.Errorf("member %d = %+v, want %+v", i, m, want[i])
+		}
+	}
+}
+
+// 쓰기가 밀려 큐가 차면 길드 액터를 멈추지 않고 변경 요청을 거절한다
+func TestGuildRejectsChangesWhenWriteQueueFull(t *testing.T) {
+	h := newCombatHarness(t)
+	g := newGuilds(openTestDB(t))().(*Guilds)
+	g.writes = make(chan guildWrite, guildWriteQueueLen) // 쓰기 고루틴 없이 (DB 가 멈춘 것처럼)
+	gs := &guildState{id: 1, name: "Test Guild", members: map[string]string{"alice": types.GuildRankLeader, "bob": types.GuildRankMember}}
+	g.guilds[gs.id], g.byMember["alice"], g.byMember["bob"] = gs, gs, gs
+	for len(g.writes) < cap(g.writes)-1 {
+		g.writes <- guildWrite{}
+	}
+
+	h.do(func(c *actor.Context) {
+		g.handle(c, guildRequest{Session: h.session, Username: "alice", Action: types.GuildActionSetRank, Target: "bob", Rank: types.GuildRankLeader})
+		g.handle(c, guildRequest{Session: h.session, Username: "alice", Action: types.GuildActionMotd, Text: "hi"})
+	})
+	results := wsData[types.GuildResult](h.messages(), "guildResult")
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want 2", len(results))
+	}
+	for _, res := range results {
+		if res.Code != types.GuildErrBusy {
+			t.Errorf("%s: code %q, want busy", res.Action, res.Code)
+		}
+	}
+	if gs.members["bob"] != types.GuildRankMember || gs.motd != "" {
+		t.Errorf("guild changed in memory without being saved: %+v", gs)
+	}
+
+	// 자리가 나면 다시 받는다
+	<-g.writes
+	<-g.writes
+	h.do(func(c *actor.Context) {
+		g.handle(c, guildRequest{Session: h.session, Username: "alice", Action: types.GuildActionMotd, Text: "hi"})
+	})
+	if res := wsData[types.GuildResult](h.messages(), "guildResult"); len(res) != 1 || !res[0].Success || gs.motd != "hi" {
+		t.Errorf("motd after the queue drained: %+v", res)
+	}
+}
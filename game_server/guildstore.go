@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/SilverSS/gameserver/ent"
+	"github.com/SilverSS/gameserver/ent/guildmember"
+	"github.com/SilverSS/gameserver/ent/user"
+)
+
+// 이미 있는 길드 이름으로 만들려고 한 경우
+var errGuildNameTaken = errors.New("guild name taken")
+
+// 모든 길드와 길드원 (서버 시작 시 길드 액터가 읽는다)
+func loadGuilds(ctx context.Context, client *ent.Client) ([]*ent.Guild, error) {
+	return client.Guild.Query().
+		WithMembers(func(q *ent.GuildMemberQuery) { q.WithUser() }).
+		All(ctx)
+}
+
+// 길드 생성: 길드와 길드장 길드원을 하나의 트랜잭션으로 만든다.
+func createGuild(ctx context.Context, client *ent.Client, name, leader string) (*ent.Guild, error) {
+	var g *ent.Guild
+	err := withTx(ctx, client, func(tx *ent.Tx) error {
+		u, err := findUser(ctx, tx.Client(), leader)
+		if err != nil {
+			return err
+		}
+		g, err = tx.Guild.Create().SetName(name).SetNameKey(usernameKey(name)).Save(ctx)
+		if err != nil {
+			return err
+		}
+		return tx.GuildMember.Create().SetGuild(g).SetUser(u).SetRank(guildmember.RankLeader).Exec(ctx)
+	})
+	if ent.IsConstraintError(err) {
+		return nil, errGuildNameTaken
+	}
+	return g, err
+}
+
+func addGuildMember(ctx context.Context, client *ent.Client, guildID int, username string) error {
+	u, err := findUser(ctx, client, username)
+	if err != nil {
+		return err
+	}
+	return client.GuildMember.Create().SetGuildID(guildID).SetUser(u).SetRank(guildmember.RankMember).Exec(ctx)
+}
+
+func removeGuildMember(ctx context.Context, client *ent.Client, guildID int, username string) error {
+	_, err := client.GuildMember.Delete().
+		Where(guildmember.GuildID(guildID), guildmember.HasUserWith(user.UsernameEQ(username))).
+		Exec(ctx)
+	return err
+}
+
+func setGuildRank(ctx context.Context, client *ent.Client, guildID int, username, rank string) error {
+	return client.GuildMember.Update().
+		Where(guildmember.GuildID(guildID), guildmember.HasUserWith(user.UsernameEQ(username))).
+		SetRank(guildmember.Rank(rank)).
+		Exec(ctx)
+}
+
+func setGuildMotd(ctx context.Context, client *ent.Client, guildID int, motd string) error {
+	return client.Guild.UpdateOneID(guildID).SetMotd(motd).Exec(ctx)
+}
+
+// 길드 해산: 길드원과 길드를 함께 지운다.
+func deleteGuild(ctx context.Context, client *ent.Client, guildID int) error {
+	return withTx(ctx, client, func(tx *ent.Tx) error {
+		if _, err := tx.GuildMember.Delete().Where(guildmember.GuildID(guildID)).Exec(ctx); err != nil {
+			return fmt.Errorf("deleting members: %w", err)
+		}
+		return tx.Guild.DeleteOneID(guildID).Exec(ctx)
+	})
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/SilverSS/gameserver/types"
+)
+
+// 이동 목표의 Y 가 지면에서 이보다 멀면 거부한다 (클라이언트는 지면을 찍어 보내야 함)
+const groundTolerance = 2.0
+
+// 지형 높이맵 (data/height/*.json, Unity 터레인에서 내보낸 파일)
+// heights[z][x] 는 X/Z 평면의 격자점 origin + (x*cellSize, z*cellSize) 의 높이이고,
+// 격자점 사이는 쌍선형 보간한다. 격자 밖은 가장 가까운 가장자리 높이를 쓴다.
+type heightmap struct {
+	Origin   types.Vector `json:"origin"`
+	CellSize float32      `json:"cellSize"`
+	Heights  [][]float32  `json:"heights"`
+}
+
+func loadHeightmap(name string) (*heightmap, error) {
+	var h heightmap
+	if err := readDataJSON(name, &h); err != nil {
+		return nil, err
+	}
+	if h.CellSize <= 0 || len(h.Heights) < 2 || len(h.Heights[0]) < 2 {
+		return nil, fmt.Errorf("%s: cellSize and at least 2x2 heights required", name)
+	}
+	for z, row := range h.Heights {
+		if len(row) != len(h.Heights[0]) {
+			return nil, fmt.Errorf("%s: row %d has %d heights, want %d", name, z, len(row), len(h.Heights[0]))
+		}
+	}
+	return &h, nil
+}
+
+// (x, z) 의 지면 높이
+func (h *heightmap) at(x, z float32) float32 {
+	w, d := len(h.Heights[0]), len(h.Heights)
+	fx := clampf((x-h.Origin.X)/h.CellSize, 0, float32(w-1))
+	fz := clampf((z-h.Origin.Z)/h.CellSize, 0, float32(d-1))
+	x0, z0 := min(int(math.Floor(float64(fx))), w-2), min(int(math.Floor(float64(fz))), d-2)
+	tx, tz := fx-float32(x0), fz-float32(z0)
+	near := h.Heights[z0][x0]*(1-tx) + h.Heights[z0][x0+1]*tx
+	far := h.Heights[z0+1][x0]*(1-tx) + h.Heights[z0+1][x0+1]*tx
+	return near*(1-tz) + far*tz
+}
+
+// (x, z) 의 지면 높이. 높이맵이 없는 존은 boundsMin.Y 높이의 평지다.
+// 스폰, 부활, NPC, 능력의 지점 조준 등 위치를 정하는 곳은 모두 이 높이를 쓴다.
+func (d *zoneDef) groundHeight(x, z float32) float32 {
+	if d.height == nil {
+		return d.BoundsMin.Y
+	}
+	return clampf(d.height.at(x, z), d.BoundsMin.Y, d.BoundsMax.Y)
+}
+
+// 존 경계 안으로 제한하고 지면 위에 올려놓은 위치
+func (d *zoneDef) onGround(v types.Vector) types.Vector {
+	v = d.clamp(v)
+	v.Y = d.groundHeight(v.X, v.Z)
+	return v
+}
+
+// 클라이언트가 보낸 위치가 지면 가까이에 있는지
+func (d *zoneDef) nearGround(v types.Vector) bool {
+	v = d.clamp(v)
+	return absf(v.Y-d.groundHeight(v.X, v.Z)) <= groundTolerance
+}
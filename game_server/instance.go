@@ -0,0 +1,416 @@
+package main
+
+import (
+	"fmt"
+	"io/fs"
+	"sort"
+	"time"
+
+	"github.com/SilverSS/gameserver/types"
+	"github.com/anthdm/hollywood/actor"
+)
+
+// 빈 인스턴스 기본 유지 시간
+const defaultInstanceEmptyTimeout = 60 * time.Second
+
+// 빈 인스턴스 정리 주기
+const instanceSweepInterval = time.Second
+
+// 입장을 허락한 뒤 존에 들어오기를 기다리며 자리를 잡아 두는 시간 (이동에 실패하면 지나서 풀린다)
+const instanceSeatTimeout = 10 * time.Second
+
+// 인스턴스 템플릿 (data/instances/*.json)
+// 요청이 올 때마다 Zone 정의를 복사해 새 존 액터를 만든다.
+type instanceTemplate struct {
+	ID              string       `json:"id"`
+	Name            string       `json:"name"`
+	MaxPlayers      int          `json:"maxPlayers"`      // 0 이면 제한 없음
+	EmptyTimeoutSec int          `json:"emptyTimeoutSec"` // 0 이면 defaultInstanceEmptyTimeout
+	ExitZone        string       `json:"exitZone"`        // instanceLeave 시 돌아갈 존
+	ExitPosition    types.Vector `json:"exitPosition"`
+	Zone            zoneDef      `json:"zone"` // id, name 은 인스턴스마다 채운다
+}
+
+func (t *instanceTemplate) emptyTimeout() time.Duration {
+	if t.EmptyTimeoutSec <= 0 {
+		return defaultInstanceEmptyTimeout
+	}
+	return time.Duration(t.EmptyTimeoutSec) * time.Second
+}
+
+// 인스턴스 템플릿 전체 로드. 출구와 포탈은 상시 존만 가리킬 수 있다.
+func loadInstanceTemplates(zoneDefs map[string]*zoneDef, npcs map[string]*npcDef) (map[string]*instanceTemplate, error) {
+	names, err := fs.Glob(dataFS, "instances/*.json")
+	if err != nil {
+		return nil, err
+	}
+	templates := make(map[string]*instanceTemplate, len(names))
+	for _, name := range names {
+		var t instanceTemplate
+		if err := readDataJSON(name, &t); err != nil {
+			return nil, err
+		}
+		if t.ID == "" {
+			return nil, fmt.Errorf("%s: missing id", name)
+		}
+		if _, dup := templates[t.ID]; dup {
+			return nil, fmt.Errorf("%s: duplicate instance template %q", name, t.ID)
+		}
+		t.Zone.ID, t.Zone.Name = t.ID, t.Name
+		if err := t.Zone.validate(npcs); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		if _, ok := zoneDefs[t.ExitZone]; !ok {
+			return nil, fmt.Errorf("%s: exit zone %q is not defined", name, t.ExitZone)
+		}
+		for _, p := range t.Zone.Portals {
+			if _, ok := zoneDefs[p.TargetZone]; !ok {
+				return nil, fmt.Errorf("%s: portal %s targets unknown zone %q", name, p.ID, p.TargetZone)
+			}
+		}
+		templates[t.ID] = &t
+	}
+	return templates, nil
+}
+
+// 인스턴스 관리자 메시지 (세션 -> 인스턴스 관리자)
+type (
+	createInstance struct {
+		Session  *actor.PID
+		Username string
+		Template string
+	}
+
+	inviteInstance struct {
+		Session    *actor.PID
+		Username   string
+		InstanceID string
+		Target     string
+	}
+
+	joinInstance struct {
+		Session    *actor.PID
+		Username   string
+		InstanceID string
+	}
+
+	// ZoneID 는 요청한 플레이어가 현재 있는 존
+	leaveInstance struct {
+		Session  *actor.PID
+		EntityID int64
+		ZoneID   string
+	}
+
+	instanceSweep struct{}
+)
+
+// 인스턴스 입장 지시 (인스턴스 관리자 -> 세션). 세션이 현재 존에 transferOut 을 보낸다.
+type instanceTransfer struct {
+	ToZone string
+}
+
+// 인스턴스 존 등록/해제 (인스턴스 관리자 -> GameServer)
+type (
+	registerZone struct {
+		ID   string
+		Zone *actor.PID
+	}
+
+	unregisterZone struct {
+		ID string
+	}
+)
+
+// 실행 중인 인스턴스
+type instance struct {
+	id         string
+	template   *instanceTemplate
+	zone       *actor.PID
+	invited    map[string]bool // 입장할 수 있는 사용자명 (만든 사람 포함)
+	population int
+	reserved   map[string]time.Time // 입장을 허락했지만 아직 존에 없는 사용자명 -> 자리 만료 시각
+	emptySince time.Time            // 마지막으로 비게 된 시각
+}
+
+// 존 안 인원과 입장 중인 인원
+func (inst *instance) occupancy() int {
+	return inst.population + len(inst.reserved)
+}
+
+func (inst *instance) reserve(username string) {
+	inst.reserved[username] = time.Now().Add(instanceSeatTimeout)
+}
+
+// 인스턴스 관리자: 템플릿으로 인스턴스 존을 만들고, 비어 있는 채로 유지 시간이 지나면 없앤다.
+// 인스턴스 존은 이 액터의 자식이고, 존 이동은 GameServer 에 등록해 상시 존과 같은 경로로 처리한다.
+type InstanceManager struct {
+	templates    map[string]*instanceTemplate
+	rules        *combatRules
+	maxInstances int
+	server       *actor.PID
+	parties      *actor.PID // 파티 액터 (인스턴스를 만들 때 파티원 조회, 인스턴스 존의 상태 보고)
+	instances    map[string]*instance
+	online       onlinePlayers
+	names        map[string]string // usernameKey -> 접속 중인 정식 사용자명
+	seq          int
+	repeater     actor.SendRepeater
+}
+
+func newInstanceManager(templates map[string]*instanceTemplate, rules *combatRules, maxInstances int, server, parties *actor.PID) actor.Producer {
+	return func() actor.Receiver {
+		return &InstanceManager{
+			templates:    templates,
+			rules:        rules,
+			maxInstances: maxInstances,
+			server:       server,
+			parties:      parties,
+			instances:    make(map[string]*instance),
+			online:       make(onlinePlayers),
+			names:        make(map[string]string),
+		}
+	}
+}
+
+func (m *InstanceManager) Receive(c *actor.Context) {
+	if m.online.apply(c.Message()) {
+		switch msg := c.Message().(type) {
+		case playerOnline:
+			m.names[usernameKey(msg.Username)] = msg.Username
+		case playerOffline:
+			if _, ok := m.online[msg.Username]; !ok {
+				delete(m.names, usernameKey(msg.Username))
+				for _, inst := range m.instances {
+					delete(inst.reserved, msg.Username)
+				}
+			}
+		}
+		return
+	}
+	switch msg := c.Message().(type) {
+	case actor.Started:
+		c.Engine().Subscribe(c.PID())
+		m.repeater = c.SendRepeat(c.PID(), instanceSweep{}, instanceSweepInterval)
+	case actor.Stopped:
+		m.repeater.Stop()
+		c.Engine().Unsubscribe(c.PID())
+	case createInstance:
+		m.create(c, msg)
+	case partyMembers:
+		if req, ok := msg.Request.(createInstance); ok {
+			m.createForParty(c, req, msg.Members)
+		}
+	case createRoomInstance:
+		m.createForRoom(c, msg)
+	case inviteInstance:
+		m.invite(c, msg)
+	case joinInstance:
+		m.join(c, msg)
+	case leaveInstance:
+		m.leave(c, msg)
+	case zonePopulation:
+		if inst, ok := m.instances[msg.ZoneID]; ok {
+			if msg.Count == 0 && inst.population > 0 {
+				inst.emptySince = time.Now()
+			}
+			inst.population = msg.Count
+			for _, name := range msg.Players {
+				delete(inst.reserved, name)
+			}
+		}
+	case instanceSweep:
+		m.sweep(c)
+	}
+}
+
+// 인스턴스 생성 요청: 만든 사람의 파티원을 파티 액터에 물어본 뒤 createForParty 에서 만든다.
+func (m *InstanceManager) create(c *actor.Context, msg createInstance) {
+	if code := m.checkCreate(msg.Template); code != "" {
+		m.reply(c, msg.Session, types.InstanceActionCreate, code, "")
+		return
+	}
+	c.Send(m.parties, partyMembersQuery{Username: msg.Username, Request: msg})
+}
+
+func (m *InstanceManager) checkCreate(template string) string {
+	switch _, ok := m.templates[template]; {
+	case !ok:
+		return types.InstanceErrUnknownTemplate
+	case m.full():
+		return types.InstanceErrLimit
+	}
+	return ""
+}
+
+// 만든 사람과 지금 파티원 모두를 초대한 인스턴스를 만든다. 만든 사람만 바로 입장하고,
+// 접속 중인 파티원에게는 초대 알림을 보낸다 (접속이 끊긴 파티원도 다시 접속하면 입장할 수 있다).
+func (m *InstanceManager) createForParty(c *actor.Context, msg createInstance, party []roomMember) {
+	// 파티원을 조회하는 동안 다른 인스턴스가 생겼을 수 있으므로 다시 검사한다.
+	if code := m.checkCreate(msg.Template); code != "" {
+		m.reply(c, msg.Session, types.InstanceActionCreate, code, "")
+		return
+	}
+	names := []string{msg.Username}
+	for _, pm := range party {
+		names = append(names, pm.Username)
+	}
+	inst := m.spawn(c, m.templates[msg.Template], names...)
+	inst.reserve(msg.Username)
+	m.reply(c, msg.Session, types.InstanceActionCreate, "", inst.id)
+	c.Send(msg.Session, instanceTransfer{ToZone: inst.id})
+	for _, pm := range party {
+		if pm.Session != nil {
+			m.sendInvitation(c, inst, pm.Session, msg.Username)
+		}
+	}
+}
+
+// 로비 방 시작: 방 인원 모두를 초대한 인스턴스를 만들고 함께 이동시킨다.
+func (m *InstanceManager) createForRoom(c *actor.Context, msg createRoomInstance) {
+	result := roomInstanceResult{RoomID: msg.RoomID}
+	t, ok := m.templates[msg.Template]
+	switch {
+	case !ok:
+		result.Code = types.InstanceErrUnknownTemplate
+	case m.full():
+		result.Code = types.InstanceErrLimit
+	default:
+		names := make([]string, len(msg.Members))
+		for i, rm := range msg.Members {
+			names[i] = rm.Username
+		}
+		inst := m.spawn(c, t, names...)
+		if msg.Match != nil {
+			c.Send(inst.zone, *msg.Match)
+		}
+		result.InstanceID = inst.id
+		for _, rm := range msg.Members {
+			inst.reserve(rm.Username)
+			c.Send(rm.Session, instanceTransfer{ToZone: inst.id})
+		}
+	}
+	c.Send(c.Sender(), result)
+}
+
+// 동시 인스턴스 수 제한에 걸렸는지
+func (m *InstanceManager) full() bool {
+	return m.maxInstances > 0 && len(m.instances) >= m.maxInstances
+}
+
+// 템플릿으로 인스턴스 존을 만들고 GameServer 에 등록한다.
+func (m *InstanceManager) spawn(c *actor.Context, t *instanceTemplate, invited ...string) *instance {
+	m.seq++
+	def := t.Zone
+	def.ID = fmt.Sprintf("%s-%d", t.ID, m.seq)
+	inst := &instance{
+		id:         def.ID,
+		template:   t,
+		zone:       c.SpawnChild(newZone(&def, m.rules, m.server, c.PID(), m.parties), "instance", actor.WithID(def.ID)),
+		invited:    make(map[string]bool, len(invited)),
+		reserved:   make(map[string]time.Time),
+		emptySince: time.Now(),
+	}
+	for _, name := range invited {
+		inst.invited[name] = true
+	}
+	m.instances[inst.id] = inst
+	c.Send(m.server, registerZone{ID: inst.id, Zone: inst.zone})
+	fmt.Printf("instance %s created for %v (%d running)\n", inst.id, invited, len(m.instances))
+	return inst
+}
+
+func (m *InstanceManager) invite(c *actor.Context, msg inviteInstance) {
+	inst, ok := m.instances[msg.InstanceID]
+	if !ok {
+		m.reply(c, msg.Session, types.InstanceActionInvite, types.InstanceErrUnknown, msg.InstanceID)
+		return
+	}
+	if !inst.invited[msg.Username] {
+		m.reply(c, msg.Session, types.InstanceActionInvite, types.InstanceErrNotInvited, inst.id)
+		return
+	}
+	// 초대 목록은 정식 사용자명으로 둔다 (join 은 세션의 정식 사용자명으로 확인)
+	name := m.names[usernameKey(msg.Target)]
+	target, ok := m.online[name]
+	if !ok {
+		m.reply(c, msg.Session, types.InstanceActionInvite, types.InstanceErrPlayerOffline, inst.id)
+		return
+	}
+	inst.invited[name] = true
+	m.sendInvitation(c, inst, target, msg.Username)
+	m.reply(c, msg.Session, types.InstanceActionInvite, "", inst.id)
+}
+
+func (m *InstanceManager) sendInvitation(c *actor.Context, inst *instance, to *actor.PID, from string) {
+	c.Send(to, wsSend{Type: "instanceInvitation", Data: types.InstanceInvitation{
+		InstanceID: inst.id,
+		Template:   inst.template.ID,
+		Name:       inst.template.Name,
+		From:       from,
+	}})
+}
+
+func (m *InstanceManager) join(c *actor.Context, msg joinInstance) {
+	inst, ok := m.instances[msg.InstanceID]
+	if !ok {
+		m.reply(c, msg.Session, types.InstanceActionJoin, types.InstanceErrUnknown, msg.InstanceID)
+		return
+	}
+	if !inst.invited[msg.Username] {
+		m.reply(c, msg.Session, types.InstanceActionJoin, types.InstanceErrNotInvited, inst.id)
+		return
+	}
+	// 존이 인원을 알려 오기 전에 여러 명이 동시에 들어와도 넘치지 않도록 입장 중인 자리도 센다
+	_, reserved := inst.reserved[msg.Username]
+	if max := inst.template.MaxPlayers; max > 0 && !reserved && inst.occupancy() >= max {
+		m.reply(c, msg.Session, types.InstanceActionJoin, types.InstanceErrFull, inst.id)
+		return
+	}
+	inst.reserve(msg.Username)
+	m.reply(c, msg.Session, types.InstanceActionJoin, "", inst.id)
+	c.Send(msg.Session, instanceTransfer{ToZone: inst.id})
+}
+
+func (m *InstanceManager) leave(c *actor.Context, msg leaveInstance) {
+	inst, ok := m.instances[msg.ZoneID]
+	if !ok {
+		m.reply(c, msg.Session, types.InstanceActionLeave, types.InstanceErrNotInInstance, "")
+		return
+	}
+	m.reply(c, msg.Session, types.InstanceActionLeave, "", inst.id)
+	exit := inst.template.ExitPosition
+	c.Send(inst.zone, transferOut{EntityID: msg.EntityID, ToZone: inst.template.ExitZone, Position: &exit})
+}
+
+// 비어 있는 채로 유지 시간이 지난 인스턴스 정리
+// 이미 이동 중인 플레이어가 있으면 GameServer 가 원래 존으로 되돌린다.
+func (m *InstanceManager) sweep(c *actor.Context) {
+	now := time.Now()
+	var expired []string
+	for id, inst := range m.instances {
+		for name, until := range inst.reserved {
+			if now.After(until) {
+				delete(inst.reserved, name)
+			}
+		}
+		if inst.occupancy() == 0 && now.Sub(inst.emptySince) >= inst.template.emptyTimeout() {
+			expired = append(expired, id)
+		}
+	}
+	sort.Strings(expired)
+	for _, id := range expired {
+		inst := m.instances[id]
+		delete(m.instances, id)
+		c.Send(m.server, unregisterZone{ID: id})
+		c.Engine().Poison(inst.zone)
+		fmt.Printf("instance %s destroyed (%d running)\n", id, len(m.instances))
+	}
+}
+
+func (m *InstanceManager) reply(c *actor.Context, session *actor.PID, action, code, instanceID string) {
+	c.Send(session, wsSend{Type: "instanceResult", Data: types.InstanceResult{
+		Action:     action,
+		Success:    code == "",
+		Code:       code,
+		InstanceID: instanceID,
+	}})
+}
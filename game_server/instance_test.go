@@ -0,0 +1,108 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/SilverSS/gameserver/types"
+	"github.com/anthdm/hollywood/actor"
+)
+
+// 최대 maxPlayers 명이 들어가는 "dungeon" 템플릿의 인스턴스 관리자와 접속 중인 세션들
+type instanceTest struct {
+	engine    *actor.Engine
+	instances *actor.PID
+	server    *inbox
+	sessions  map[string]*inbox
+}
+
+func newInstanceTest(t *testing.T, maxPlayers int, names ...string) *instanceTest {
+	t.Helper()
+	e, err := actor.NewEngine(actor.NewEngineConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	it := &instanceTest{engine: e, server: newInbox(t, e, "server"), sessions: make(map[string]*inbox)}
+	parties := e.Spawn(newParties(time.Minute), "parties")
+	templates := map[string]*instanceTemplate{"dungeon": {
+		ID: "dungeon", Name: "Dungeon", MaxPlayers: maxPlayers, ExitZone: "town",
+		Zone: *testZoneDef("dungeon", types.Vector{}),
+	}}
+	it.instances = e.Spawn(newInstanceManager(templates, testCombatRules(), 0, it.server.pid, parties), "instances")
+	t.Cleanup(func() {
+		e.Poison(it.instances).Wait()
+		e.Poison(parties).Wait()
+	})
+	for i, name := range names {
+		s := newInbox(t, e, name)
+		it.sessions[name] = s
+		online := playerOnline{Username: name, Session: s.pid, EntityID: int64(i + 1)}
+		e.Send(parties, online)
+		e.Send(it.instances, online)
+	}
+	return it
+}
+
+func waitInstanceResult(t *testing.T, s *inbox, action, code string) types.InstanceResult {
+	t.Helper()
+	res := waitForWS[types.InstanceResult](s, "instanceResult")
+	if res.Action != action || res.Code != code {
+		t.Fatalf("%s: instanceResult = %+v, want %s %q", s.name, res, action, code)
+	}
+	return res
+}
+
+// 인스턴스를 만들고 ID 를 돌려준다
+func (it *instanceTest) create(t *testing.T, by string) string {
+	t.Helper()
+	s := it.sessions[by]
+	it.engine.Send(it.instances, createInstance{Session: s.pid, Username: by, Template: "dungeon"})
+	return waitInstanceResult(t, s, types.InstanceActionCreate, "").InstanceID
+}
+
+func (it *instanceTest) invite(t *testing.T, by, target, id, code string) {
+	t.Helper()
+	s := it.sessions[by]
+	it.engine.Send(it.instances, inviteInstance{Session: s.pid, Username: by, InstanceID: id, Target: target})
+	waitInstanceResult(t, s, types.InstanceActionInvite, code)
+}
+
+func (it *instanceTest) join(t *testing.T, name, id, code string) {
+	t.Helper()
+	s := it.sessions[name]
+	it.engine.Send(it.instances, joinInstance{Session: s.pid, Username: name, InstanceID: id})
+	waitInstanceResult(t, s, types.InstanceActionJoin, code)
+}
+
+// 초대 대상은 대소문자가 달라도 같은 계정으로 보고, 정식 사용자명으로 입장할 수 있다
+func TestInstanceInviteCaseInsensitive(t *testing.T) {
+	it := newInstanceTest(t, 0, "alice", "Bob")
+	id := it.create(t, "alice")
+
+	it.invite(t, "alice", "BOB", id, "")
+	if inv := waitForWS[types.InstanceInvitation](it.sessions["Bob"], "instanceInvitation"); inv.InstanceID != id || inv.From != "alice" {
+		t.Errorf("instanceInvitation = %+v", inv)
+	}
+	it.join(t, "Bob", id, "")
+	it.invite(t, "alice", "carol", id, types.InstanceErrPlayerOffline)
+}
+
+// 존이 인원을 알려 오기 전에 허락한 입장도 자리를 차지한다
+func TestInstanceJoinReservesSeat(t *testing.T) {
+	it := newInstanceTest(t, 2, "alice", "bob", "carol")
+	id := it.create(t, "alice")
+	it.invite(t, "alice", "bob", id, "")
+	it.invite(t, "alice", "carol", id, "")
+
+	it.join(t, "bob", id, "")
+	it.join(t, "carol", id, types.InstanceErrFull)
+	it.join(t, "bob", id, "") // 이미 자리가 있으면 다시 요청해도 된다
+
+	// alice 가 들어온 것으로 보고되어도 bob 의 자리는 남아 있다
+	it.engine.Send(it.instances, zonePopulation{ZoneID: id, Count: 1, Players: []string{"alice"}})
+	it.join(t, "carol", id, types.InstanceErrFull)
+
+	// 이동하지 못하고 접속이 끊기면 자리가 풀린다
+	it.engine.Send(it.instances, playerOffline{Username: "bob", Session: it.sessions["bob"].pid})
+	it.join(t, "carol", id, "")
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"time"
+
+	"github.com/SilverSS/gameserver/types"
+)
+
+// 지연 보상
+// 클라이언트는 서버가 보낸 상태를 편도 지연만큼 늦게 보고, 공격 요청도 편도 지연만큼 늦게 도착한다.
+// 그래서 존은 엔티티마다 최근 위치를 기록해 두고, 공격을 판정할 때 대상을 공격자가 보던 시점의 위치로 되돌린다.
+// 되돌리는 폭은 combat.json 의 lagCompensationMs 를 넘지 않는다 (지연이 큰 클라이언트가 지나치게 유리하지 않도록).
+
+// 핑 주기
+const pingInterval = 2 * time.Second
+
+// 이보다 늦게 온 pong 은 지연 측정에 쓰지 않는다 (그동안 연결이 막혔던 것)
+const pingTimeout = 10 * time.Second
+
+// 핑을 보낼 때가 됨 (세션 -> 자신)
+type sessionPing struct{}
+
+// 클라이언트와의 지연 측정값 (세션 액터 안에서만 쓴다). RTT 와 시계 차이는 pong 마다 지수 평활한다.
+type latency struct {
+	lastPing int64         // 답을 기다리는 ping 의 serverTime (없으면 0)
+	pingAt   time.Time     // 그 ping 을 보낸 시각
+	measured bool          // pong 을 한 번이라도 받았는지
+	rtt      time.Duration // 왕복 지연
+	offset   time.Duration // 클라이언트 시계 - 서버 시계
+}
+
+func (l *latency) ping(now time.Time) types.Ping {
+	l.lastPing, l.pingAt = now.UnixMilli(), now
+	return types.Ping{ServerTime: l.lastPing, ServerClock: serverTime(now)}
+}
+
+// 마지막으로 보낸 ping 에 대한 답만 받는다 (클라이언트가 serverTime 을 꾸며 RTT 를 속이지 못하게)
+func (l *latency) pong(p types.Pong, now time.Time) {
+	if p.ServerTime == 0 || p.ServerTime != l.lastPing {
+		return
+	}
+	l.lastPing = 0
+	sent := l.pingAt
+	rtt := now.Sub(sent)
+	if rtt < 0 || rtt > pingTimeout {
+		return
+	}
+	// 클라이언트가 답한 순간은 보낸 뒤 편도 지연만큼 지났을 때라고 본다
+	offset := time.UnixMilli(p.ClientTime).Sub(sent.Add(rtt / 2))
+	if !l.measured {
+		l.measured, l.rtt, l.offset = true, rtt, offset
+		return
+	}
+	l.rtt += (rtt - l.rtt) / 8
+	l.offset += (offset - l.offset) / 8
+}
+
+// 클라이언트가 행동을 입력할 때 보고 있던 서버 시각.
+// 클라이언트가 그리던 서버 시각(renderTime)을 보냈으면 그대로 쓴다 (미래는 지금으로 제한).
+// 아니면 입력한 순간(clientTime)을 서버 시계로 바꾼 뒤 편도 지연만큼 더 되돌린다. clientTime 도 없으면
+// 요청이 편도 지연만큼 걸려 지금 도착했다고 본다. 지연을 아직 재지 못했으면 zero time (보상하지 않음).
+func (l *latency) viewTime(clientTime, renderTime int64, now time.Time) time.Time {
+	if renderTime > 0 {
+		if t := fromServerTime(renderTime); t.Before(now) {
+			return t
+		}
+		return now
+	}
+	if !l.measured {
+		return time.Time{}
+	}
+	input := now.Add(-l.rtt / 2)
+	if clientTime > 0 {
+		input = time.UnixMilli(clientTime).Add(-l.offset)
+		if input.After(now) {
+			input = now
+		}
+	}
+	return input.Add(-l.rtt / 2)
+}
+
+// 위치 기록 한 건
+type positionSample struct {
+	at  time.Time
+	pos types.Vector
+}
+
+// 엔티티의 최근 위치 기록. 존 틱마다 하나씩 쌓고, 가득 차면 가장 오래된 것부터 덮어쓴다.
+type positionHistory struct {
+	samples []positionSample // 링 버퍼 (처음 기록할 때 만든다)
+	next    int              // 다음에 쓸 자리
+	count   int
+}
+
+func (h *positionHistory) record(at time.Time, pos types.Vector, size int) {
+	if len(h.samples) != size {
+		*h = positionHistory{samples: make([]positionSample, size)}
+	}
+	h.samples[h.next] = positionSample{at: at, pos: pos}
+	h.next = (h.next + 1) % len(h.samples)
+	h.count = min(h.count+1, len(h.samples))
+}
+
+// 기록을 모두 버린다 (부활처럼 순간 이동했을 때, 이전 위치와 보간되지 않도록)
+func (h *positionHistory) reset() {
+	h.next, h.count = 0, 0
+}
+
+// i 번째로 오래된 기록
+func (h *positionHistory) get(i int) positionSample {
+	n := len(h.samples)
+	return h.samples[(h.next-h.count+i+n)%n]
+}
+
+// t 시점의 위치. 기록 사이는 선형 보간하고, 가장 오래된 기록보다 앞이면 그 기록을 쓴다.
+// 기록이 없거나 t 가 가장 최근 기록 이후이면 false (지금 위치를 쓰면 된다).
+func (h *positionHistory) at(t time.Time) (types.Vector, bool) {
+	if h.count == 0 || !t.Before(h.get(h.count-1).at) {
+		return types.Vector{}, false
+	}
+	prev := h.get(0)
+	if !t.After(prev.at) {
+		return prev.pos, true
+	}
+	for i := 1; i < h.count; i++ {
+		next := h.get(i)
+		if t.Before(next.at) {
+			ratio := float32(t.Sub(prev.at)) / float32(next.at.Sub(prev.at))
+			return lerp(prev.pos, next.pos, ratio), true
+		}
+		prev = next
+	}
+	return types.Vector{}, false
+}
+
+func (r *combatRules) lagCompensation() time.Duration {
+	return time.Duration(r.LagCompensationMs) * time.Millisecond
+}
+
+// 보상 구간을 덮는 데 필요한 기록 수 (틱 주기에 맞춰, 구간 양 끝을 보간할 수 있게 하나 더)
+func (z *Zone) historySize() int {
+	return int(z.rules.lagCompensation()/z.def.tickInterval()) + 2
+}
+
+// 틱 끝에 모든 엔티티의 위치를 기록한다 (클라이언트에게 보내는 상태와 같은 시점)
+func (z *Zone) recordHistory(now time.Time) {
+	size := z.historySize()
+	for _, e := range z.entities {
+		e.history.record(now, e.state.Position, size)
+	}
+}
+
+// t 시점의 e 위치. t 는 보상 구간 [now - lagCompensationMs, now] 안으로 제한하고,
+// zero time 이면 지금 위치를 돌려준다.
+func (z *Zone) rewind(e *entity, t, now time.Time) types.Vector {
+	if t.IsZero() {
+		return e.state.Position
+	}
+	if oldest := now.Add(-z.rules.lagCompensation()); t.Before(oldest) {
+		t = oldest
+	}
+	if pos, ok := e.history.at(t); ok {
+		return pos
+	}
+	return e.state.Position
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/SilverSS/gameserver/types"
+)
+
+// X 가 0, 10, 20 ... 으로 100ms 마다 움직인 기록
+func testHistory(start time.Time, n, size int) *positionHistory {
+	var h positionHistory
+	for i := 0; i < n; i++ {
+		h.record(start.Add(time.Duration(i)*100*time.Millisecond), types.Vector{X: float32(i * 10)}, size)
+	}
+	return &h
+}
+
+func TestPositionHistoryAt(t *testing.T) {
+	start := time.Now()
+	h := testHistory(start, 3, 4)
+	tests := []struct {
+		name   string
+		at     time.Time
+		wantX  float32
+		wantOK bool
+	}{
+		{"oldest sample", start, 0, true},
+		{"middle sample", start.Add(100 * time.Millisecond), 10, true},
+		{"between samples", start.Add(150 * time.Millisecond), 15, true},
+		{"before oldest", start.Add(-time.Second), 0, true},
+		{"latest sample", start.Add(200 * time.Millisecond), 0, false},
+		{"after latest", start.Add(time.Second), 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pos, ok := h.at(tt.at)
+			if ok != tt.wantOK || pos.X != tt.wantX {
+				t.Errorf("at = %v, %v; want X %v, %v", pos, ok, tt.wantX, tt.wantOK)
+			}
+		})
+	}
+
+	var empty positionHistory
+	if _, ok := empty.at(start); ok {
+		t.Error("empty history returned a position")
+	}
+	h.reset()
+	if _, ok := h.at(start.Add(50 * time.Millisecond)); ok {
+		t.Error("reset history returned a position")
+	}
+}
+
+func TestPositionHistoryOverwritesOldest(t *testing.T) {
+	start := time.Now()
+	// 5 개를 기록했지만 3 개만 남는다 (X = 20, 30, 40)
+	h := testHistory(start, 5, 3)
+	if h.count != 3 || h.get(0).pos.X != 20 || h.get(2).pos.X != 40 {
+		t.Fatalf("samples = %v, %v, %v", h.get(0), h.get(1), h.get(2))
+	}
+	if pos, ok := h.at(start); !ok || pos.X != 20 {
+		t.Errorf("at(start) = %v, %v; want the oldest kept sample X=20", pos, ok)
+	}
+}
+
+func TestRewindClampsToLagCompensation(t *testing.T) {
+	e := testEntity(1, types.Vector{X: 50})
+	z := collisionZone(&zoneDef{TickMs: 100}, e)
+	z.rules = &combatRules{LagCompensationMs: 200}
+	start := time.Now()
+	for i := 0; i < 6; i++ {
+		e.history.record(start.Add(time.Duration(i)*100*time.Millisecond), types.Vector{X: float32(i * 10)}, z.historySize())
+	}
+	now := start.Add(500 * time.Millisecond)
+
+	tests := []struct {
+		name  string
+		t     time.Time
+		wantX float32
+	}{
+		{"no view time", time.Time{}, 50},
+		{"within window", now.Add(-150 * time.Millisecond), 35},
+		{"past window", now.Add(-time.Second), 30}, // now - 200ms 로 제한
+		{"now", now, 50},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := z.rewind(e, tt.t, now); got.X != tt.wantX {
+				t.Errorf("rewind X = %v, want %v", got.X, tt.wantX)
+			}
+		})
+	}
+}
+
+func TestLatencyPong(t *testing.T) {
+	var l latency
+	now := time.Now()
+	pong := func(rtt time.Duration) {
+		p := l.ping(now)
+		now = now.Add(rtt)
+		l.pong(types.Pong{ServerTime: p.ServerTime, ClientTime: now.Add(-rtt / 2).UnixMilli()}, now)
+	}
+
+	if !l.viewTime(0, 0, now).IsZero() {
+		t.Error("viewTime before any pong should be zero (no compensation)")
+	}
+	pong(80 * time.Millisecond)
+	if l.rtt != 80*time.Millisecond {
+		t.Fatalf("first rtt = %v, want 80ms", l.rtt)
+	}
+	// 이후 값은 1/8 씩 반영한다
+	pong(160 * time.Millisecond)
+	if l.rtt != 90*time.Millisecond {
+		t.Errorf("smoothed rtt = %v, want 90ms", l.rtt)
+	}
+
+	// 기다리는 ping 이 아닌 답과 너무 늦은 답은 버린다
+	p := l.ping(now)
+	l.pong(types.Pong{ServerTime: p.ServerTime + 1}, now.Add(time.Millisecond))
+	l.pong(types.Pong{ServerTime: p.ServerTime}, now.Add(pingTimeout+time.Second))
+	if l.rtt != 90*time.Millisecond {
+		t.Errorf("rtt = %v after ignored pongs, want 90ms", l.rtt)
+	}
+
+	// renderTime 은 그대로 쓰되 미래면 지금으로 제한한다
+	render := now.Add(-100 * time.Millisecond)
+	if got := l.viewTime(0, serverTime(render), now); got.Sub(render).Abs() > time.Millisecond {
+		t.Errorf("viewTime(renderTime) = %v, want %v", got, render)
+	}
+	if got := l.viewTime(0, serverTime(now.Add(time.Second)), now); !got.Equal(now) {
+		t.Errorf("viewTime(future renderTime) = %v, want now", got)
+	}
+	if got := l.viewTime(0, 0, now); got.Sub(now.Add(-l.rtt)).Abs() > time.Millisecond {
+		t.Errorf("viewTime without clientTime = %v, want now - rtt", got)
+	}
+}
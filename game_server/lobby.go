@@ -0,0 +1,336 @@
+package main
+
+import (
+	"crypto/subtle"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/SilverSS/gameserver/types"
+	"github.com/anthdm/hollywood/actor"
+)
+
+// 방 설정 제한
+const (
+	maxRoomNameLen        = 32
+	defaultRoomMaxPlayers = 4
+	maxRoomPlayers        = 16
+)
+
+// 로비 메시지 (세션/GameServer -> 로비)
+type (
+	lobbyEnter struct {
+		Session  *actor.PID
+		Username string
+	}
+
+	// 월드 입장이나 접속 종료로 로비를 떠남 (들어가 있던 방에서도 나간다)
+	lobbyExit struct {
+		Session *actor.PID
+	}
+
+	listRooms struct {
+		Session *actor.PID
+	}
+
+	createRoom struct {
+		Session *actor.PID
+		Req     types.RoomCreateRequest
+	}
+
+	joinRoom struct {
+		Session *actor.PID
+		Req     types.RoomJoinRequest
+	}
+
+	leaveRoom struct {
+		Session *actor.PID
+	}
+
+	startRoom struct {
+		Session *actor.PID
+	}
+)
+
+// 방 인스턴스 생성 요청 (로비 -> 인스턴스 관리자)
+// 성공하면 관리자가 인원 모두를 초대하고 각 세션에 instanceTransfer 를 보낸다.
+type createRoomInstance struct {
+	RoomID   int64
+	Template string
+	Members  []roomMember
+	Match    *arenaMatch // 매치메이커의 매치면 인스턴스 존에 넘길 팀 구성 (로비 방이면 nil)
+}
+
+// 방 인스턴스 생성 결과 (인스턴스 관리자 -> 로비). Code 가 비어 있으면 성공.
+type roomInstanceResult struct {
+	RoomID     int64
+	InstanceID string
+	Code       string
+}
+
+type roomMember struct {
+	Username string
+	Session  *actor.PID
+}
+
+type room struct {
+	id         int64
+	name       string
+	template   string
+	password   string
+	maxPlayers int
+	members    []roomMember // members[0] 이 방장
+	starting   bool         // 인스턴스 생성 대기 중 (입장/퇴장 불가)
+}
+
+func (r *room) info() types.RoomInfo {
+	names := make([]string, len(r.members))
+	for i, m := range r.members {
+		names[i] = m.Username
+	}
+	return types.RoomInfo{
+		ID:          r.id,
+		Name:        r.name,
+		Template:    r.template,
+		Host:        names[0],
+		Members:     names,
+		MaxPlayers:  r.maxPlayers,
+		HasPassword: r.password != "",
+	}
+}
+
+func (r *room) remove(session *actor.PID) {
+	for i, m := range r.members {
+		if m.Session.Equals(session) {
+			r.members = append(r.members[:i], r.members[i+1:]...)
+			return
+		}
+	}
+}
+
+// 로비에 있는 세션
+type lobbyMember struct {
+	username string
+	room     *room
+}
+
+// 로비 액터: 로비에 있는 세션과 방을 관리한다. 방을 시작하면 인스턴스 관리자에 인스턴스를 요청한다.
+type Lobby struct {
+	templates map[string]*instanceTemplate
+	instances *actor.PID
+	members   map[*actor.PID]*lobbyMember
+	rooms     map[int64]*room
+	lastRoom  int64
+}
+
+func newLobby(templates map[string]*instanceTemplate, instances *actor.PID) actor.Producer {
+	return func() actor.Receiver {
+		return &Lobby{
+			templates: templates,
+			instances: instances,
+			members:   make(map[*actor.PID]*lobbyMember),
+			rooms:     make(map[int64]*room),
+		}
+	}
+}
+
+func (l *Lobby) Receive(c *actor.Context) {
+	switch msg := c.Message().(type) {
+	case lobbyEnter:
+		l.members[msg.Session] = &lobbyMember{username: msg.Username}
+		c.Send(msg.Session, wsSend{Type: "lobbyJoined", Data: types.LobbyJoined{Rooms: l.roomList()}})
+	case lobbyExit:
+		if m, ok := l.members[msg.Session]; ok {
+			l.leave(c, msg.Session, m)
+			delete(l.members, msg.Session)
+		}
+	case listRooms:
+		c.Send(msg.Session, wsSend{Type: "roomList", Data: types.RoomList{Rooms: l.roomList()}})
+	case createRoom:
+		l.create(c, msg)
+	case joinRoom:
+		l.join(c, msg)
+	case leaveRoom:
+		m, ok := l.members[msg.Session]
+		if !ok || m.room == nil || m.room.starting {
+			l.reply(c, msg.Session, types.RoomActionLeave, types.LobbyErrNotInRoom, 0)
+			return
+		}
+		id := m.room.id
+		l.leave(c, msg.Session, m)
+		l.reply(c, msg.Session, types.RoomActionLeave, "", id)
+	case startRoom:
+		l.start(c, msg)
+	case roomInstanceResult:
+		l.started(c, msg)
+	}
+}
+
+// 방 목록 (ID 순)
+func (l *Lobby) roomList() []types.RoomInfo {
+	rooms := make([]types.RoomInfo, 0, len(l.rooms))
+	for _, r := range l.rooms {
+		rooms = append(rooms, r.info())
+	}
+	sort.Slice(rooms, func(i, j int) bool { return rooms[i].ID < rooms[j].ID })
+	return rooms
+}
+
+func (l *Lobby) create(c *actor.Context, msg createRoom) {
+	m, ok := l.members[msg.Session]
+	if !ok {
+		l.reply(c, msg.Session, types.RoomActionCreate, types.LobbyErrNotInLobby, 0)
+		return
+	}
+	if m.room != nil {
+		l.reply(c, msg.Session, types.RoomActionCreate, types.LobbyErrAlreadyInRoom, m.room.id)
+		return
+	}
+	req := msg.Req
+	t, ok := l.templates[req.Template]
+	if !ok {
+		l.reply(c, msg.Session, types.RoomActionCreate, types.InstanceErrUnknownTemplate, 0)
+		return
+	}
+	limit := maxRoomPlayers
+	if t.MaxPlayers > 0 && t.MaxPlayers < limit {
+		limit = t.MaxPlayers
+	}
+	if req.MaxPlayers == 0 {
+		req.MaxPlayers = min(defaultRoomMaxPlayers, limit)
+	}
+	n := utf8.RuneCountInString(req.Name)
+	if n == 0 || n > maxRoomNameLen || req.MaxPlayers < 1 || req.MaxPlayers > limit {
+		l.reply(c, msg.Session, types.RoomActionCreate, types.LobbyErrInvalidRoom, 0)
+		return
+	}
+
+	l.lastRoom++
+	r := &room{
+		id:         l.lastRoom,
+		name:       req.Name,
+		template:   t.ID,
+		password:   req.Password,
+		maxPlayers: req.MaxPlayers,
+		members:    []roomMember{{Username: m.username, Session: msg.Session}},
+	}
+	l.rooms[r.id] = r
+	m.room = r
+	l.reply(c, msg.Session, types.RoomActionCreate, "", r.id)
+	l.broadcast(c, r)
+}
+
+func (l *Lobby) join(c *actor.Context, msg joinRoom) {
+	m, ok := l.members[msg.Session]
+	if !ok {
+		l.reply(c, msg.Session, types.RoomActionJoin, types.LobbyErrNotInLobby, 0)
+		return
+	}
+	if m.room != nil {
+		l.reply(c, msg.Session, types.RoomActionJoin, types.LobbyErrAlreadyInRoom, m.room.id)
+		return
+	}
+	r, ok := l.rooms[msg.Req.RoomID]
+	switch {
+	case !ok:
+		l.reply(c, msg.Session, types.RoomActionJoin, types.LobbyErrUnknownRoom, msg.Req.RoomID)
+	case r.starting:
+		l.reply(c, msg.Session, types.RoomActionJoin, types.LobbyErrRoomStarting, r.id)
+	case len(r.members) >= r.maxPlayers:
+		l.reply(c, msg.Session, types.RoomActionJoin, types.LobbyErrRoomFull, r.id)
+	case subtle.ConstantTimeCompare([]byte(r.password), []byte(msg.Req.Password)) != 1:
+		l.reply(c, msg.Session, types.RoomActionJoin, types.LobbyErrWrongPassword, r.id)
+	default:
+		r.members = append(r.members, roomMember{Username: m.username, Session: msg.Session})
+		m.room = r
+		l.reply(c, msg.Session, types.RoomActionJoin, "", r.id)
+		l.broadcast(c, r)
+	}
+}
+
+// 방에서 나가기. 방장이 나가면 다음으로 들어온 사람이 방장이 되고, 아무도 없으면 방을 없앤다.
+// 시작 중인 방은 인스턴스로 이동하는 인원이 먼저 로비를 떠날 수 있으므로 결과가 올 때 정리한다.
+func (l *Lobby) leave(c *actor.Context, session *actor.PID, m *lobbyMember) {
+	r := m.room
+	if r == nil {
+		return
+	}
+	m.room = nil
+	if r.starting {
+		return
+	}
+	r.remove(session)
+	if len(r.members) == 0 {
+		delete(l.rooms, r.id)
+		return
+	}
+	l.broadcast(c, r)
+}
+
+func (l *Lobby) start(c *actor.Context, msg startRoom) {
+	m, ok := l.members[msg.Session]
+	if !ok || m.room == nil {
+		l.reply(c, msg.Session, types.RoomActionStart, types.LobbyErrNotInRoom, 0)
+		return
+	}
+	r := m.room
+	if !r.members[0].Session.Equals(msg.Session) {
+		l.reply(c, msg.Session, types.RoomActionStart, types.LobbyErrNotHost, r.id)
+		return
+	}
+	if r.starting {
+		l.reply(c, msg.Session, types.RoomActionStart, types.LobbyErrRoomStarting, r.id)
+		return
+	}
+	r.starting = true
+	members := append([]roomMember(nil), r.members...)
+	c.Send(l.instances, createRoomInstance{RoomID: r.id, Template: r.template, Members: members})
+}
+
+// 방 인스턴스 생성 결과 처리. 성공하면 방과 인원을 로비에서 정리한다 (세션은 인스턴스로 이동 중).
+func (l *Lobby) started(c *actor.Context, msg roomInstanceResult) {
+	r, ok := l.rooms[msg.RoomID]
+	if !ok {
+		return
+	}
+	if msg.Code != "" {
+		// 기다리는 동안 로비를 떠난 인원은 빼고 방을 다시 연다
+		r.starting = false
+		host := r.members[0].Session
+		kept := r.members[:0]
+		for _, rm := range r.members {
+			if m, ok := l.members[rm.Session]; ok && m.room == r {
+				kept = append(kept, rm)
+			}
+		}
+		r.members = kept
+		if len(r.members) == 0 {
+			delete(l.rooms, r.id)
+			return
+		}
+		l.reply(c, host, types.RoomActionStart, msg.Code, r.id)
+		l.broadcast(c, r)
+		return
+	}
+	delete(l.rooms, r.id)
+	for _, rm := range r.members {
+		l.reply(c, rm.Session, types.RoomActionStart, "", r.id)
+		delete(l.members, rm.Session)
+	}
+}
+
+// 방 인원 모두에게 현재 방 정보 전송
+func (l *Lobby) broadcast(c *actor.Context, r *room) {
+	update := types.RoomUpdate{Room: r.info()}
+	for _, m := range r.members {
+		c.Send(m.Session, wsSend{Type: "roomUpdate", Data: update})
+	}
+}
+
+func (l *Lobby) reply(c *actor.Context, session *actor.PID, action, code string, roomID int64) {
+	c.Send(session, wsSend{Type: "roomResult", Data: types.RoomResult{
+		Action:  action,
+		Success: code == "",
+		Code:    code,
+		RoomID:  roomID,
+	}})
+}
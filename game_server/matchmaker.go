@@ -0,0 +1,598 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/SilverSS/gameserver/ent"
+	"github.com/SilverSS/gameserver/types"
+	"github.com/anthdm/hollywood/actor"
+)
+
+// 매치 한 판의 팀 수 (레이팅 계산은 2팀 기준)
+const matchTeams = 2
+
+// 대기열 검사 주기
+const matchmakingInterval = time.Second
+
+// 시작한 매치의 결과를 기다리는 최대 시간 (지나면 결과 없이 정리)
+const activeMatchTTL = 2 * time.Hour
+
+// DB 조회/저장 제한 시간
+const ratingDBTimeout = 5 * time.Second
+
+// 매치 거절/미응답 누적 횟수별 대기열 등록 제한 시간
+var dodgePenalties = []time.Duration{time.Minute, 5 * time.Minute, 15 * time.Minute}
+
+// 마지막 거절 후 이 시간이 지나면 누적 횟수를 초기화
+const dodgeDecay = time.Hour
+
+// 매치 모드 정의 (data/match_modes.json)
+// 레이팅 차이 허용 범위는 InitialWindow 에서 시작해 대기 1초마다 WidenPerSec 씩 MaxWindow 까지 넓어진다.
+type matchMode struct {
+	ID               string `json:"id"`
+	Name             string `json:"name"`
+	TeamSize         int    `json:"teamSize"`
+	Template         string `json:"template"` // 매치가 시작되면 만들 인스턴스 템플릿
+	InitialWindow    int    `json:"initialWindow"`
+	WidenPerSec      int    `json:"widenPerSec"`
+	MaxWindow        int    `json:"maxWindow"`
+	AcceptTimeoutSec int    `json:"acceptTimeoutSec"`
+	TimeLimitSec     int    `json:"timeLimitSec"` // 매치 제한 시간 (지나면 무승부)
+}
+
+func (m *matchMode) size() int {
+	return m.TeamSize * matchTeams
+}
+
+func (m *matchMode) timeLimit() time.Duration {
+	return time.Duration(m.TimeLimitSec) * time.Second
+}
+
+// 대기 시간에 따른 레이팅 차이 허용 범위
+func (m *matchMode) window(wait time.Duration) int {
+	w := m.InitialWindow + int(wait/time.Second)*m.WidenPerSec
+	return min(w, m.MaxWindow)
+}
+
+// 매치 모드 전체 로드. 템플릿 최대 인원이 매치 인원보다 적으면 오류.
+func loadMatchModes(templates map[string]*instanceTemplate) (map[string]*matchMode, error) {
+	var list []*matchMode
+	if err := readDataJSON("match_modes.json", &list); err != nil {
+		return nil, err
+	}
+	modes := make(map[string]*matchMode, len(list))
+	for _, m := range list {
+		if m.ID == "" {
+			return nil, fmt.Errorf("match_modes.json: missing id")
+		}
+		if _, dup := modes[m.ID]; dup {
+			return nil, fmt.Errorf("match_modes.json: duplicate mode %q", m.ID)
+		}
+		if m.TeamSize < 1 || m.AcceptTimeoutSec < 1 || m.TimeLimitSec < 1 || m.InitialWindow < 0 || m.MaxWindow < m.InitialWindow {
+			return nil, fmt.Errorf("match_modes.json: mode %s: invalid teamSize, acceptTimeoutSec, timeLimitSec or rating window", m.ID)
+		}
+		t, ok := templates[m.Template]
+		if !ok {
+			return nil, fmt.Errorf("match_modes.json: mode %s: unknown instance template %q", m.ID, m.Template)
+		}
+		if t.MaxPlayers > 0 && t.MaxPlayers < m.size() {
+			return nil, fmt.Errorf("match_modes.json: mode %s: template %s allows only %d players", m.ID, t.ID, t.MaxPlayers)
+		}
+		modes[m.ID] = m
+	}
+	return modes, nil
+}
+
+// 매치메이커 메시지 (세션 -> 매치메이커)
+type (
+	queueMatch struct {
+		Session  *actor.PID
+		Username string
+		Mode     string
+	}
+
+	cancelMatchQueue struct {
+		Session  *actor.PID
+		Username string
+	}
+
+	respondMatch struct {
+		Username string
+		MatchID  int64
+		Accept   bool
+	}
+
+	// 매치 결과 보고 (매치 인스턴스 존 -> 매치메이커). WinningTeam 이 음수면 무승부.
+	matchFinished struct {
+		MatchID     int64
+		WinningTeam int
+	}
+
+	// 레이팅 조회 완료 (조회 고루틴 -> 매치메이커)
+	ratingLoaded struct {
+		Session     *actor.PID
+		Username    string
+		Mode        string
+		CharacterID int
+		Rating      int
+		Err         error
+	}
+
+	matchmakingTick struct{}
+)
+
+// 대기열 등록 정보
+type matchTicket struct {
+	username    string
+	session     *actor.PID
+	characterID int
+	rating      int
+	mode        *matchMode
+	queuedAt    time.Time
+	match       int64 // 수락 대기 중인 매치 (대기열에 있으면 0)
+}
+
+// 수락을 기다리는 매치
+type pendingMatch struct {
+	id       int64
+	mode     *matchMode
+	tickets  []*matchTicket
+	accepted map[string]bool
+	deadline time.Time
+	starting bool                       // 전원 수락, 인스턴스 생성 대기 중
+	teams    [matchTeams][]*matchTicket // 인스턴스를 요청할 때 나눈 팀
+}
+
+// 시작한 매치 (결과 보고를 기다림)
+type activeMatch struct {
+	teams     [matchTeams][]*matchTicket
+	startedAt time.Time
+}
+
+// 매치 거절 누적
+type dodgeRecord struct {
+	count int
+	last  time.Time
+	until time.Time
+}
+
+// 매치메이커: 모드별 대기열에서 레이팅이 비슷한 플레이어를 묶어 매치를 만들고,
+// 전원이 수락하면 인스턴스 관리자에 매치 인스턴스를 요청한다. 레이팅은 캐릭터에 저장한다.
+type Matchmaker struct {
+	db        *ent.Client
+	modes     map[string]*matchMode
+	instances *actor.PID
+
+	queues    map[string][]*matchTicket // 모드 -> 대기 순서대로 정렬된 대기열
+	players   map[string]*matchTicket   // 대기 중이거나 수락 대기 중인 플레이어
+	loading   map[string]*actor.PID     // 레이팅 조회 중인 플레이어 -> 세션
+	pending   map[int64]*pendingMatch
+	active    map[int64]*activeMatch
+	dodges    map[string]*dodgeRecord
+	lastMatch int64
+	repeater  actor.SendRepeater
+}
+
+func newMatchmaker(db *ent.Client, modes map[string]*matchMode, instances *actor.PID) actor.Producer {
+	return func() actor.Receiver {
+		return &Matchmaker{
+			db:        db,
+			modes:     modes,
+			instances: instances,
+			queues:    make(map[string][]*matchTicket),
+			players:   make(map[string]*matchTicket),
+			loading:   make(map[string]*actor.PID),
+			pending:   make(map[int64]*pendingMatch),
+			active:    make(map[int64]*activeMatch),
+			dodges:    make(map[string]*dodgeRecord),
+		}
+	}
+}
+
+func (m *Matchmaker) Receive(c *actor.Context) {
+	switch msg := c.Message().(type) {
+	case actor.Started:
+		c.Engine().Subscribe(c.PID())
+		m.repeater = c.SendRepeat(c.PID(), matchmakingTick{}, matchmakingInterval)
+	case actor.Stopped:
+		m.repeater.Stop()
+		c.Engine().Unsubscribe(c.PID())
+	case queueMatch:
+		m.queue(c, msg)
+	case ratingLoaded:
+		m.ratingLoaded(c, msg)
+	case cancelMatchQueue:
+		m.cancelQueue(c, msg)
+	case respondMatch:
+		m.respond(c, msg)
+	case playerOffline:
+		m.offline(c, msg)
+	case roomInstanceResult:
+		m.instanceCreated(c, msg)
+	case matchFinished:
+		m.finish(c, msg)
+	case matchmakingTick:
+		m.tick(c)
+	}
+}
+
+func (m *Matchmaker) queue(c *actor.Context, msg queueMatch) {
+	_, ok := m.modes[msg.Mode]
+	if !ok {
+		m.reply(c, msg.Session, types.MatchQueueResult{Action: types.MatchActionQueue, Code: types.MatchErrUnknownMode, Mode: msg.Mode})
+		return
+	}
+	if _, ok := m.players[msg.Username]; ok {
+		m.reply(c, msg.Session, types.MatchQueueResult{Action: types.MatchActionQueue, Code: types.MatchErrAlreadyQueued, Mode: msg.Mode})
+		return
+	}
+	if _, ok := m.loading[msg.Username]; ok {
+		m.reply(c, msg.Session, types.MatchQueueResult{Action: types.MatchActionQueue, Code: types.MatchErrAlreadyQueued, Mode: msg.Mode})
+		return
+	}
+	if d, ok := m.dodges[msg.Username]; ok && time.Now().Before(d.until) {
+		left := int(time.Until(d.until).Seconds()) + 1
+		m.reply(c, msg.Session, types.MatchQueueResult{Action: types.MatchActionQueue, Code: types.MatchErrPenalty, Mode: msg.Mode, PenaltySec: left})
+		return
+	}
+
+	// 레이팅은 DB 에서 읽어 온 뒤 대기열에 넣는다 (액터가 DB 를 기다리지 않도록 고루틴에서 조회)
+	m.loading[msg.Username] = msg.Session
+	self, engine := c.PID(), c.Engine()
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), ratingDBTimeout)
+		defer cancel()
+		loaded := ratingLoaded{Session: msg.Session, Username: msg.Username, Mode: msg.Mode}
+		ch, err := loadCharacterRating(ctx, m.db, msg.Username)
+		if err != nil {
+			loaded.Err = err
+		} else {
+			loaded.CharacterID, loaded.Rating = ch.ID, ch.Rating
+		}
+		engine.Send(self, loaded)
+	}()
+}
+
+func (m *Matchmaker) ratingLoaded(c *actor.Context, msg ratingLoaded) {
+	// 조회하는 동안 취소했거나 접속이 끊겼으면 버린다
+	if pid, ok := m.loading[msg.Username]; !ok || !pid.Equals(msg.Session) {
+		return
+	}
+	delete(m.loading, msg.Username)
+	if msg.Err != nil {
+		fmt.Printf("matchmaking: rating lookup for %s failed: %v\n", msg.Username, msg.Err)
+		m.reply(c, msg.Session, types.MatchQueueResult{Action: types.MatchActionQueue, Code: types.MatchErrUnavailable, Mode: msg.Mode})
+		return
+	}
+	mode := m.modes[msg.Mode]
+	t := &matchTicket{
+		username:    msg.Username,
+		session:     msg.Session,
+		characterID: msg.CharacterID,
+		rating:      msg.Rating,
+		mode:        mode,
+		queuedAt:    time.Now(),
+	}
+	m.players[t.username] = t
+	m.enqueue(t)
+	m.reply(c, msg.Session, types.MatchQueueResult{Action: types.MatchActionQueue, Mode: mode.ID, Rating: t.rating})
+}
+
+// 대기 순서를 지켜 대기열에 넣는다 (취소된 매치에서 돌아온 플레이어는 원래 순서로)
+func (m *Matchmaker) enqueue(t *matchTicket) {
+	q := m.queues[t.mode.ID]
+	i := sort.Search(len(q), func(i int) bool { return q[i].queuedAt.After(t.queuedAt) })
+	q = append(q, nil)
+	copy(q[i+1:], q[i:])
+	q[i] = t
+	m.queues[t.mode.ID] = q
+}
+
+func (m *Matchmaker) dequeue(t *matchTicket) {
+	q := m.queues[t.mode.ID]
+	for i, other := range q {
+		if other == t {
+			m.queues[t.mode.ID] = append(q[:i], q[i+1:]...)
+			return
+		}
+	}
+}
+
+func (m *Matchmaker) cancelQueue(c *actor.Context, msg cancelMatchQueue) {
+	if _, ok := m.loading[msg.Username]; ok {
+		delete(m.loading, msg.Username)
+		m.reply(c, msg.Session, types.MatchQueueResult{Action: types.MatchActionCancel})
+		return
+	}
+	t, ok := m.players[msg.Username]
+	if !ok || t.match != 0 {
+		// 수락 대기 중인 매치는 matchDecline 으로만 빠질 수 있다
+		m.reply(c, msg.Session, types.MatchQueueResult{Action: types.MatchActionCancel, Code: types.MatchErrNotQueued})
+		return
+	}
+	m.dequeue(t)
+	delete(m.players, t.username)
+	m.reply(c, msg.Session, types.MatchQueueResult{Action: types.MatchActionCancel, Mode: t.mode.ID})
+}
+
+func (m *Matchmaker) respond(c *actor.Context, msg respondMatch) {
+	t, ok := m.players[msg.Username]
+	if !ok || t.match != msg.MatchID {
+		return
+	}
+	// 매치가 없는 대기 중인 플레이어는 t.match 가 0 이라 matchID 없는 응답도 여기까지 온다
+	pm, ok := m.pending[msg.MatchID]
+	if !ok || pm.starting {
+		return
+	}
+	if !msg.Accept {
+		m.cancelMatch(c, pm, types.MatchCancelDeclined, map[string]bool{t.username: true})
+		return
+	}
+	pm.accepted[t.username] = true
+	if len(pm.accepted) == len(pm.tickets) {
+		m.start(c, pm)
+	}
+}
+
+// 접속 종료: 대기열에서 빼고, 수락 대기 중인 매치는 거절로 처리한다.
+func (m *Matchmaker) offline(c *actor.Context, msg playerOffline) {
+	if pid, ok := m.loading[msg.Username]; ok && pid.Equals(msg.Session) {
+		delete(m.loading, msg.Username)
+	}
+	t, ok := m.players[msg.Username]
+	if !ok || !t.session.Equals(msg.Session) {
+		return
+	}
+	if t.match == 0 {
+		m.dequeue(t)
+		delete(m.players, t.username)
+		return
+	}
+	if pm := m.pending[t.match]; !pm.starting {
+		m.cancelMatch(c, pm, types.MatchCancelDeclined, map[string]bool{t.username: true})
+	}
+}
+
+func (m *Matchmaker) tick(c *actor.Context) {
+	now := time.Now()
+
+	// 수락 제한 시간이 지난 매치: 수락하지 않은 플레이어가 거절한 것으로 처리
+	for _, pm := range m.pendingByID() {
+		if pm.starting || now.Before(pm.deadline) {
+			continue
+		}
+		dodgers := make(map[string]bool)
+		for _, t := range pm.tickets {
+			if !pm.accepted[t.username] {
+				dodgers[t.username] = true
+			}
+		}
+		m.cancelMatch(c, pm, types.MatchCancelTimeout, dodgers)
+	}
+
+	for id, am := range m.active {
+		if now.Sub(am.startedAt) > activeMatchTTL {
+			delete(m.active, id)
+		}
+	}
+
+	ids := make([]string, 0, len(m.queues))
+	for id := range m.queues {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		m.formMatches(c, m.modes[id], now)
+	}
+}
+
+func (m *Matchmaker) pendingByID() []*pendingMatch {
+	list := make([]*pendingMatch, 0, len(m.pending))
+	for _, pm := range m.pending {
+		list = append(list, pm)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].id < list[j].id })
+	return list
+}
+
+// 오래 기다린 플레이어부터 기준으로 삼아, 레이팅 차이가 양쪽 허용 범위 안에 드는 플레이어를 모은다.
+func (m *Matchmaker) formMatches(c *actor.Context, mode *matchMode, now time.Time) {
+	size := mode.size()
+	for {
+		q := m.queues[mode.ID]
+		if len(q) < size {
+			return
+		}
+		var picked []*matchTicket
+		for _, anchor := range q {
+			cands := make([]*matchTicket, 0, len(q))
+			for _, t := range q {
+				if t == anchor {
+					continue
+				}
+				w := min(mode.window(now.Sub(anchor.queuedAt)), mode.window(now.Sub(t.queuedAt)))
+				if absInt(t.rating-anchor.rating) <= w {
+					cands = append(cands, t)
+				}
+			}
+			if len(cands) < size-1 {
+				continue
+			}
+			sort.SliceStable(cands, func(i, j int) bool {
+				return absInt(cands[i].rating-anchor.rating) < absInt(cands[j].rating-anchor.rating)
+			})
+			picked = append([]*matchTicket{anchor}, cands[:size-1]...)
+			break
+		}
+		if picked == nil {
+			return
+		}
+		m.propose(c, mode, picked)
+	}
+}
+
+// 매치 성사: 대기열에서 빼고 수락을 요청한다.
+func (m *Matchmaker) propose(c *actor.Context, mode *matchMode, tickets []*matchTicket) {
+	m.lastMatch++
+	pm := &pendingMatch{
+		id:       m.lastMatch,
+		mode:     mode,
+		tickets:  tickets,
+		accepted: make(map[string]bool, len(tickets)),
+		deadline: time.Now().Add(time.Duration(mode.AcceptTimeoutSec) * time.Second),
+	}
+	m.pending[pm.id] = pm
+	found := types.MatchFound{MatchID: pm.id, Mode: mode.ID, Players: len(tickets), AcceptTimeoutSec: mode.AcceptTimeoutSec}
+	for _, t := range tickets {
+		m.dequeue(t)
+		t.match = pm.id
+		c.Send(t.session, wsSend{Type: "matchFound", Data: found})
+	}
+}
+
+// 매치 취소. dodgers 는 대기열 제한을 받고, 나머지는 원래 대기 순서로 대기열에 돌아간다.
+func (m *Matchmaker) cancelMatch(c *actor.Context, pm *pendingMatch, reason string, dodgers map[string]bool) {
+	delete(m.pending, pm.id)
+	for _, t := range pm.tickets {
+		t.match = 0
+		requeue := !dodgers[t.username]
+		if requeue {
+			m.enqueue(t)
+		} else {
+			delete(m.players, t.username)
+			m.penalize(t.username)
+		}
+		c.Send(t.session, wsSend{Type: "matchCancelled", Data: types.MatchCancelled{MatchID: pm.id, Reason: reason, Requeued: requeue}})
+	}
+}
+
+func (m *Matchmaker) penalize(username string) {
+	now := time.Now()
+	d, ok := m.dodges[username]
+	if !ok || now.Sub(d.last) > dodgeDecay {
+		d = &dodgeRecord{}
+		m.dodges[username] = d
+	}
+	d.until = now.Add(dodgePenalties[min(d.count, len(dodgePenalties)-1)])
+	d.count++
+	d.last = now
+}
+
+// 전원 수락: 레이팅이 고르게 나뉘도록 팀을 짜고 매치 인스턴스를 요청한다.
+// 인스턴스 존이 팀 구성으로 승패를 판정해 matchFinished 로 알려 준다.
+func (m *Matchmaker) start(c *actor.Context, pm *pendingMatch) {
+	pm.starting = true
+	pm.teams = splitTeams(pm.tickets)
+	members := make([]roomMember, len(pm.tickets))
+	for i, t := range pm.tickets {
+		members[i] = roomMember{Username: t.username, Session: t.session}
+	}
+	match := &arenaMatch{MatchID: pm.id, TimeLimit: pm.mode.timeLimit(), Matchmaker: c.PID()}
+	for i, team := range pm.teams {
+		for _, t := range team {
+			match.Teams[i] = append(match.Teams[i], t.username)
+		}
+	}
+	c.Send(m.instances, createRoomInstance{RoomID: pm.id, Template: pm.mode.Template, Members: members, Match: match})
+}
+
+func (m *Matchmaker) instanceCreated(c *actor.Context, msg roomInstanceResult) {
+	pm, ok := m.pending[msg.RoomID]
+	if !ok {
+		return
+	}
+	if msg.Code != "" {
+		fmt.Printf("matchmaking: match %d instance failed: %s\n", pm.id, msg.Code)
+		m.cancelMatch(c, pm, types.MatchCancelFailed, nil)
+		return
+	}
+	delete(m.pending, pm.id)
+	am := &activeMatch{teams: pm.teams, startedAt: time.Now()}
+	m.active[pm.id] = am
+
+	var teams [][]types.MatchPlayer
+	for _, team := range am.teams {
+		players := make([]types.MatchPlayer, len(team))
+		for i, t := range team {
+			players[i] = types.MatchPlayer{Username: t.username, Rating: t.rating}
+		}
+		teams = append(teams, players)
+	}
+	for i, team := range am.teams {
+		for _, t := range team {
+			delete(m.players, t.username)
+			c.Send(t.session, wsSend{Type: "matchStarted", Data: types.MatchStarted{
+				MatchID:      pm.id,
+				InstanceID:   msg.InstanceID,
+				Team:         i,
+				Teams:        teams,
+				TimeLimitSec: pm.mode.TimeLimitSec,
+			}})
+		}
+	}
+}
+
+// 레이팅 높은 순으로 0,1,1,0,0,1... 순서로 나눠 팀 평균을 맞춘다.
+func splitTeams(tickets []*matchTicket) [matchTeams][]*matchTicket {
+	sorted := append([]*matchTicket(nil), tickets...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].rating > sorted[j].rating })
+	var teams [matchTeams][]*matchTicket
+	for i, t := range sorted {
+		team := i % matchTeams
+		if (i/matchTeams)%2 == 1 {
+			team = matchTeams - 1 - team
+		}
+		teams[team] = append(teams[team], t)
+	}
+	return teams
+}
+
+// 매치 결과로 레이팅을 갱신하고 각 플레이어에게 알린다.
+func (m *Matchmaker) finish(c *actor.Context, msg matchFinished) {
+	am, ok := m.active[msg.MatchID]
+	if !ok {
+		return
+	}
+	delete(m.active, msg.MatchID)
+
+	var avg [matchTeams]float64
+	for i, team := range am.teams {
+		for _, t := range team {
+			avg[i] += float64(t.rating)
+		}
+		avg[i] /= float64(len(team))
+	}
+	deltas := eloDeltas(avg, msg.WinningTeam)
+
+	engine := c.Engine()
+	for i, team := range am.teams {
+		for _, t := range team {
+			go func(t *matchTicket, delta int) {
+				ctx, cancel := context.WithTimeout(context.Background(), ratingDBTimeout)
+				defer cancel()
+				ch, err := applyRatingDelta(ctx, m.db, t.characterID, delta)
+				if err != nil {
+					fmt.Printf("matchmaking: saving rating for %s failed: %v\n", t.username, err)
+					return
+				}
+				engine.Send(t.session, wsSend{Type: "ratingChanged", Data: types.RatingChanged{MatchID: msg.MatchID, Rating: ch.Rating, Delta: delta}})
+			}(t, deltas[i])
+		}
+	}
+}
+
+func (m *Matchmaker) reply(c *actor.Context, session *actor.PID, result types.MatchQueueResult) {
+	result.Success = result.Code == ""
+	c.Send(session, wsSend{Type: "matchQueueResult", Data: result})
+}
+
+func absInt(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/SilverSS/gameserver/ent"
+	"github.com/SilverSS/gameserver/ent/character"
+	"github.com/SilverSS/gameserver/ent/user"
+	"github.com/SilverSS/gameserver/types"
+	"github.com/anthdm/hollywood/actor"
+)
+
+// 받은 메시지를 모아 두는 액터 (세션, GameServer 자리)
+type inbox struct {
+	t       *testing.T
+	name    string
+	engine  *actor.Engine
+	pid     *actor.PID
+	pending []any
+}
+
+func newInbox(t *testing.T, e *actor.Engine, name string) *inbox {
+	var received []any
+	pid := e.SpawnFunc(func(c *actor.Context) {
+		switch msg := c.Message().(type) {
+		case actor.Initialized, actor.Started, actor.Stopped:
+		case takeMessages:
+			c.Respond(received)
+			received = nil
+		default:
+			received = append(received, msg)
+		}
+	}, name)
+	t.Cleanup(func() { e.Poison(pid).Wait() })
+	return &inbox{t: t, name: name, engine: e, pid: pid}
+}
+
+// match 가 참인 메시지가 올 때까지 기다려 꺼낸다 (다른 메시지는 남겨 둔다)
+func (b *inbox) waitFor(what string, match func(any) bool) any {
+	b.t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		for i, msg := range b.pending {
+			if match(msg) {
+				b.pending = append(b.pending[:i], b.pending[i+1:]...)
+				return msg
+			}
+		}
+		if time.Now().After(deadline) {
+			b.t.Fatalf("%s: timed out waiting for %s (got %v)", b.name, what, b.pending)
+		}
+		res, err := b.engine.Request(b.pid, takeMessages{}, time.Second).Result()
+		if err != nil {
+			b.t.Fatal(err)
+		}
+		msgs, _ := res.([]any)
+		b.pending = append(b.pending, msgs...)
+		if len(msgs) == 0 {
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+}
+
+func waitForWS[T any](b *inbox, msgType string) T {
+	b.t.Helper()
+	msg := b.waitFor(msgType, func(m any) bool {
+		ws, ok := m.(wsSend)
+		return ok && ws.Type == msgType
+	})
+	return msg.(wsSend).Data.(T)
+}
+
+// p1, p2 계정과 1대1 모드("duel"), 실제 인스턴스 관리자를 둔 매치메이커
+type matchTest struct {
+	db       *ent.Client
+	engine   *actor.Engine
+	server   *inbox
+	sessions []*inbox
+	mm       *actor.PID
+}
+
+func newMatchTest(t *testing.T) *matchTest {
+	t.Helper()
+	db := openTestDB(t)
+	for _, name := range []string{"p1", "p2"} {
+		if _, err := createAccount(context.Background(), db, name, "hash"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	e, err := actor.NewEngine(actor.NewEngineConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	mt := &matchTest{db: db, engine: e, server: newInbox(t, e, "server")}
+	mt.sessions = []*inbox{newInbox(t, e, "p1"), newInbox(t, e, "p2")}
+
+	templates := map[string]*instanceTemplate{"arena": {ID: "arena", Name: "Arena", ExitZone: "town", Zone: *testZoneDef("arena", types.Vector{})}}
+	instances := e.Spawn(newInstanceManager(templates, testCombatRules(), 0, mt.server.pid, nil), "instances")
+	modes := map[string]*matchMode{"duel": {
+		ID: "duel", Template: "arena", TeamSize: 1,
+		InitialWindow: 100, WidenPerSec: 10, MaxWindow: 300,
+		AcceptTimeoutSec: 15, TimeLimitSec: 60,
+	}}
+	mt.mm = e.Spawn(newMatchmaker(db, modes, instances), "matchmaker")
+	t.Cleanup(func() {
+		e.Poison(mt.mm).Wait()
+		e.Poison(instances).Wait()
+	})
+	return mt
+}
+
+// 대기열에 넣고 등록 결과를 기다린다
+func (mt *matchTest) queue(s *inbox) {
+	s.t.Helper()
+	mt.engine.Send(mt.mm, queueMatch{Session: s.pid, Username: s.name, Mode: "duel"})
+	if res := waitForWS[types.MatchQueueResult](s, "matchQueueResult"); !res.Success || res.Rating != 1200 {
+		s.t.Fatalf("%s queue: %+v", s.name, res)
+	}
+}
+
+// 큐 등록부터 매치 인스턴스에서의 승패 판정, 레이팅 저장까지 (p2 가 매치 도중에 나가 기권)
+func TestMatchForfeitUpdatesRatings(t *testing.T) {
+	mt := newMatchTest(t)
+	ctx, e, db, server, sessions, mm := context.Background(), mt.engine, mt.db, mt.server, mt.sessions, mt.mm
+	for _, s := range sessions {
+		mt.queue(s)
+	}
+	e.Send(mm, matchmakingTick{})
+	var found types.MatchFound
+	for _, s := range sessions {
+		found = waitForWS[types.MatchFound](s, "matchFound")
+	}
+	for _, s := range sessions {
+		e.Send(mm, respondMatch{Username: s.name, MatchID: found.MatchID, Accept: true})
+	}
+	for _, s := range sessions {
+		if started := waitForWS[types.MatchStarted](s, "matchStarted"); started.TimeLimitSec != 60 {
+			t.Errorf("%s: matchStarted = %+v", s.name, started)
+		}
+	}
+	reg := server.waitFor("registerZone", func(m any) bool {
+		_, ok := m.(registerZone)
+		return ok
+	}).(registerZone)
+
+	for i, s := range sessions {
+		pos := types.Vector{X: float32(i * 2)}
+		e.Send(reg.Zone, enterZone{EntityID: int64(i + 1), Name: s.name, Session: s.pid, Position: &pos})
+	}
+	e.Send(reg.Zone, leaveZone{EntityID: 2})
+
+	if ended := waitForWS[types.MatchEnded](sessions[0], "matchEnded"); ended.WinningTeam != 0 || ended.Reason != types.MatchEndForfeit {
+		t.Errorf("matchEnded = %+v, want team 0 to win by forfeit", ended)
+	}
+	want := map[string]int{"p1": 1216, "p2": 1184}
+	for _, s := range sessions {
+		changed := waitForWS[types.RatingChanged](s, "ratingChanged")
+		if changed.Rating != want[s.name] {
+			t.Errorf("%s: ratingChanged = %+v, want rating %d", s.name, changed, want[s.name])
+		}
+		ch := db.Character.Query().Where(character.HasOwnerWith(user.UsernameEQ(s.name))).OnlyX(ctx)
+		if ch.Rating != want[s.name] || ch.RatedGames != 1 {
+			t.Errorf("%s: stored rating %d (%d games), want %d (1 game)", s.name, ch.Rating, ch.RatedGames, want[s.name])
+		}
+	}
+}
+
+// matchID 없는 수락/거절 (매치가 없는 대기 중 플레이어의 t.match 도 0) 에 매치메이커가 죽으면
+// 재시작하면서 대기열이 모두 사라진다
+func TestRespondWithoutMatchKeepsQueue(t *testing.T) {
+	mt := newMatchTest(t)
+	p1, p2 := mt.sessions[0], mt.sessions[1]
+	mt.queue(p1)
+	mt.engine.Send(mt.mm, respondMatch{Username: p1.name, Accept: true})
+	mt.engine.Send(mt.mm, respondMatch{Username: p1.name, Accept: false})
+
+	mt.queue(p2)
+	mt.engine.Send(mt.mm, matchmakingTick{})
+	for _, s := range mt.sessions {
+		if found := waitForWS[types.MatchFound](s, "matchFound"); found.Players != 2 {
+			t.Errorf("%s: matchFound = %+v", s.name, found)
+		}
+	}
+}
@@ -0,0 +1,539 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	stdsql "database/sql"
+	"embed"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/sqltool"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql/schema"
+)
+
+// 버전별 마이그레이션 파일 (golang-migrate 형식: <version>_<name>.up.sql / <version>_<name>.down.sql)
+// 드라이버마다 SQL 이 다르므로 migrations/<driver>/ 디렉토리를 따로 둔다.
+//
+//go:embed migrations
+var embeddedMigrations embed.FS
+
+// 적용 이력 테이블
+const migrationTable = "schema_migrations"
+
+// postgres 에서 여러 서버가 동시에 마이그레이션하지 않도록 잡는 advisory lock 키
+const migrationLockKey = 0x67616d65 // "game"
+
+// SQL 만으로 할 수 없는 데이터 변환 (버전 -> 함수). 그 버전의 up SQL 직후 같은 트랜잭션에서 실행한다.
+var migrationFuncs = map[string]func(ctx context.Context, tx *stdsql.Tx, m *migrator) error{
+	"20261019083639": backfillUsernameKeys,
+}
+
+type migration struct {
+	Version string
+	Name    string
+	Up      string
+	Down    string
+}
+
+// 적용된 마이그레이션 이력
+type appliedMigration struct {
+	Version   string
+	Name      string
+	AppliedAt time.Time
+}
+
+type migrator struct {
+	db         *stdsql.DB
+	driver     string
+	migrations []migration
+}
+
+func newMigrator(db *stdsql.DB, driver string) (*migrator, error) {
+	migrations, err := loadMigrations(driver)
+	if err != nil {
+		return nil, err
+	}
+	return &migrator{db: db, driver: driver, migrations: migrations}, nil
+}
+
+// 내장된 마이그레이션 파일을 읽고 atlas.sum 으로 무결성을 검사한다.
+func loadMigrations(driver string) ([]migration, error) {
+	dir, err := fs.Sub(embeddedMigrations, path.Join("migrations", driver))
+	if err != nil {
+		return nil, err
+	}
+	names, err := fs.Glob(dir, "*.sql")
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+
+	var files []migrate.File
+	byVersion := make(map[string]*migration)
+	for _, name := range names {
+		b, err := fs.ReadFile(dir, name)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, migrate.NewLocalFile(name, b))
+
+		var up bool
+		base := name
+		switch {
+		case strings.HasSuffix(name, ".up.sql"):
+			up, base = true, strings.TrimSuffix(name, ".up.sql")
+		case strings.HasSuffix(name, ".down.sql"):
+			base = strings.TrimSuffix(name, ".down.sql")
+		default:
+			return nil, fmt.Errorf("migration %s: expected .up.sql or .down.sql suffix", name)
+		}
+		version, desc, _ := strings.Cut(base, "_")
+		if _, err := strconv.ParseUint(version, 10, 64); err != nil {
+			return nil, fmt.Errorf("migration %s: invalid version %q", name, version)
+		}
+		m, ok := byVersion[version]
+		if !ok {
+			m = &migration{Version: version, Name: desc}
+			byVersion[version] = m
+		}
+		if up {
+			m.Up = string(b)
+		} else {
+			m.Down = string(b)
+		}
+	}
+	if err := checkSum(dir, files); err != nil {
+		return nil, fmt.Errorf("migrations/%s: %w", driver, err)
+	}
+
+	migrations := make([]migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" {
+			return nil, fmt.Errorf("migration %s_%s: missing up file", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// atlas.sum 과 실제 파일 내용 비교 (수동 편집된 마이그레이션 감지)
+func checkSum(dir fs.FS, files []migrate.File) error {
+	if len(files) == 0 {
+		return nil
+	}
+	want, err := fs.ReadFile(dir, migrate.HashFileName)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", migrate.HashFileName, err)
+	}
+	sum, err := migrate.NewHashFile(files)
+	if err != nil {
+		return err
+	}
+	got, err := sum.MarshalText()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(bytes.TrimSpace(want), bytes.TrimSpace(got)) {
+		return fmt.Errorf("checksum mismatch: run `migrate hash` after editing migration files")
+	}
+	return nil
+}
+
+// 드라이버별 바인딩 변수 표기
+func (m *migrator) arg(n int) string {
+	if m.driver == dialect.Postgres {
+		return "$" + strconv.Itoa(n)
+	}
+	return "?"
+}
+
+func (m *migrator) ensureTable(ctx context.Context) error {
+	_, err := m.db.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS "+migrationTable+
+		" (version VARCHAR(32) PRIMARY KEY, name VARCHAR(255) NOT NULL, applied_at TIMESTAMP NOT NULL)")
+	return err
+}
+
+// 적용된 마이그레이션 목록 (버전 순)
+func (m *migrator) applied(ctx context.Context) ([]appliedMigration, error) {
+	if err := m.ensureTable(ctx); err != nil {
+		return nil, err
+	}
+	rows, err := m.db.QueryContext(ctx, "SELECT version, name, applied_at FROM "+migrationTable+" ORDER BY version")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var applied []appliedMigration
+	for rows.Next() {
+		var a appliedMigration
+		if err := rows.Scan(&a.Version, &a.Name, &a.AppliedAt); err != nil {
+			return nil, err
+		}
+		applied = append(applied, a)
+	}
+	return applied, rows.Err()
+}
+
+// 아직 적용되지 않은 마이그레이션 목록
+func (m *migrator) pending(ctx context.Context) ([]migration, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	done := make(map[string]bool, len(applied))
+	for _, a := range applied {
+		done[a.Version] = true
+	}
+	var pending []migration
+	for _, mig := range m.migrations {
+		if !done[mig.Version] {
+			pending = append(pending, mig)
+		}
+	}
+	return pending, nil
+}
+
+// 마이그레이션 하나를 트랜잭션 안에서 실행하고 이력을 갱신한다.
+func (m *migrator) exec(ctx context.Context, mig migration, up bool) error {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if m.driver == dialect.Postgres {
+		if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", migrationLockKey); err != nil {
+			return err
+		}
+	}
+	// 잠금을 잡은 뒤 다시 확인해 다른 서버가 먼저 적용한 경우를 건너뛴다.
+	var n int
+	if err := tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM "+migrationTable+" WHERE version = "+m.arg(1), mig.Version).Scan(&n); err != nil {
+		return err
+	}
+	if up == (n > 0) {
+		return nil
+	}
+	if up {
+		if _, err := tx.ExecContext(ctx, mig.Up); err != nil {
+			return fmt.Errorf("migration %s_%s up: %w", mig.Version, mig.Name, err)
+		}
+		if fn := migrationFuncs[mig.Version]; fn != nil {
+			if err := fn(ctx, tx, m); err != nil {
+				return fmt.Errorf("migration %s_%s up: %w", mig.Version, mig.Name, err)
+			}
+		}
+		if _, err := tx.ExecContext(ctx, "INSERT INTO "+migrationTable+" (version, name, applied_at) VALUES ("+m.arg(1)+", "+m.arg(2)+", "+m.arg(3)+")",
+			mig.Version, mig.Name, time.Now().UTC()); err != nil {
+			return err
+		}
+	} else {
+		if mig.Down == "" {
+			return fmt.Errorf("migration %s_%s: no down file", mig.Version, mig.Name)
+		}
+		if _, err := tx.ExecContext(ctx, mig.Down); err != nil {
+			return fmt.Errorf("migration %s_%s down: %w", mig.Version, mig.Name, err)
+		}
+		if _, err := tx.ExecContext(ctx, "DELETE FROM "+migrationTable+" WHERE version = "+m.arg(1), mig.Version); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// 대기 중인 마이그레이션 적용 (limit <= 0 이면 전부). 적용한 개수를 반환한다.
+func (m *migrator) up(ctx context.Context, limit int) (int, error) {
+	pending, err := m.pending(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if limit > 0 && limit < len(pending) {
+		pending = pending[:limit]
+	}
+	for i, mig := range pending {
+		if err := m.exec(ctx, mig, true); err != nil {
+			return i, err
+		}
+		fmt.Printf("applied %s_%s\n", mig.Version, mig.Name)
+	}
+	return len(pending), nil
+}
+
+// 기존 계정의 username_key 채우기 (username_key 에 NOT NULL/UNIQUE 를 걸기 전).
+// 대소문자 등만 다른 사용자명이 이미 여럿 있으면 가장 먼저 가입한 계정이 이름을 지키고,
+// 나머지는 "<이름>_<ID>" 로 바꾼다 (로그에 남기므로 운영자가 알려 줄 수 있다).
+func backfillUsernameKeys(ctx context.Context, tx *stdsql.Tx, m *migrator) error {
+	type row struct {
+		id       int64
+		username string
+	}
+	rows, err := tx.QueryContext(ctx, "SELECT id, username FROM users ORDER BY id")
+	if err != nil {
+		return err
+	}
+	var users []row
+	for rows.Next() {
+		var r row
+		if err := rows.Scan(&r.id, &r.username); err != nil {
+			rows.Close()
+			return err
+		}
+		users = append(users, r)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	// 바꾼 이름이 아직 처리하지 않은 계정의 키를 빼앗지 않도록 원래 키를 모두 먼저 모은다.
+	original := make(map[string]bool, len(users))
+	for _, u := range users {
+		original[usernameKey(u.username)] = true
+	}
+	taken := make(map[string]bool, len(users))
+	for _, u := range users {
+		name, key := u.username, usernameKey(u.username)
+		if taken[key] {
+			name = fmt.Sprintf("%s_%d", u.username, u.id)
+			for key = usernameKey(name); taken[key] || original[key]; key = usernameKey(name) {
+				name += "_"
+			}
+			fmt.Printf("username_key: user %d renamed %q -> %q (same key as an older account)\n", u.id, u.username, name)
+		}
+		taken[key] = true
+		if _, err := tx.ExecContext(ctx, "UPDATE users SET username = "+m.arg(1)+", username_key = "+m.arg(2)+" WHERE id = "+m.arg(3),
+			name, key, u.id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 가장 최근에 적용된 마이그레이션부터 limit 개 되돌린다.
+func (m *migrator) down(ctx context.Context, limit int) (int, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return 0, err
+	}
+	byVersion := make(map[string]migration, len(m.migrations))
+	for _, mig := range m.migrations {
+		byVersion[mig.Version] = mig
+	}
+	n := 0
+	for i := len(applied) - 1; i >= 0 && n < limit; i-- {
+		mig, ok := byVersion[applied[i].Version]
+		if !ok {
+			return n, fmt.Errorf("migration %s_%s is applied but its files are missing", applied[i].Version, applied[i].Name)
+		}
+		if err := m.exec(ctx, mig, false); err != nil {
+			return n, err
+		}
+		fmt.Printf("reverted %s_%s\n", mig.Version, mig.Name)
+		n++
+	}
+	return n, nil
+}
+
+// 기존 DB(자동 마이그레이션으로 만든 DB)를 주어진 버전까지 적용된 것으로 기록한다. SQL 은 실행하지 않는다.
+func (m *migrator) baseline(ctx context.Context, version string) error {
+	if err := m.ensureTable(ctx); err != nil {
+		return err
+	}
+	found := false
+	for _, mig := range m.migrations {
+		if mig.Version > version {
+			break
+		}
+		found = found || mig.Version == version
+		if _, err := m.db.ExecContext(ctx, "INSERT INTO "+migrationTable+" (version, name, applied_at) VALUES ("+m.arg(1)+", "+m.arg(2)+", "+m.arg(3)+")",
+			mig.Version, mig.Name, time.Now().UTC()); err != nil {
+			return fmt.Errorf("baseline %s: %w", mig.Version, err)
+		}
+	}
+	if !found {
+		return fmt.Errorf("unknown migration version %q", version)
+	}
+	return nil
+}
+
+// 서버 시작 전 스키마 버전 확인. 적용되지 않은 마이그레이션이 있으면 시작하지 않는다.
+func (m *migrator) checkCurrent(ctx context.Context) error {
+	pending, err := m.pending(ctx)
+	if err != nil {
+		return err
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("DB 스키마가 최신이 아닙니다: 적용되지 않은 마이그레이션 %d개 (첫 번째: %s_%s). `migrate up` 을 먼저 실행하세요",
+			len(pending), pending[0].Version, pending[0].Name)
+	}
+	return nil
+}
+
+func (m *migrator) status(ctx context.Context) error {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return err
+	}
+	at := make(map[string]time.Time, len(applied))
+	for _, a := range applied {
+		at[a.Version] = a.AppliedAt
+	}
+	pending := 0
+	for _, mig := range m.migrations {
+		if t, ok := at[mig.Version]; ok {
+			fmt.Printf("  applied  %s_%s (%s)\n", mig.Version, mig.Name, t.Format(time.RFC3339))
+			delete(at, mig.Version)
+		} else {
+			fmt.Printf("  pending  %s_%s\n", mig.Version, mig.Name)
+			pending++
+		}
+	}
+	for version := range at {
+		fmt.Printf("  unknown  %s (applied, but no migration file)\n", version)
+	}
+	fmt.Printf("driver: %s, migrations: %d, pending: %d\n", m.driver, len(m.migrations), pending)
+	return nil
+}
+
+// ent 스키마와 마이그레이션 디렉토리를 비교해 새 마이그레이션 파일을 만든다.
+// 기존 파일을 개발용 DB(devDSN)에 재생한 결과와 ent 스키마의 차이를 계산한다.
+func generateMigration(ctx context.Context, driver, dirPath, devDSN, name string) error {
+	if devDSN == "" {
+		if driver != dialect.SQLite {
+			return errors.New("-dev-dsn is required for postgres (an empty scratch database)")
+		}
+		devDSN = "file:atlas_dev?mode=memory&cache=shared"
+	}
+	dir, err := sqltool.NewGolangMigrateDir(dirPath)
+	if err != nil {
+		return err
+	}
+	client, err := openDB(DBConfig{Driver: driver, DSN: devDSN})
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	return client.Schema.NamedDiff(ctx, name,
+		schema.WithDir(dir),
+		schema.WithMigrationMode(schema.ModeReplay),
+		schema.WithDialect(driver),
+		schema.WithFormatter(sqltool.GolangMigrateFormatter),
+	)
+}
+
+// 마이그레이션 디렉토리의 atlas.sum 재계산 (파일을 직접 편집한 뒤 사용)
+func rehashMigrations(dirPath string) error {
+	dir, err := migrate.NewLocalDir(dirPath)
+	if err != nil {
+		return err
+	}
+	sum, err := dir.Checksum()
+	if err != nil {
+		return err
+	}
+	return migrate.WriteSumFile(dir, sum)
+}
+
+const migrateUsage = `usage: server [flags] migrate <command>
+
+commands:
+  up [N]             적용되지 않은 마이그레이션 적용 (N 생략 시 전부)
+  down [N]           최근 마이그레이션 N개 되돌리기 (기본 1)
+  status             적용 상태 출력
+  baseline VERSION   기존 DB 를 VERSION 까지 적용된 것으로 기록 (SQL 실행 안 함)
+  diff NAME          ent 스키마 변경분으로 새 마이그레이션 파일 생성
+  hash               atlas.sum 재계산
+
+flags (migrate 뒤에 지정):
+`
+
+// migrate 하위 명령 실행. 프로세스 종료 코드를 반환한다.
+func runMigrateCommand(cfg Config, args []string) int {
+	fset := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	dirRoot := fset.String("dir", filepath.Join("game_server", "migrations"), "마이그레이션 소스 디렉토리 (diff, hash 용)")
+	devDSN := fset.String("dev-dsn", "", "diff 에 사용할 빈 개발용 DB 접속 문자열 (sqlite 는 생략 시 메모리 DB)")
+	fset.Usage = func() {
+		fmt.Fprint(fset.Output(), migrateUsage)
+		fset.PrintDefaults()
+	}
+	if len(args) == 0 {
+		fset.Usage()
+		return 2
+	}
+	cmd := args[0]
+	if err := fset.Parse(args[1:]); err != nil {
+		return 2
+	}
+	countArg := func(def int) (int, error) {
+		if fset.NArg() == 0 {
+			return def, nil
+		}
+		return strconv.Atoi(fset.Arg(0))
+	}
+
+	ctx := context.Background()
+	dirPath := filepath.Join(*dirRoot, cfg.DB.Driver)
+	var err error
+	switch cmd {
+	case "diff":
+		if fset.NArg() != 1 {
+			fset.Usage()
+			return 2
+		}
+		err = generateMigration(ctx, cfg.DB.Driver, dirPath, *devDSN, fset.Arg(0))
+	case "hash":
+		err = rehashMigrations(dirPath)
+	case "up", "down", "status", "baseline":
+		var client *stdsql.DB
+		client, err = openSQL(cfg.DB)
+		if err != nil {
+			break
+		}
+		defer client.Close()
+		var m *migrator
+		if m, err = newMigrator(client, cfg.DB.Driver); err != nil {
+			break
+		}
+		switch cmd {
+		case "up":
+			var n int
+			if n, err = countArg(0); err == nil {
+				n, err = m.up(ctx, n)
+				fmt.Printf("%d migration(s) applied\n", n)
+			}
+		case "down":
+			var n int
+			if n, err = countArg(1); err == nil {
+				n, err = m.down(ctx, n)
+				fmt.Printf("%d migration(s) reverted\n", n)
+			}
+		case "status":
+			err = m.status(ctx)
+		case "baseline":
+			if fset.NArg() != 1 {
+				fset.Usage()
+				return 2
+			}
+			err = m.baseline(ctx, fset.Arg(0))
+		}
+	default:
+		fset.Usage()
+		return 2
+	}
+	if err != nil {
+		fmt.Printf("migrate %s: %v\n", cmd, err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/SilverSS/gameserver/ent"
+	"github.com/SilverSS/gameserver/ent/user"
+)
+
+func openTestMigrator(t *testing.T) (*migrator, *stdsql.DB) {
+	t.Helper()
+	db, err := openSQL(DBConfig{Driver: dialect.SQLite, DSN: "file:" + t.Name() + "?mode=memory&cache=shared"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	m, err := newMigrator(db, dialect.SQLite)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(m.migrations) < 2 {
+		t.Fatalf("got %d migrations, want at least 2", len(m.migrations))
+	}
+	return m, db
+}
+
+func tableExists(t *testing.T, db *stdsql.DB, name string) bool {
+	t.Helper()
+	var n int
+	if err := db.QueryRow("SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?", name).Scan(&n); err != nil {
+		t.Fatal(err)
+	}
+	return n > 0
+}
+
+func TestLoadMigrations(t *testing.T) {
+	for _, driver := range []string{dialect.SQLite, dialect.Postgres} {
+		migrations, err := loadMigrations(driver)
+		if err != nil {
+			t.Fatalf("%s: %v", driver, err)
+		}
+		for i, mig := range migrations {
+			if mig.Down == "" {
+				t.Errorf("%s: %s_%s has no down file", driver, mig.Version, mig.Name)
+			}
+			if i > 0 && migrations[i-1].Version >= mig.Version {
+				t.Errorf("%s: migrations out of order at %s", driver, mig.Version)
+			}
+		}
+	}
+}
+
+func TestMigrateUpDown(t *testing.T) {
+	m, db := openTestMigrator(t)
+	ctx := context.Background()
+	total := len(m.migrations)
+
+	if err := m.checkCurrent(ctx); err == nil {
+		t.Error("checkCurrent on an empty DB succeeded")
+	}
+	if n, err := m.up(ctx, 1); err != nil || n != 1 {
+		t.Fatalf("up 1 = %d, %v", n, err)
+	}
+	if pending, _ := m.pending(ctx); len(pending) != total-1 {
+		t.Errorf("pending after up 1 = %d, want %d", len(pending), total-1)
+	}
+	if n, err := m.up(ctx, 0); err != nil || n != total-1 {
+		t.Fatalf("up = %d, %v; want %d", n, err, total-1)
+	}
+	if err := m.checkCurrent(ctx); err != nil {
+		t.Errorf("checkCurrent after up: %v", err)
+	}
+	if n, _ := m.up(ctx, 0); n != 0 {
+		t.Errorf("second up applied %d migrations", n)
+	}
+	if err := m.status(ctx); err != nil {
+		t.Error(err)
+	}
+
+	if n, err := m.down(ctx, 1); err != nil || n != 1 {
+		t.Fatalf("down 1 = %d, %v", n, err)
+	}
+	pending, _ := m.pending(ctx)
+	if len(pending) != 1 || pending[0].Version != m.migrations[total-1].Version {
+		t.Errorf("pending after down 1 = %v, want the latest migration", pending)
+	}
+	if n, err := m.down(ctx, total); err != nil || n != total-1 {
+		t.Fatalf("down all = %d, %v; want %d", n, err, total-1)
+	}
+	if tableExists(t, db, "users") {
+		t.Error("users table left after reverting every migration")
+	}
+	if applied, _ := m.applied(ctx); len(applied) != 0 {
+		t.Errorf("applied after down all = %v", applied)
+	}
+}
+
+// 마이그레이션으로 만든 스키마에서 ent 가 그대로 동작하는지 (ent 스키마와 마이그레이션 파일이 어긋나지 않았는지)
+func TestMigratedSchemaMatchesEnt(t *testing.T) {
+	m, db := openTestMigrator(t)
+	ctx := context.Background()
+	if _, err := m.up(ctx, 0); err != nil {
+		t.Fatal(err)
+	}
+	client := ent.NewClient(ent.Driver(sql.OpenDB(dialect.SQLite, db)))
+
+	if _, err := createAccount(ctx, client, "Alice", "hash"); err != nil {
+		t.Fatal(err)
+	}
+	u, err := findUser(ctx, client, "ALICE")
+	if err != nil || u.Username != "Alice" {
+		t.Fatalf("findUser = %v, %v", u, err)
+	}
+	if _, err := u.QueryCharacters().Only(ctx); err != nil {
+		t.Errorf("default character: %v", err)
+	}
+}
+
+func TestMigrateBaseline(t *testing.T) {
+	m, _ := openTestMigrator(t)
+	ctx := context.Background()
+	first := m.migrations[0].Version
+
+	if err := m.baseline(ctx, "1"); err == nil {
+		t.Error("baseline to an unknown version succeeded")
+	}
+	if err := m.baseline(ctx, first); err != nil {
+		t.Fatal(err)
+	}
+	pending, _ := m.pending(ctx)
+	if len(pending) != len(m.migrations)-1 || pending[0].Version == first {
+		t.Errorf("pending after baseline = %d, want every migration after %s", len(pending), first)
+	}
+}
+
+// 버전 관리 이전 DB (users 테이블만 있고 대소문자만 다른 사용자명이 섞인 DB) 업그레이드
+func TestMigrateUpgradesPreVersionedDB(t *testing.T) {
+	m, db := openTestMigrator(t)
+	ctx := context.Background()
+	if _, err := m.up(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"Alice", "alice", "Bob", "alice_2", "ＡＬＩＣＥ"} {
+		if _, err := db.Exec("INSERT INTO users (username, password_hash, created_at) VALUES (?, 'hash', CURRENT_TIMESTAMP)", name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := m.up(ctx, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	client := ent.NewClient(ent.Driver(sql.OpenDB(dialect.SQLite, db)))
+	users := client.User.Query().Order(ent.Asc(user.FieldID)).AllX(ctx)
+	want := []string{"Alice", "alice_2_", "Bob", "alice_2", "ＡＬＩＣＥ_5"}
+	if len(users) != len(want) {
+		t.Fatalf("got %d users, want %d", len(users), len(want))
+	}
+	for i, u := range users {
+		if u.Username != want[i] || u.UsernameKey != usernameKey(want[i]) {
+			t.Errorf("user %d = %q (key %q), want %q", u.ID, u.Username, u.UsernameKey, want[i])
+		}
+		if n := client.User.QueryCharacters(u).CountX(ctx); n != 1 {
+			t.Errorf("user %q has %d characters, want a default one", u.Username, n)
+		}
+	}
+	if _, err := createAccount(ctx, client, "BOB", "hash"); !errors.Is(err, errUsernameTaken) {
+		t.Errorf("register BOB after upgrade: %v, want errUsernameTaken", err)
+	}
+
+	// 되돌리면 다시 username_key 가 없는 스키마가 된다
+	if _, err := m.down(ctx, len(m.migrations)-1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec("INSERT INTO users (username, password_hash, created_at) VALUES ('carol', 'hash', CURRENT_TIMESTAMP)"); err != nil {
+		t.Errorf("insert into the reverted users table: %v", err)
+	}
+}
@@ -0,0 +1,4 @@
+-- reverse: create index "users_username_key" to table: "users"
+DROP INDEX "users_username_key";
+-- reverse: create "users" table
+DROP TABLE "users";
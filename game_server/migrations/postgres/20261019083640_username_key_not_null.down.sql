@@ -0,0 +1,4 @@
+-- reverse: create index "users_username_key_key" to table: "users"
+DROP INDEX "users_username_key_key";
+-- reverse: modify "users" table
+ALTER TABLE "users" ALTER COLUMN "username_key" DROP NOT NULL;
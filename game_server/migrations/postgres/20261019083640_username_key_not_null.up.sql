@@ -0,0 +1,4 @@
+-- modify "users" table
+ALTER TABLE "users" ALTER COLUMN "username_key" SET NOT NULL;
+-- create index "users_username_key_key" to table: "users"
+CREATE UNIQUE INDEX "users_username_key_key" ON "users" ("username_key");
@@ -0,0 +1,4 @@
+-- reverse: create index "characters_name_key" to table: "characters"
+DROP INDEX "characters_name_key";
+-- reverse: create "characters" table
+DROP TABLE "characters";
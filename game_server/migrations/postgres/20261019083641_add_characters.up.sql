@@ -0,0 +1,6 @@
+-- create "characters" table
+CREATE TABLE "characters" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "name" character varying NOT NULL, "level" bigint NOT NULL DEFAULT 1, "created_at" timestamptz NOT NULL, "user_characters" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "characters_users_characters" FOREIGN KEY ("user_characters") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- create index "characters_name_key" to table: "characters"
+CREATE UNIQUE INDEX "characters_name_key" ON "characters" ("name");
+-- 기존 계정마다 기본 캐릭터 생성 (새 계정은 가입할 때 createAccount 가 만든다)
+INSERT INTO "characters" ("name", "level", "created_at", "user_characters") SELECT "username", 1, "created_at", "id" FROM "users";
@@ -0,0 +1,2 @@
+-- reverse: modify "characters" table
+ALTER TABLE "characters" DROP COLUMN "rated_games", DROP COLUMN "rating";
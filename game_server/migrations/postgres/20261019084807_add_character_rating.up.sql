@@ -0,0 +1,2 @@
+-- modify "characters" table
+ALTER TABLE "characters" ADD COLUMN "rating" bigint NOT NULL DEFAULT 1200, ADD COLUMN "rated_games" bigint NOT NULL DEFAULT 0;
@@ -0,0 +1,6 @@
+-- reverse: create index "chatmessage_recipient_delivered" to table: "chat_messages"
+DROP INDEX "chatmessage_recipient_delivered";
+-- reverse: create index "chatmessage_channel_zone_id_created_at" to table: "chat_messages"
+DROP INDEX "chatmessage_channel_zone_id_created_at";
+-- reverse: create "chat_messages" table
+DROP TABLE "chat_messages";
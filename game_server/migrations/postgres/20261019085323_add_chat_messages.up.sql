@@ -0,0 +1,6 @@
+-- create "chat_messages" table
+CREATE TABLE "chat_messages" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "channel" character varying NOT NULL, "zone_id" character varying NOT NULL DEFAULT '', "sender" character varying NOT NULL, "recipient" character varying NOT NULL DEFAULT '', "text" character varying NOT NULL, "delivered" boolean NOT NULL DEFAULT true, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "chatmessage_channel_zone_id_created_at" to table: "chat_messages"
+CREATE INDEX "chatmessage_channel_zone_id_created_at" ON "chat_messages" ("channel", "zone_id", "created_at");
+-- create index "chatmessage_recipient_delivered" to table: "chat_messages"
+CREATE INDEX "chatmessage_recipient_delivered" ON "chat_messages" ("recipient", "delivered");
@@ -0,0 +1,6 @@
+-- reverse: create index "friendship_friend_id_status" to table: "friendships"
+DROP INDEX "friendship_friend_id_status";
+-- reverse: create index "friendship_user_id_friend_id" to table: "friendships"
+DROP INDEX "friendship_user_id_friend_id";
+-- reverse: create "friendships" table
+DROP TABLE "friendships";
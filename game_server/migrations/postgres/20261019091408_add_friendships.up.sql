@@ -0,0 +1,6 @@
+-- create "friendships" table
+CREATE TABLE "friendships" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "status" character varying NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "friend_id" bigint NOT NULL, "user_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "friendships_users_friend" FOREIGN KEY ("friend_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION, CONSTRAINT "friendships_users_friendships" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- create index "friendship_user_id_friend_id" to table: "friendships"
+CREATE UNIQUE INDEX "friendship_user_id_friend_id" ON "friendships" ("user_id", "friend_id");
+-- create index "friendship_friend_id_status" to table: "friendships"
+CREATE INDEX "friendship_friend_id_status" ON "friendships" ("friend_id", "status");
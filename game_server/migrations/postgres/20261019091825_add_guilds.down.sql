@@ -0,0 +1,10 @@
+-- reverse: create index "guild_members_user_id_key" to table: "guild_members"
+DROP INDEX "guild_members_user_id_key";
+-- reverse: create "guild_members" table
+DROP TABLE "guild_members";
+-- reverse: create index "guilds_name_key_key" to table: "guilds"
+DROP INDEX "guilds_name_key_key";
+-- reverse: create index "guilds_name_key" to table: "guilds"
+DROP INDEX "guilds_name_key";
+-- reverse: create "guilds" table
+DROP TABLE "guilds";
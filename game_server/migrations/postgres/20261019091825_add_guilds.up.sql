@@ -0,0 +1,10 @@
+-- create "guilds" table
+CREATE TABLE "guilds" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "name" character varying NOT NULL, "name_key" character varying NOT NULL, "motd" character varying NOT NULL DEFAULT '', "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "guilds_name_key" to table: "guilds"
+CREATE UNIQUE INDEX "guilds_name_key" ON "guilds" ("name");
+-- create index "guilds_name_key_key" to table: "guilds"
+CREATE UNIQUE INDEX "guilds_name_key_key" ON "guilds" ("name_key");
+-- create "guild_members" table
+CREATE TABLE "guild_members" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "rank" character varying NOT NULL DEFAULT 'member', "joined_at" timestamptz NOT NULL, "guild_id" bigint NOT NULL, "user_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "guild_members_guilds_members" FOREIGN KEY ("guild_id") REFERENCES "guilds" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION, CONSTRAINT "guild_members_users_guild_membership" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- create index "guild_members_user_id_key" to table: "guild_members"
+CREATE UNIQUE INDEX "guild_members_user_id_key" ON "guild_members" ("user_id");
@@ -0,0 +1,2 @@
+-- reverse: add "username_key" column to table: "users"
+ALTER TABLE `users` DROP COLUMN `username_key`;
@@ -0,0 +1,2 @@
+-- add "username_key" column to table: "users" (비워 둔 채로 추가하고, 값은 마이그레이션 코드가 채운다)
+ALTER TABLE `users` ADD COLUMN `username_key` text NULL;
@@ -0,0 +1,16 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- create "new_users" table
+CREATE TABLE `new_users` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `username` text NOT NULL, `password_hash` text NOT NULL, `created_at` datetime NOT NULL, `username_key` text NOT NULL);
+-- copy rows from old table "users" to new temporary table "new_users"
+INSERT INTO `new_users` (`id`, `username`, `password_hash`, `created_at`, `username_key`) SELECT `id`, `username`, `password_hash`, `created_at`, `username_key` FROM `users`;
+-- drop "users" table after copying rows
+DROP TABLE `users`;
+-- rename temporary table "new_users" to "users"
+ALTER TABLE `new_users` RENAME TO `users`;
+-- create index "users_username_key" to table: "users"
+CREATE UNIQUE INDEX `users_username_key` ON `users` (`username`);
+-- create index "users_username_key_key" to table: "users"
+CREATE UNIQUE INDEX `users_username_key_key` ON `users` (`username_key`);
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
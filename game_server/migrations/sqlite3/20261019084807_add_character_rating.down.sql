@@ -0,0 +1,3 @@
+-- reverse: add "rating", "rated_games" columns to table: "characters"
+ALTER TABLE `characters` DROP COLUMN `rated_games`;
+ALTER TABLE `characters` DROP COLUMN `rating`;
@@ -0,0 +1,14 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- create "new_characters" table
+CREATE TABLE `new_characters` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `name` text NOT NULL, `level` integer NOT NULL DEFAULT (1), `rating` integer NOT NULL DEFAULT (1200), `rated_games` integer NOT NULL DEFAULT (0), `created_at` datetime NOT NULL, `user_characters` integer NOT NULL, CONSTRAINT `characters_users_characters` FOREIGN KEY (`user_characters`) REFERENCES `users` (`id`) ON DELETE NO ACTION);
+-- copy rows from old table "characters" to new temporary table "new_characters"
+INSERT INTO `new_characters` (`id`, `name`, `level`, `created_at`, `user_characters`) SELECT `id`, `name`, `level`, `created_at`, `user_characters` FROM `characters`;
+-- drop "characters" table after copying rows
+DROP TABLE `characters`;
+-- rename temporary table "new_characters" to "characters"
+ALTER TABLE `new_characters` RENAME TO `characters`;
+-- create index "characters_name_key" to table: "characters"
+CREATE UNIQUE INDEX `characters_name_key` ON `characters` (`name`);
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,6 @@
+-- create "chat_messages" table
+CREATE TABLE `chat_messages` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `channel` text NOT NULL, `zone_id` text NOT NULL DEFAULT (''), `sender` text NOT NULL, `recipient` text NOT NULL DEFAULT (''), `text` text NOT NULL, `delivered` bool NOT NULL DEFAULT (true), `created_at` datetime NOT NULL);
+-- create index "chatmessage_channel_zone_id_created_at" to table: "chat_messages"
+CREATE INDEX `chatmessage_channel_zone_id_created_at` ON `chat_messages` (`channel`, `zone_id`, `created_at`);
+-- create index "chatmessage_recipient_delivered" to table: "chat_messages"
+CREATE INDEX `chatmessage_recipient_delivered` ON `chat_messages` (`recipient`, `delivered`);
@@ -0,0 +1,6 @@
+-- create "friendships" table
+CREATE TABLE `friendships` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `status` text NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `friend_id` integer NOT NULL, `user_id` integer NOT NULL, CONSTRAINT `friendships_users_friend` FOREIGN KEY (`friend_id`) REFERENCES `users` (`id`) ON DELETE NO ACTION, CONSTRAINT `friendships_users_friendships` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE NO ACTION);
+-- create index "friendship_user_id_friend_id" to table: "friendships"
+CREATE UNIQUE INDEX `friendship_user_id_friend_id` ON `friendships` (`user_id`, `friend_id`);
+-- create index "friendship_friend_id_status" to table: "friendships"
+CREATE INDEX `friendship_friend_id_status` ON `friendships` (`friend_id`, `status`);
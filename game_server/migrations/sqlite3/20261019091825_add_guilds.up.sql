@@ -0,0 +1,10 @@
+-- create "guilds" table
+CREATE TABLE `guilds` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `name` text NOT NULL, `name_key` text NOT NULL, `motd` text NOT NULL DEFAULT (''), `created_at` datetime NOT NULL);
+-- create index "guilds_name_key" to table: "guilds"
+CREATE UNIQUE INDEX `guilds_name_key` ON `guilds` (`name`);
+-- create index "guilds_name_key_key" to table: "guilds"
+CREATE UNIQUE INDEX `guilds_name_key_key` ON `guilds` (`name_key`);
+-- create "guild_members" table
+CREATE TABLE `guild_members` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `rank` text NOT NULL DEFAULT ('member'), `joined_at` datetime NOT NULL, `guild_id` integer NOT NULL, `user_id` integer NOT NULL, CONSTRAINT `guild_members_guilds_members` FOREIGN KEY (`guild_id`) REFERENCES `guilds` (`id`) ON DELETE NO ACTION, CONSTRAINT `guild_members_users_guild_membership` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE NO ACTION);
+-- create index "guild_members_user_id_key" to table: "guild_members"
+CREATE UNIQUE INDEX `guild_members_user_id_key` ON `guild_members` (`user_id`);
@@ -0,0 +1,250 @@
+package main
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+
+	"github.com/SilverSS/gameserver/types"
+)
+
+// 한 번의 길찾기에서 펼쳐 볼 최대 셀 수 (갈 수 없는 곳을 찾느라 격자 전체를 뒤지지 않도록)
+const navMaxExpanded = 20000
+
+// 목표 셀이 막혀 있을 때 대신 갈 셀을 찾는 최대 거리 (셀 단위)
+const navSnapRadius = 3
+
+// 길찾기 격자 (data/nav/*.json, Unity 에디터의 Tools/Nav Grid Exporter 로 내보낸 파일)
+// 셀 (x, z) 는 X/Z 평면에서 origin + (x*cellSize, z*cellSize) 부터 cellSize 크기의 정사각형이다.
+// rows[z] 의 x 번째 글자가 '#' 이면 막힌 셀, '.' 이면 걸을 수 있는 셀이고, 격자 밖은 모두 막혀 있다.
+type navGrid struct {
+	Origin   types.Vector `json:"origin"`
+	CellSize float32      `json:"cellSize"`
+	Rows     []string     `json:"rows"`
+
+	width, height int
+	blocked       []bool
+}
+
+func loadNavGrid(name string) (*navGrid, error) {
+	var g navGrid
+	if err := readDataJSON(name, &g); err != nil {
+		return nil, err
+	}
+	if g.CellSize <= 0 || len(g.Rows) == 0 || len(g.Rows[0]) == 0 {
+		return nil, fmt.Errorf("%s: cellSize and rows required", name)
+	}
+	g.width, g.height = len(g.Rows[0]), len(g.Rows)
+	g.blocked = make([]bool, g.width*g.height)
+	for z, row := range g.Rows {
+		if len(row) != g.width {
+			return nil, fmt.Errorf("%s: row %d has %d cells, want %d", name, z, len(row), g.width)
+		}
+		for x, ch := range row {
+			switch ch {
+			case '.':
+			case '#':
+				g.blocked[z*g.width+x] = true
+			default:
+				return nil, fmt.Errorf("%s: row %d: unknown cell %q", name, z, ch)
+			}
+		}
+	}
+	return &g, nil
+}
+
+type navCell struct {
+	x, z int
+}
+
+func (g *navGrid) cellOf(v types.Vector) navCell {
+	return navCell{
+		x: int(math.Floor(float64((v.X - g.Origin.X) / g.CellSize))),
+		z: int(math.Floor(float64((v.Z - g.Origin.Z) / g.CellSize))),
+	}
+}
+
+// 셀 중심 (높이는 y)
+func (g *navGrid) center(c navCell, y float32) types.Vector {
+	return types.Vector{
+		X: g.Origin.X + (float32(c.x)+0.5)*g.CellSize,
+		Y: y,
+		Z: g.Origin.Z + (float32(c.z)+0.5)*g.CellSize,
+	}
+}
+
+func (g *navGrid) walkable(c navCell) bool {
+	if c.x < 0 || c.z < 0 || c.x >= g.width || c.z >= g.height {
+		return false
+	}
+	return !g.blocked[c.z*g.width+c.x]
+}
+
+// c 에서 가장 가까운 걸을 수 있는 셀 (radius 셀 안에서, 없으면 false)
+func (g *navGrid) nearestWalkable(c navCell, radius int) (navCell, bool) {
+	if g.walkable(c) {
+		return c, true
+	}
+	for r := 1; r <= radius; r++ {
+		best, found := navCell{}, false
+		bestDist := 0
+		for dz := -r; dz <= r; dz++ {
+			for dx := -r; dx <= r; dx++ {
+				if max(abs(dx), abs(dz)) != r {
+					continue
+				}
+				n := navCell{c.x + dx, c.z + dz}
+				if d := dx*dx + dz*dz; g.walkable(n) && (!found || d < bestDist) {
+					best, bestDist, found = n, d, true
+				}
+			}
+		}
+		if found {
+			return best, true
+		}
+	}
+	return c, false
+}
+
+// from 에서 to 까지 A* 로 길을 찾아 경유지 목록을 돌려준다 (마지막이 도착 위치).
+// 목표 셀이 막혀 있으면 가장 가까운 걸을 수 있는 셀의 중심으로 간다. 출발 셀은 막혀 있어도 된다.
+func (g *navGrid) findPath(from, to types.Vector) ([]types.Vector, bool) {
+	start := g.cellOf(from)
+	goal, ok := g.nearestWalkable(g.cellOf(to), navSnapRadius)
+	if !ok {
+		return nil, false
+	}
+	if goal != g.cellOf(to) {
+		to = g.center(goal, to.Y)
+	}
+	if start == goal || g.clearLine(from, to) {
+		return []types.Vector{to}, true
+	}
+	cells, ok := g.search(start, goal)
+	if !ok {
+		return nil, false
+	}
+	// 중간 경유지는 셀 중심, 마지막은 요청한 위치
+	points := make([]types.Vector, len(cells))
+	for i, c := range cells {
+		points[i] = g.center(c, to.Y)
+	}
+	points[len(points)-1] = to
+	return g.smooth(from, points), true
+}
+
+// A* (8방향, 막힌 셀의 모서리를 가로질러 대각선으로 가지 않음). 출발 셀을 뺀 셀 목록을 돌려준다.
+func (g *navGrid) search(start, goal navCell) ([]navCell, bool) {
+	cost := map[navCell]float32{start: 0}
+	prev := make(map[navCell]navCell)
+	closed := make(map[navCell]bool)
+	open := &navQueue{}
+	heap.Push(open, &navNode{cell: start, f: octile(start, goal)})
+	for open.Len() > 0 && len(closed) < navMaxExpanded {
+		cur := heap.Pop(open).(*navNode).cell
+		if closed[cur] {
+			continue
+		}
+		if cur == goal {
+			var cells []navCell
+			for c := goal; c != start; c = prev[c] {
+				cells = append(cells, c)
+			}
+			for i, j := 0, len(cells)-1; i < j; i, j = i+1, j-1 {
+				cells[i], cells[j] = cells[j], cells[i]
+			}
+			return cells, true
+		}
+		closed[cur] = true
+		for dz := -1; dz <= 1; dz++ {
+			for dx := -1; dx <= 1; dx++ {
+				n := navCell{cur.x + dx, cur.z + dz}
+				if (dx == 0 && dz == 0) || closed[n] || !g.walkable(n) {
+					continue
+				}
+				if dx != 0 && dz != 0 && (!g.walkable(navCell{cur.x + dx, cur.z}) || !g.walkable(navCell{cur.x, cur.z + dz})) {
+					continue
+				}
+				step := float32(1)
+				if dx != 0 && dz != 0 {
+					step = math.Sqrt2
+				}
+				c := cost[cur] + step
+				if old, seen := cost[n]; seen && old <= c {
+					continue
+				}
+				cost[n] = c
+				prev[n] = cur
+				heap.Push(open, &navNode{cell: n, f: c + octile(n, goal)})
+			}
+		}
+	}
+	return nil, false
+}
+
+// 8방향 이동의 최단 거리 (셀 단위)
+func octile(a, b navCell) float32 {
+	dx, dz := abs(a.x-b.x), abs(a.z-b.z)
+	return float32(max(dx, dz)) + (math.Sqrt2-1)*float32(min(dx, dz))
+}
+
+// 곧게 갈 수 있는 경유지를 건너뛴다 (from 에서 시작해 가장 멀리 보이는 경유지로)
+func (g *navGrid) smooth(from types.Vector, points []types.Vector) []types.Vector {
+	var out []types.Vector
+	cur := from
+	for i := 0; i < len(points); {
+		next := i
+		for j := len(points) - 1; j > i; j-- {
+			if g.clearLine(cur, points[j]) {
+				next = j
+				break
+			}
+		}
+		out = append(out, points[next])
+		cur = points[next]
+		i = next + 1
+	}
+	return out
+}
+
+// a 에서 b 까지 직선이 막힌 셀을 지나지 않는지 (X/Z 평면, 셀 크기의 1/4 간격으로 검사)
+// 출발 셀은 막혀 있어도 된다.
+func (g *navGrid) clearLine(a, b types.Vector) bool {
+	start := g.cellOf(a)
+	d := types.Vector{X: b.X - a.X, Z: b.Z - a.Z}
+	n := int(length(d)/(g.CellSize/4)) + 1
+	for i := 1; i <= n; i++ {
+		t := float32(i) / float32(n)
+		c := g.cellOf(types.Vector{X: a.X + d.X*t, Z: a.Z + d.Z*t})
+		if c != start && !g.walkable(c) {
+			return false
+		}
+	}
+	return true
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// A* 열린 목록 (f 가 작은 순)
+type navNode struct {
+	cell navCell
+	f    float32
+}
+
+type navQueue []*navNode
+
+func (q navQueue) Len() int            { return len(q) }
+func (q navQueue) Less(i, j int) bool  { return q[i].f < q[j].f }
+func (q navQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *navQueue) Push(x interface{}) { *q = append(*q, x.(*navNode)) }
+func (q *navQueue) Pop() interface{} {
+	old := *q
+	n := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return n
+}
@@ -0,0 +1,359 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/SilverSS/gameserver/types"
+	"github.com/anthdm/hollywood/actor"
+)
+
+// NPC AI 상태 (npcState.ai)
+const (
+	npcIdle   = "idle"   // 제자리 대기 (순찰 지점 사이에서 쉬는 중이기도 함)
+	npcPatrol = "patrol" // 다음 순찰 지점으로 이동 중
+	npcChase  = "chase"  // 위협 수치가 가장 높은 대상을 추격
+	npcAttack = "attack" // 사거리 안에서 기본 공격
+	npcReturn = "return" // 추격을 포기하고 스폰 지점으로 돌아가는 중 (피해를 받지 않음)
+)
+
+// 죽은 NPC 가 시체로 남아 있는 시간
+const npcCorpseTime = 3 * time.Second
+
+// 시야에 들어와 먼저 공격한 대상의 처음 위협 수치
+const npcProximityThreat = 1
+
+// NPC 정의 (data/npcs.json)
+type npcDef struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	Hostile      bool      `json:"hostile"` // false 면 공격하지도 공격받지도 않는 NPC (경비병, 상인 등)
+	Stats        statBase  `json:"stats"`
+	Attack       attackDef `json:"attack"`       // hostile 일 때만
+	AggroRadius  float32   `json:"aggroRadius"`  // 이 거리 안에 들어온 플레이어를 먼저 공격 (0 이면 맞아야 반격)
+	LeashRadius  float32   `json:"leashRadius"`  // 스폰 지점에서 이만큼 멀어지면 추격을 포기
+	PatrolWaitMs int       `json:"patrolWaitMs"` // 순찰 지점마다 머무는 시간
+}
+
+func (d *npcDef) patrolWait() time.Duration {
+	return time.Duration(d.PatrolWaitMs) * time.Millisecond
+}
+
+func (d *npcDef) validate() error {
+	if err := d.Stats.validate(); err != nil {
+		return fmt.Errorf("npc %s: %w", d.ID, err)
+	}
+	if d.PatrolWaitMs < 0 {
+		return fmt.Errorf("npc %s: patrolWaitMs must not be negative", d.ID)
+	}
+	if !d.Hostile {
+		return nil
+	}
+	if err := d.Attack.validate(); err != nil {
+		return fmt.Errorf("npc %s: %w", d.ID, err)
+	}
+	if d.AggroRadius < 0 || d.LeashRadius <= d.AggroRadius {
+		return fmt.Errorf("npc %s: leashRadius must be greater than aggroRadius", d.ID)
+	}
+	return nil
+}
+
+// NPC 정의 전체 로드
+func loadNPCDefs() (map[string]*npcDef, error) {
+	var list []*npcDef
+	if err := readDataJSON("npcs.json", &list); err != nil {
+		return nil, err
+	}
+	npcs := make(map[string]*npcDef, len(list))
+	for _, d := range list {
+		if d.ID == "" {
+			return nil, fmt.Errorf("npcs.json: missing id")
+		}
+		if _, dup := npcs[d.ID]; dup {
+			return nil, fmt.Errorf("npcs.json: duplicate npc %q", d.ID)
+		}
+		if err := d.validate(); err != nil {
+			return nil, fmt.Errorf("npcs.json: %w", err)
+		}
+		npcs[d.ID] = d
+	}
+	return npcs, nil
+}
+
+// 존 정의 안의 NPC 스폰 지점. 죽으면 RespawnMs 뒤 같은 자리에 새 엔티티로 나타난다.
+type npcSpawnDef struct {
+	NPC       string         `json:"npc"`
+	Position  types.Vector   `json:"position"`
+	RespawnMs int            `json:"respawnMs"`
+	Patrol    []types.Vector `json:"patrol"` // 순서대로 돌며 순찰할 지점 (없으면 제자리에서 대기)
+}
+
+func (s *npcSpawnDef) respawnDelay() time.Duration {
+	return time.Duration(s.RespawnMs) * time.Millisecond
+}
+
+func (s *npcSpawnDef) validate(npcs map[string]*npcDef) error {
+	if _, ok := npcs[s.NPC]; !ok {
+		return fmt.Errorf("unknown npc %q", s.NPC)
+	}
+	if s.RespawnMs < 0 {
+		return fmt.Errorf("respawnMs must not be negative")
+	}
+	return nil
+}
+
+// 존마다 스폰 지점의 현재 NPC 와 다음 스폰 시각
+type npcSpawner struct {
+	def     *npcDef
+	spawn   *npcSpawnDef
+	entity  *entity   // 살아 있거나 시체로 남은 NPC (없으면 nil)
+	spawnAt time.Time // entity 가 nil 일 때 다음 스폰 시각
+}
+
+// NPC 엔티티의 정의와 AI 상태
+type npcState struct {
+	def       *npcDef
+	home      types.Vector
+	patrol    []types.Vector
+	ai        string
+	aggro     map[int64]int // 엔티티 ID -> 위협 수치 (준 피해만큼 오름)
+	next      int           // 다음 순찰 지점
+	waitUntil time.Time     // idle: 다음 순찰을 시작할 시각
+	chaseCell navCell       // chase: 따라가는 길을 찾을 때 대상이 있던 칸
+	chaseEnd  types.Vector  // chase: 그 길의 끝 (다른 이동으로 바뀌었는지 확인)
+}
+
+// 돌아가는 중이라 피해를 받지 않는지
+func (e *entity) evading() bool {
+	return e.npc != nil && e.npc.ai == npcReturn
+}
+
+func (z *Zone) initSpawners() {
+	for i := range z.def.NPCs {
+		s := &z.def.NPCs[i]
+		z.spawners = append(z.spawners, &npcSpawner{def: z.rules.npcs[s.NPC], spawn: s})
+	}
+}
+
+// 시체를 치우고 스폰 시각이 된 지점에 NPC 를 만든다.
+func (z *Zone) updateSpawners(now time.Time) {
+	for _, s := range z.spawners {
+		if e := s.entity; e != nil {
+			// 죽은 NPC 는 더 피해를 받지 않으므로 마지막 피해 시각이 곧 사망 시각이다
+			if !e.dead() || now.Sub(e.combat.lastDamaged) < npcCorpseTime {
+				continue
+			}
+			delete(z.entities, e.id)
+			s.entity = nil
+			s.spawnAt = e.combat.lastDamaged.Add(s.spawn.respawnDelay())
+		}
+		if now.Before(s.spawnAt) {
+			continue
+		}
+		s.entity = z.spawnNPC(s, now)
+	}
+}
+
+func (z *Zone) spawnNPC(s *npcSpawner, now time.Time) *entity {
+	pos := z.def.onGround(s.spawn.Position)
+	kind := types.EntityKindNPC
+	if s.def.Hostile {
+		kind = types.EntityKindMonster
+	}
+	e := &entity{
+		id:     newEntityID(),
+		kind:   kind,
+		name:   s.def.Name,
+		state:  types.PlayerState{Position: pos, Target: pos, ZoneID: z.def.ID},
+		target: pos,
+		base:   s.def.Stats,
+		npc: &npcState{
+			def:       s.def,
+			home:      pos,
+			patrol:    s.spawn.Patrol,
+			ai:        npcIdle,
+			aggro:     make(map[int64]int),
+			waitUntil: now,
+		},
+	}
+	z.rules.initCombat(&e.combat)
+	z.rules.initStats(e)
+	z.entities[e.id] = e
+	return e
+}
+
+// 틱마다 살아 있는 NPC 의 행동을 정한다 (엔티티 ID 순). 기절한 NPC 는 아무것도 하지 않는다.
+func (z *Zone) updateNPCs(c *actor.Context, now time.Time) {
+	var npcs []*entity
+	for _, e := range z.entities {
+		if e.npc != nil {
+			npcs = append(npcs, e)
+		}
+	}
+	sort.Slice(npcs, func(i, j int) bool { return npcs[i].id < npcs[j].id })
+	for _, e := range npcs {
+		if e.dead() || e.stunned() {
+			continue
+		}
+		z.think(c, e, now)
+	}
+}
+
+func (z *Zone) think(c *actor.Context, e *entity, now time.Time) {
+	n := e.npc
+	if n.ai == npcIdle || n.ai == npcPatrol {
+		if tgt := z.findAggro(e); tgt != nil {
+			n.aggro[tgt.id] += npcProximityThreat
+			n.ai = npcChase
+		}
+	}
+	switch n.ai {
+	case npcIdle:
+		if len(n.patrol) > 0 && !now.Before(n.waitUntil) && z.moveNPC(e, n.patrol[n.next]) {
+			n.ai = npcPatrol
+		}
+	case npcPatrol:
+		if z.arrived(e, n.patrol[n.next]) {
+			n.next = (n.next + 1) % len(n.patrol)
+			n.waitUntil = now.Add(n.def.patrolWait())
+			n.ai = npcIdle
+		}
+	case npcChase, npcAttack:
+		z.engage(c, e, now)
+	case npcReturn:
+		if z.arrived(e, n.home) {
+			// 돌아오면 체력을 모두 채우고 처음부터 다시 순찰한다
+			e.state.Health = e.state.MaxHealth
+			n.next = 0
+			n.waitUntil = now.Add(n.def.patrolWait())
+			n.ai = npcIdle
+		}
+	}
+}
+
+// 위협 수치가 가장 높은 대상을 쫓아가 사거리 안에 들면 멈춰서 공격한다.
+// 대상이 없거나 스폰 지점에서 너무 멀어지면 돌아간다.
+func (z *Zone) engage(c *actor.Context, e *entity, now time.Time) {
+	n := e.npc
+	tgt := z.topThreat(e)
+	if tgt == nil || !withinXZ(n.home, e.state.Position, n.def.LeashRadius) {
+		z.leash(e)
+		return
+	}
+	a := &n.def.Attack
+	if !a.inRange(e.state.Position, tgt.state.Position) || !z.lineOfSight(e.state.Position, tgt.state.Position) {
+		n.ai = npcChase
+		z.chase(e, tgt.state.Position)
+		return
+	}
+	n.ai = npcAttack
+	if e.moving {
+		e.stopMoving()
+	}
+	if a.check(e, tgt, tgt.state.Position, now) == "" {
+		e.combat.lastAttack = now
+		z.damage(c, hitSource{entityID: e.id}, tgt, a.Damage+e.stats.AttackPower, now)
+	}
+}
+
+// 위협 수치를 모두 버리고 스폰 지점으로 돌아간다.
+func (z *Zone) leash(e *entity) {
+	clear(e.npc.aggro)
+	e.npc.ai = npcReturn
+	z.moveNPC(e, e.npc.home)
+}
+
+// 위협 수치가 가장 높은 대상 (같으면 ID 가 작은 쪽). 떠났거나 죽은 대상은 목록에서 지운다.
+func (z *Zone) topThreat(e *entity) *entity {
+	var best *entity
+	bestThreat := 0
+	for id, threat := range e.npc.aggro {
+		tgt, ok := z.entities[id]
+		if !ok || tgt.dead() || !z.hostile(e, tgt) {
+			delete(e.npc.aggro, id)
+			continue
+		}
+		if best == nil || threat > bestThreat || (threat == bestThreat && id < best.id) {
+			best, bestThreat = tgt, threat
+		}
+	}
+	return best
+}
+
+// 선공 반경 안에서 가장 가까운 플레이어 (같으면 ID 가 작은 쪽)
+func (z *Zone) findAggro(e *entity) *entity {
+	r := e.npc.def.AggroRadius
+	if !e.npc.def.Hostile || r <= 0 {
+		return nil
+	}
+	var best *entity
+	var bestDist float32
+	for _, other := range z.entities {
+		if other.kind != types.EntityKindPlayer || other.dead() || !z.hostile(e, other) {
+			continue
+		}
+		if !withinXZ(e.state.Position, other.state.Position, r) || !z.lineOfSight(e.state.Position, other.state.Position) {
+			continue
+		}
+		d := subtract(other.state.Position, e.state.Position)
+		dist := d.X*d.X + d.Z*d.Z
+		if best == nil || dist < bestDist || (dist == bestDist && other.id < best.id) {
+			best, bestDist = other, dist
+		}
+	}
+	return best
+}
+
+// 피해를 준 엔티티에 대한 위협 수치를 올리고, 싸우고 있지 않았으면 추격을 시작한다.
+func (z *Zone) addThreat(e *entity, srcID int64, amount int) {
+	if e.npc == nil || !e.npc.def.Hostile {
+		return
+	}
+	src, ok := z.entities[srcID]
+	if !ok || !z.hostile(e, src) {
+		return
+	}
+	e.npc.aggro[srcID] += max(amount, npcProximityThreat)
+	if e.npc.ai == npcIdle || e.npc.ai == npcPatrol {
+		e.npc.ai = npcChase
+	}
+}
+
+// pos 까지 길을 찾아 이동을 시작한다. 이미 도착했거나 갈 수 없으면 멈추고 false.
+func (z *Zone) moveNPC(e *entity, pos types.Vector) bool {
+	path, ok := z.findPath(e.state.Position, pos)
+	if !ok || (len(path) == 1 && withinXZ(e.state.Position, path[0], 0.1)) {
+		e.stopMoving()
+		return false
+	}
+	e.setPath(path)
+	return true
+}
+
+// pos 에 있는 대상을 쫓는다. 틱마다 길을 찾지 않고, 대상이 다른 칸으로 옮겼거나
+// 지난번에 찾은 길을 다 걸었을 때(또는 다른 이동으로 바뀌었을 때)만 다시 찾는다.
+func (z *Zone) chase(e *entity, pos types.Vector) {
+	n := e.npc
+	cell := z.def.chaseCell(pos)
+	if e.moving && e.target == n.chaseEnd && cell == n.chaseCell {
+		return
+	}
+	if z.moveNPC(e, pos) {
+		n.chaseCell, n.chaseEnd = cell, e.target
+	}
+}
+
+// 추격 대상이 움직였는지 볼 때 쓰는 칸 (길찾기 격자가 없으면 1m 칸)
+func (d *zoneDef) chaseCell(v types.Vector) navCell {
+	if d.nav != nil {
+		return d.nav.cellOf(v)
+	}
+	return navCell{x: int(math.Floor(float64(v.X))), z: int(math.Floor(float64(v.Z)))}
+}
+
+// dest 에 도착했는지. 도착하기 전에 멈췄으면 (기절 등) 다시 출발하고, 갈 수 없으면 도착한 것으로 본다.
+func (z *Zone) arrived(e *entity, dest types.Vector) bool {
+	return !e.moving && !z.moveNPC(e, dest)
+}
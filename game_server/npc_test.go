@@ -0,0 +1,156 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/SilverSS/gameserver/types"
+	"github.com/anthdm/hollywood/actor"
+)
+
+// 원점에 스폰하는 늑대 (선공 반경 5, 추격 한계 10, 사거리 2)
+func testWolf() *npcDef {
+	return &npcDef{
+		ID: "wolf", Name: "Wolf", Hostile: true,
+		Stats:        statBase{Stamina: 5, Strength: 2, MoveSpeed: 4},
+		Attack:       attackDef{Damage: 5, Range: 2, MaxHeightDiff: 2, CooldownMs: 1000},
+		AggroRadius:  5,
+		LeashRadius:  10,
+		PatrolWaitMs: 2000,
+	}
+}
+
+// def 를 patrol 순찰 지점과 함께 원점에 스폰한 존과 그 NPC.
+// NPC 는 newEntityID 로 ID 를 받으므로 플레이어 ID 도 newEntityID 로 받아 겹치지 않게 한다.
+func newNPCZone(h *combatHarness, def *npcDef, patrol ...types.Vector) (*Zone, *entity) {
+	rules := testCombatRules()
+	rules.npcs = map[string]*npcDef{def.ID: def}
+	zd := testZoneDef("wild", types.Vector{X: -40})
+	zd.NPCs = []npcSpawnDef{{NPC: def.ID, Patrol: patrol}}
+	z := h.newZone(zd, rules)
+	z.initSpawners()
+	z.updateSpawners(time.Now())
+	return z, z.spawners[0].entity
+}
+
+func think(h *combatHarness, z *Zone, npc *entity, now time.Time) {
+	h.do(func(c *actor.Context) { z.think(c, npc, now) })
+}
+
+func TestNPCChaseAttackAndLeash(t *testing.T) {
+	h := newCombatHarness(t)
+	z, wolf := newNPCZone(h, testWolf())
+	now := time.Now()
+
+	// 선공 반경 밖이면 그대로 있는다
+	p := h.enter(z, newEntityID(), types.Vector{X: 6})
+	think(h, z, wolf, now)
+	if wolf.npc.ai != npcIdle {
+		t.Fatalf("ai = %s with the player outside the aggro radius, want idle", wolf.npc.ai)
+	}
+
+	// 들어오면 쫓아가고
+	p.state.Position = types.Vector{X: 4}
+	think(h, z, wolf, now)
+	if wolf.npc.ai != npcChase || !wolf.moving || wolf.npc.aggro[p.id] != npcProximityThreat {
+		t.Fatalf("ai = %s moving=%v aggro=%v, want chasing the player", wolf.npc.ai, wolf.moving, wolf.npc.aggro)
+	}
+
+	// 사거리에 들면 멈춰서 공격한다
+	wolf.state.Position = types.Vector{X: 2.5}
+	think(h, z, wolf, now)
+	if wolf.npc.ai != npcAttack || wolf.moving {
+		t.Fatalf("ai = %s moving=%v, want attacking in place", wolf.npc.ai, wolf.moving)
+	}
+	if want := 100 - (5 + 4); p.state.Health != want {
+		t.Errorf("player health = %d, want %d", p.state.Health, want)
+	}
+
+	// 스폰 지점에서 추격 한계보다 멀어지면 위협 수치를 버리고 돌아간다 (돌아가는 동안 피해를 받지 않음)
+	wolf.state.Position = types.Vector{X: 10.5}
+	p.state.Position = types.Vector{X: 12}
+	wolf.state.Health = 10
+	think(h, z, wolf, now)
+	if wolf.npc.ai != npcReturn || len(wolf.npc.aggro) != 0 || !wolf.evading() {
+		t.Fatalf("ai = %s aggro=%v, want returning with no threat", wolf.npc.ai, wolf.npc.aggro)
+	}
+	h.do(func(c *actor.Context) { z.damage(c, hitSource{entityID: p.id}, wolf, 5, now) })
+	if wolf.state.Health != 10 {
+		t.Errorf("returning npc took damage: health %d", wolf.state.Health)
+	}
+
+	// 스폰 지점에 도착하면 체력을 채우고 대기한다
+	p.state.Position = types.Vector{X: 30}
+	walk(z, wolf, wolf.npc.home, 100)
+	think(h, z, wolf, now)
+	if wolf.npc.ai != npcIdle || wolf.state.Health != wolf.state.MaxHealth {
+		t.Errorf("ai = %s health %d/%d, want idle at full health", wolf.npc.ai, wolf.state.Health, wolf.state.MaxHealth)
+	}
+}
+
+func TestNPCReturnsWhenTargetDies(t *testing.T) {
+	h := newCombatHarness(t)
+	z, wolf := newNPCZone(h, testWolf())
+	p := h.enter(z, newEntityID(), types.Vector{X: 4})
+	now := time.Now()
+	think(h, z, wolf, now)
+
+	p.state.Health = 0
+	think(h, z, wolf, now)
+	if wolf.npc.ai != npcReturn {
+		t.Errorf("ai = %s after the target died, want return", wolf.npc.ai)
+	}
+}
+
+func TestNPCThreat(t *testing.T) {
+	h := newCombatHarness(t)
+	def := testWolf()
+	def.AggroRadius = 0 // 맞아야 반격한다
+	z, wolf := newNPCZone(h, def)
+	p1 := h.enter(z, newEntityID(), types.Vector{X: 3})
+	p2 := h.enter(z, newEntityID(), types.Vector{X: -3})
+	now := time.Now()
+
+	think(h, z, wolf, now)
+	if wolf.npc.ai != npcIdle {
+		t.Fatalf("passive npc ai = %s, want idle", wolf.npc.ai)
+	}
+	z.addThreat(wolf, p1.id, 10)
+	if wolf.npc.ai != npcChase || z.topThreat(wolf) != p1 {
+		t.Fatalf("ai = %s, want chasing p1", wolf.npc.ai)
+	}
+	// 위협 수치가 더 높은 대상으로 바꾸고, 떠난 대상은 목록에서 지운다
+	z.addThreat(wolf, p2.id, 20)
+	if z.topThreat(wolf) != p2 {
+		t.Error("top threat is not p2")
+	}
+	delete(z.entities, p2.id)
+	if z.topThreat(wolf) != p1 || len(wolf.npc.aggro) != 1 {
+		t.Errorf("aggro = %v after p2 left, want only p1", wolf.npc.aggro)
+	}
+}
+
+func TestNPCPatrol(t *testing.T) {
+	h := newCombatHarness(t)
+	points := []types.Vector{{X: 5}, {Z: 5}}
+	z, wolf := newNPCZone(h, testWolf(), points...)
+	now := time.Now()
+
+	think(h, z, wolf, now)
+	if wolf.npc.ai != npcPatrol || wolf.target != points[0] {
+		t.Fatalf("ai = %s target %v, want patrolling to %v", wolf.npc.ai, wolf.target, points[0])
+	}
+	walk(z, wolf, points[0], 100)
+	think(h, z, wolf, now)
+	if wolf.npc.ai != npcIdle || wolf.npc.next != 1 || !wolf.npc.waitUntil.Equal(now.Add(2*time.Second)) {
+		t.Fatalf("ai = %s next %d, want waiting 2s before the next point", wolf.npc.ai, wolf.npc.next)
+	}
+	think(h, z, wolf, now.Add(time.Second))
+	if wolf.npc.ai != npcIdle {
+		t.Errorf("ai = %s before the wait ended, want idle", wolf.npc.ai)
+	}
+	think(h, z, wolf, now.Add(2*time.Second))
+	if wolf.npc.ai != npcPatrol || wolf.target != points[1] {
+		t.Errorf("ai = %s target %v, want patrolling to %v", wolf.npc.ai, wolf.target, points[1])
+	}
+}
@@ -0,0 +1,504 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/SilverSS/gameserver/types"
+	"github.com/anthdm/hollywood/actor"
+)
+
+// 파티 제한
+const (
+	maxPartySize       = 5
+	partyInviteTimeout = 60 * time.Second
+	partySweepInterval = time.Second
+	partyStateInterval = time.Second // 존이 파티 액터에 플레이어 상태를 보고하는 주기
+)
+
+// 파티 액터 메시지 (세션 -> 파티)
+type (
+	partyInvite struct {
+		Session  *actor.PID
+		Username string
+		Target   string
+	}
+
+	// 초대 수락/거절 (From: 초대한 사람)
+	partyRespond struct {
+		Session  *actor.PID
+		Username string
+		From     string
+		Accept   bool
+	}
+
+	partyLeave struct {
+		Session  *actor.PID
+		Username string
+	}
+
+	// 추방 또는 파티장 위임 (파티장만)
+	partyMemberAction struct {
+		Session  *actor.PID
+		Username string
+		Target   string
+		Promote  bool
+	}
+
+	partySweep struct{}
+)
+
+// 파티 채팅 전달 (채팅 -> 파티). 검사와 필터는 채팅 액터가 이미 마쳤다.
+// Blocked 는 보낸 사람을 차단한 사용자명으로, 이들에게는 보내지 않는다.
+type partyChat struct {
+	Session  *actor.PID
+	Username string
+	Blocked  map[string]bool
+	Msg      types.ChatMessage
+}
+
+// 파티원 조회 (인스턴스 관리자 -> 파티). 보낸 액터에게 partyMembers 로 답한다.
+// Request 는 조회를 요청한 작업으로, 답에 그대로 돌려준다.
+type partyMembersQuery struct {
+	Username string
+	Request  any
+}
+
+// 파티원 조회 결과 (Username 을 뺀 파티원, 접속이 끊긴 파티원은 Session 이 nil). 파티가 없으면 비어 있다.
+type partyMembers struct {
+	Username string
+	Members  []roomMember
+	Request  any
+}
+
+// 존 안 플레이어 상태 보고 (존 -> 파티, partyStateInterval 마다)
+type partyMemberStates struct {
+	ZoneID string
+	States []memberState
+}
+
+type memberState struct {
+	EntityID int64
+	Position types.Vector
+	Health   int
+}
+
+type partyMember struct {
+	username     string
+	session      *actor.PID // 접속이 끊겼으면 nil
+	entityID     int64
+	offlineSince time.Time
+	state        types.PartyMemberState // 마지막으로 보낸 상태
+}
+
+type party struct {
+	id      int64
+	leader  string
+	members []*partyMember // 들어온 순서
+}
+
+// 대소문자 등이 달라도 usernameKey 가 같으면 같은 파티원
+func (p *party) member(username string) *partyMember {
+	key := usernameKey(username)
+	for _, m := range p.members {
+		if usernameKey(m.username) == key {
+			return m
+		}
+	}
+	return nil
+}
+
+func (p *party) info() types.PartyUpdate {
+	states := make([]types.PartyMemberState, len(p.members))
+	for i, m := range p.members {
+		states[i] = m.state
+	}
+	return types.PartyUpdate{PartyID: p.id, Leader: p.leader, Members: states}
+}
+
+// 파티 액터: 모든 파티의 구성과 초대를 관리하고, 존이 보고한 상태를 파티원에게 전달한다.
+// 접속이 끊긴 파티원은 유예 시간 동안 자리를 유지하고, 그 안에 다시 접속하면 그대로 이어진다.
+type Parties struct {
+	grace    time.Duration
+	online   map[string]playerOnline // 사용자명 -> 현재 세션
+	names    map[string]string       // usernameKey -> 접속 중인 정식 사용자명
+	parties  map[int64]*party
+	byName   map[string]*party
+	byEntity map[int64]*partyMember          // 접속 중인 파티원의 엔티티 ID
+	invites  map[string]map[string]time.Time // 초대받은 사람 -> 초대한 사람 -> 만료 시각
+	seq      int64
+	repeater actor.SendRepeater
+}
+
+func newParties(grace time.Duration) actor.Producer {
+	return func() actor.Receiver {
+		return &Parties{
+			grace:    grace,
+			online:   make(map[string]playerOnline),
+			names:    make(map[string]string),
+			parties:  make(map[int64]*party),
+			byName:   make(map[string]*party),
+			byEntity: make(map[int64]*partyMember),
+			invites:  make(map[string]map[string]time.Time),
+		}
+	}
+}
+
+func (ps *Parties) Receive(c *actor.Context) {
+	switch msg := c.Message().(type) {
+	case actor.Started:
+		c.Engine().Subscribe(c.PID())
+		ps.repeater = c.SendRepeat(c.PID(), partySweep{}, partySweepInterval)
+	case actor.Stopped:
+		ps.repeater.Stop()
+		c.Engine().Unsubscribe(c.PID())
+	case playerOnline:
+		ps.online[msg.Username] = msg
+		ps.names[usernameKey(msg.Username)] = msg.Username
+		ps.reconnect(c, msg)
+	case playerOffline:
+		if cur, ok := ps.online[msg.Username]; ok && cur.Session.Equals(msg.Session) {
+			delete(ps.online, msg.Username)
+			delete(ps.names, usernameKey(msg.Username))
+			ps.disconnect(c, msg)
+		}
+	case partyInvite:
+		ps.invite(c, msg)
+	case partyRespond:
+		ps.respond(c, msg)
+	case partyLeave:
+		if _, ok := ps.byName[msg.Username]; !ok {
+			ps.reply(c, msg.Session, types.PartyActionLeave, types.PartyErrNotInParty)
+			return
+		}
+		ps.reply(c, msg.Session, types.PartyActionLeave, "")
+		ps.remove(c, msg.Username, types.PartyLeftSelf)
+	case partyMemberAction:
+		ps.memberAction(c, msg)
+	case partyChat:
+		ps.chat(c, msg)
+	case partyMembersQuery:
+		c.Send(c.Sender(), ps.members(msg))
+	case partyMemberStates:
+		ps.updateStates(c, msg)
+	case partySweep:
+		ps.sweep(c)
+	}
+}
+
+// 파티원이 다시 접속함 (유예 시간 안)
+func (ps *Parties) reconnect(c *actor.Context, msg playerOnline) {
+	p, ok := ps.byName[msg.Username]
+	if !ok {
+		return
+	}
+	m := p.member(msg.Username)
+	if m.session != nil {
+		delete(ps.byEntity, m.entityID)
+	}
+	m.session = msg.Session
+	m.entityID = msg.EntityID
+	m.offlineSince = time.Time{}
+	m.state = types.PartyMemberState{Username: m.username, Online: true}
+	ps.byEntity[m.entityID] = m
+	ps.broadcast(c, p, "partyUpdate", p.info())
+}
+
+// 파티원의 접속이 끊김: 자리는 유예 시간 동안 유지하고 sweep 에서 정리한다.
+func (ps *Parties) disconnect(c *actor.Context, msg playerOffline) {
+	p, ok := ps.byName[msg.Username]
+	if !ok {
+		return
+	}
+	m := p.member(msg.Username)
+	delete(ps.byEntity, m.entityID)
+	m.session = nil
+	m.offlineSince = time.Now()
+	m.state = types.PartyMemberState{Username: m.username}
+	ps.broadcast(c, p, "partyUpdate", p.info())
+}
+
+// 접속 중인 플레이어 (대소문자 등은 usernameKey 로 맞춘다)
+func (ps *Parties) lookup(username string) (playerOnline, bool) {
+	p, ok := ps.online[ps.names[usernameKey(username)]]
+	return p, ok
+}
+
+func (ps *Parties) invite(c *actor.Context, msg partyInvite) {
+	if msg.Target == "" || usernameKey(msg.Target) == usernameKey(msg.Username) {
+		ps.reply(c, msg.Session, types.PartyActionInvite, types.PartyErrInvalidTarget)
+		return
+	}
+	target, ok := ps.lookup(msg.Target)
+	if !ok {
+		ps.reply(c, msg.Session, types.PartyActionInvite, types.PartyErrPlayerOffline)
+		return
+	}
+	if p, ok := ps.byName[msg.Username]; ok {
+		if p.leader != msg.Username {
+			ps.reply(c, msg.Session, types.PartyActionInvite, types.PartyErrNotLeader)
+			return
+		}
+		if len(p.members) >= maxPartySize {
+			ps.reply(c, msg.Session, types.PartyActionInvite, types.PartyErrFull)
+			return
+		}
+	}
+	if _, ok := ps.byName[target.Username]; ok {
+		ps.reply(c, msg.Session, types.PartyActionInvite, types.PartyErrAlreadyInParty)
+		return
+	}
+	if ps.invites[target.Username] == nil {
+		ps.invites[target.Username] = make(map[string]time.Time)
+	}
+	ps.invites[target.Username][msg.Username] = time.Now().Add(partyInviteTimeout)
+	c.Send(target.Session, wsSend{Type: "partyInvitation", Data: types.PartyInvitation{
+		From:        msg.Username,
+		ExpiresInMs: partyInviteTimeout.Milliseconds(),
+	}})
+	ps.reply(c, msg.Session, types.PartyActionInvite, "")
+}
+
+func (ps *Parties) respond(c *actor.Context, msg partyRespond) {
+	action := types.PartyActionDecline
+	if msg.Accept {
+		action = types.PartyActionAccept
+	}
+	// 초대는 초대한 사람의 정식 사용자명으로 저장되어 있다
+	for name := range ps.invites[msg.Username] {
+		if usernameKey(name) == usernameKey(msg.From) {
+			msg.From = name
+		}
+	}
+	expires, ok := ps.invites[msg.Username][msg.From]
+	if ok {
+		delete(ps.invites[msg.Username], msg.From)
+		if len(ps.invites[msg.Username]) == 0 {
+			delete(ps.invites, msg.Username)
+		}
+	}
+	if !ok || time.Now().After(expires) {
+		ps.reply(c, msg.Session, action, types.PartyErrNoInvitation)
+		return
+	}
+	if !msg.Accept {
+		ps.reply(c, msg.Session, action, "")
+		if inviter, ok := ps.online[msg.From]; ok {
+			c.Send(inviter.Session, wsSend{Type: "partyInviteDeclined", Data: types.PartyInviteDeclined{Username: msg.Username}})
+		}
+		return
+	}
+	if _, ok := ps.byName[msg.Username]; ok {
+		ps.reply(c, msg.Session, action, types.PartyErrAlreadyInParty)
+		return
+	}
+	p, ok := ps.byName[msg.From]
+	switch {
+	case !ok:
+		// 초대한 사람이 아직 파티가 없으면 그를 파티장으로 새 파티를 만든다
+		inviter, online := ps.online[msg.From]
+		if !online {
+			ps.reply(c, msg.Session, action, types.PartyErrPlayerOffline)
+			return
+		}
+		ps.seq++
+		p = &party{id: ps.seq, leader: msg.From}
+		ps.parties[p.id] = p
+		ps.join(p, inviter)
+		fmt.Printf("party %d formed by %s\n", p.id, msg.From)
+	case p.leader != msg.From:
+		// 초대한 뒤 파티장이 바뀜
+		ps.reply(c, msg.Session, action, types.PartyErrNoInvitation)
+		return
+	case len(p.members) >= maxPartySize:
+		ps.reply(c, msg.Session, action, types.PartyErrFull)
+		return
+	}
+	ps.join(p, ps.online[msg.Username])
+	ps.reply(c, msg.Session, action, "")
+	ps.broadcast(c, p, "partyUpdate", p.info())
+}
+
+func (ps *Parties) join(p *party, player playerOnline) {
+	m := &partyMember{
+		username: player.Username,
+		session:  player.Session,
+		entityID: player.EntityID,
+		state:    types.PartyMemberState{Username: player.Username, Online: true},
+	}
+	p.members = append(p.members, m)
+	ps.byName[m.username] = p
+	ps.byEntity[m.entityID] = m
+}
+
+func (ps *Parties) members(msg partyMembersQuery) partyMembers {
+	res := partyMembers{Username: msg.Username, Request: msg.Request}
+	p, ok := ps.byName[msg.Username]
+	if !ok {
+		return res
+	}
+	for _, m := range p.members {
+		if m.username != msg.Username {
+			res.Members = append(res.Members, roomMember{Username: m.username, Session: m.session})
+		}
+	}
+	return res
+}
+
+func (ps *Parties) memberAction(c *actor.Context, msg partyMemberAction) {
+	action := types.PartyActionKick
+	if msg.Promote {
+		action = types.PartyActionPromote
+	}
+	p, ok := ps.byName[msg.Username]
+	switch {
+	case !ok:
+		ps.reply(c, msg.Session, action, types.PartyErrNotInParty)
+		return
+	case p.leader != msg.Username:
+		ps.reply(c, msg.Session, action, types.PartyErrNotLeader)
+		return
+	case usernameKey(msg.Target) == usernameKey(msg.Username):
+		ps.reply(c, msg.Session, action, types.PartyErrInvalidTarget)
+		return
+	}
+	target := p.member(msg.Target)
+	if target == nil {
+		ps.reply(c, msg.Session, action, types.PartyErrNotMember)
+		return
+	}
+	ps.reply(c, msg.Session, action, "")
+	if msg.Promote {
+		p.leader = target.username
+		ps.broadcast(c, p, "partyUpdate", p.info())
+		return
+	}
+	ps.remove(c, target.username, types.PartyLeftKicked)
+}
+
+// 파티원을 빼고 남은 파티원에게 알린다. 한 명만 남으면 파티를 해산한다.
+// 파티장이 빠지면 접속 중인 파티원 중 가장 먼저 들어온 사람이 파티장이 된다.
+func (ps *Parties) remove(c *actor.Context, username, reason string) {
+	p := ps.byName[username]
+	for i, m := range p.members {
+		if m.username != username {
+			continue
+		}
+		p.members = append(p.members[:i], p.members[i+1:]...)
+		ps.drop(c, p, m, reason)
+		break
+	}
+	if len(p.members) < 2 {
+		for _, m := range p.members {
+			ps.drop(c, p, m, types.PartyLeftDisbanded)
+		}
+		delete(ps.parties, p.id)
+		fmt.Printf("party %d disbanded\n", p.id)
+		return
+	}
+	if p.leader == username {
+		p.leader = p.members[0].username
+		for _, m := range p.members {
+			if m.session != nil {
+				p.leader = m.username
+				break
+			}
+		}
+	}
+	ps.broadcast(c, p, "partyUpdate", p.info())
+}
+
+func (ps *Parties) drop(c *actor.Context, p *party, m *partyMember, reason string) {
+	delete(ps.byName, m.username)
+	if m.session != nil {
+		delete(ps.byEntity, m.entityID)
+		c.Send(m.session, wsSend{Type: "partyLeft", Data: types.PartyLeft{PartyID: p.id, Reason: reason}})
+	}
+}
+
+func (ps *Parties) chat(c *actor.Context, msg partyChat) {
+	p, ok := ps.byName[msg.Username]
+	if !ok {
+		c.Send(msg.Session, wsSend{Type: "chatResult", Data: types.ChatResult{
+			Action: types.ChatActionSend,
+			Code:   types.ChatErrNotInParty,
+		}})
+		return
+	}
+	for _, m := range p.members {
+		if m.session != nil && !msg.Blocked[m.username] {
+			c.Send(m.session, wsSend{Type: "chatMessage", Data: msg.Msg})
+		}
+	}
+}
+
+// 존이 보고한 상태 중 바뀐 파티원만 파티별로 모아 보낸다.
+func (ps *Parties) updateStates(c *actor.Context, msg partyMemberStates) {
+	changed := make(map[*party][]types.PartyMemberState)
+	var order []*party
+	for _, s := range msg.States {
+		m, ok := ps.byEntity[s.EntityID]
+		if !ok {
+			continue
+		}
+		next := types.PartyMemberState{Username: m.username, Online: true, ZoneID: msg.ZoneID, Position: s.Position, Health: s.Health}
+		if next == m.state {
+			continue
+		}
+		m.state = next
+		p := ps.byName[m.username]
+		if _, ok := changed[p]; !ok {
+			order = append(order, p)
+		}
+		changed[p] = append(changed[p], next)
+	}
+	for _, p := range order {
+		ps.broadcast(c, p, "partyMemberUpdate", types.PartyMemberUpdate{Members: changed[p]})
+	}
+}
+
+// 만료된 초대와 유예 시간이 지난 파티원 정리
+func (ps *Parties) sweep(c *actor.Context) {
+	now := time.Now()
+	for target, from := range ps.invites {
+		for name, expires := range from {
+			if now.After(expires) {
+				delete(from, name)
+			}
+		}
+		if len(from) == 0 {
+			delete(ps.invites, target)
+		}
+	}
+	var expired []string
+	for _, p := range ps.parties {
+		for _, m := range p.members {
+			if m.session == nil && now.Sub(m.offlineSince) >= ps.grace {
+				expired = append(expired, m.username)
+			}
+		}
+	}
+	for _, name := range expired {
+		// 앞선 정리로 파티가 해산되었으면 이미 빠져 있다
+		if _, ok := ps.byName[name]; ok {
+			ps.remove(c, name, types.PartyLeftTimeout)
+		}
+	}
+}
+
+// 접속 중인 파티원 모두에게 전송
+func (ps *Parties) broadcast(c *actor.Context, p *party, msgType string, v interface{}) {
+	for _, m := range p.members {
+		if m.session != nil {
+			c.Send(m.session, wsSend{Type: msgType, Data: v})
+		}
+	}
+}
+
+func (ps *Parties) reply(c *actor.Context, session *actor.PID, action, code string) {
+	c.Send(session, wsSend{Type: "partyResult", Data: types.PartyResult{
+		Action:  action,
+		Success: code == "",
+		Code:    code,
+	}})
+}
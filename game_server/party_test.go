@@ -0,0 +1,76 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/SilverSS/gameserver/types"
+	"github.com/anthdm/hollywood/actor"
+)
+
+// 파티 액터와 접속 중인 세션들 (이름은 정식 사용자명)
+func newPartyTest(t *testing.T, names ...string) (*actor.Engine, *actor.PID, map[string]*inbox) {
+	t.Helper()
+	e, err := actor.NewEngine(actor.NewEngineConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	parties := e.Spawn(newParties(time.Minute), "parties")
+	t.Cleanup(func() { e.Poison(parties).Wait() })
+	sessions := make(map[string]*inbox)
+	for i, name := range names {
+		s := newInbox(t, e, name)
+		sessions[name] = s
+		e.Send(parties, playerOnline{Username: name, Session: s.pid, EntityID: int64(i + 1)})
+	}
+	return e, parties, sessions
+}
+
+func waitPartyResult(t *testing.T, s *inbox, action, code string) {
+	t.Helper()
+	res := waitForWS[types.PartyResult](s, "partyResult")
+	if res.Action != action || res.Code != code {
+		t.Fatalf("%s: partyResult = %+v, want %s %q", s.name, res, action, code)
+	}
+}
+
+// 대상 사용자명은 대소문자가 달라도 같은 계정으로 본다
+func TestPartyTargetCaseInsensitive(t *testing.T) {
+	e, parties, s := newPartyTest(t, "alice", "Bob", "carol")
+	alice, bob, carol := s["alice"], s["Bob"], s["carol"]
+
+	e.Send(parties, partyInvite{Session: bob.pid, Username: "Bob", Target: "BOB"})
+	waitPartyResult(t, bob, types.PartyActionInvite, types.PartyErrInvalidTarget)
+
+	for _, target := range []struct {
+		name  string
+		inbox *inbox
+	}{{"ALICE", alice}, {"Carol", carol}} {
+		e.Send(parties, partyInvite{Session: bob.pid, Username: "Bob", Target: target.name})
+		waitPartyResult(t, bob, types.PartyActionInvite, "")
+		if inv := waitForWS[types.PartyInvitation](target.inbox, "partyInvitation"); inv.From != "Bob" {
+			t.Errorf("partyInvitation = %+v", inv)
+		}
+	}
+	e.Send(parties, partyRespond{Session: alice.pid, Username: "alice", From: "bob", Accept: true})
+	waitPartyResult(t, alice, types.PartyActionAccept, "")
+	e.Send(parties, partyRespond{Session: carol.pid, Username: "carol", From: "BOB", Accept: true})
+	waitPartyResult(t, carol, types.PartyActionAccept, "")
+
+	e.Send(parties, partyMemberAction{Session: bob.pid, Username: "Bob", Target: "bOB"})
+	waitPartyResult(t, bob, types.PartyActionKick, types.PartyErrInvalidTarget)
+	e.Send(parties, partyMemberAction{Session: bob.pid, Username: "Bob", Target: "CAROL"})
+	waitPartyResult(t, bob, types.PartyActionKick, "")
+	e.Send(parties, partyMemberAction{Session: bob.pid, Username: "Bob", Target: "Alice", Promote: true})
+	waitPartyResult(t, bob, types.PartyActionPromote, "")
+
+	var update types.PartyUpdate
+	for len(update.Members) != 2 || update.Leader != "alice" {
+		update = waitForWS[types.PartyUpdate](alice, "partyUpdate")
+	}
+	for _, m := range update.Members {
+		if m.Username != "alice" && m.Username != "Bob" {
+			t.Errorf("member %q, want only canonical names alice and Bob", m.Username)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"github.com/anthdm/hollywood/actor"
+)
+
+// 접속/종료/존 입장 이벤트 (GameServer 가 엔진 이벤트 스트림으로 방송)
+// 다른 서비스 액터는 Started 에서 c.Engine().Subscribe(c.PID()) 로 구독해 접속자 목록을 유지한다.
+type (
+	playerOnline struct {
+		Username string
+		Session  *actor.PID
+		EntityID int64
+	}
+
+	playerOffline struct {
+		Username string
+		Session  *actor.PID
+		EntityID int64
+	}
+
+	// 존 입장 (존 이동 포함)
+	playerZoneChanged struct {
+		Username string
+		Session  *actor.PID
+		ZoneID   string
+	}
+)
+
+// 세션 종료 알림 (세션 -> GameServer)
+type playerLeft struct {
+	Username string
+	Session  *actor.PID
+	EntityID int64
+}
+
+// 접속 중인 플레이어 (사용자명 -> 세션). 구독한 액터 안에서만 사용한다.
+type onlinePlayers map[string]*actor.PID
+
+// 접속/종료 이벤트를 반영한다. 이벤트가 아니면 false.
+// 같은 계정이 다시 접속한 뒤 이전 세션의 종료 이벤트가 와도 새 세션을 지우지 않는다.
+func (o onlinePlayers) apply(msg interface{}) bool {
+	switch msg := msg.(type) {
+	case playerOnline:
+		o[msg.Username] = msg.Session
+	case playerOffline:
+		if pid, ok := o[msg.Username]; ok && pid.Equals(msg.Session) {
+			delete(o, msg.Username)
+		}
+	default:
+		return false
+	}
+	return true
+}
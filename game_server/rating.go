@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"math"
+
+	"github.com/SilverSS/gameserver/ent"
+	"github.com/SilverSS/gameserver/ent/character"
+	"github.com/SilverSS/gameserver/ent/user"
+)
+
+// Elo 레이팅 변동 폭
+const eloK = 32
+
+// 팀 평균 레이팅 a 가 b 를 상대로 이길 기대 확률
+func eloExpected(a, b float64) float64 {
+	return 1 / (1 + math.Pow(10, (b-a)/400))
+}
+
+// 2팀 대전 결과에 따른 팀별 레이팅 변화. winner 가 음수면 무승부.
+// 같은 팀원은 모두 같은 폭으로 오르내린다.
+func eloDeltas(teamRatings [2]float64, winner int) [2]int {
+	score := 0.5
+	switch winner {
+	case 0:
+		score = 1
+	case 1:
+		score = 0
+	}
+	d := int(math.Round(eloK * (score - eloExpected(teamRatings[0], teamRatings[1]))))
+	return [2]int{d, -d}
+}
+
+// 계정의 대표 캐릭터(가장 먼저 만든 캐릭터)와 레이팅 조회
+func loadCharacterRating(ctx context.Context, client *ent.Client, username string) (*ent.Character, error) {
+	return client.Character.Query().
+		Where(character.HasOwnerWith(user.UsernameEQ(username))).
+		Order(ent.Asc(character.FieldID)).
+		First(ctx)
+}
+
+// 레이팅 변화 저장 (동시에 여러 매치 결과가 와도 누락되지 않도록 증감으로 갱신)
+func applyRatingDelta(ctx context.Context, client *ent.Client, characterID, delta int) (*ent.Character, error) {
+	return client.Character.UpdateOneID(characterID).
+		AddRating(delta).
+		AddRatedGames(1).
+		Save(ctx)
+}
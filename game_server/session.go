@@ -0,0 +1,448 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/SilverSS/gameserver/types"
+	"github.com/anthdm/hollywood/actor"
+	"github.com/gorilla/websocket"
+)
+
+// 세션 액터가 클라이언트에게 보낼 메시지 (다른 액터 -> 세션)
+type wsSend struct {
+	Type string
+	Data interface{}
+}
+
+// 존 입장 완료 알림 (존 -> 세션)
+type zoneJoined struct {
+	ZoneID string
+	Zone   *actor.PID
+}
+
+type PlayerSession struct {
+	sessionID int
+	clientID  int
+	entityID  int64 // 존에서 이 플레이어를 가리키는 엔티티 ID
+	username  string
+	inLobby   bool // 로비에 있음 (존에 들어가면 false)
+	conn      *websocket.Conn
+	server    *GameServer
+	engine    *actor.Engine
+	done      chan struct{}
+	pid       *actor.PID
+
+	zoneID string     // 현재 존 ID
+	zone   *actor.PID // 현재 존 액터 (존 이동 중에는 nil)
+
+	latency latency            // 클라이언트와의 지연 (지연 보상용)
+	pinger  actor.SendRepeater // pingInterval 마다 sessionPing
+
+	writeMu sync.Mutex // WebSocket Write 보호용 뮤텍스 추가
+}
+
+// Receive implements actor.Receiver.
+// WebSocket 으로 받은 메시지도 readLoop 가 자신에게 다시 보내 이 액터 안에서 처리한다.
+func (s *PlayerSession) Receive(c *actor.Context) {
+	switch msg := c.Message().(type) {
+	case actor.Started:
+		s.pid = c.PID()
+		s.engine = c.Engine()
+		s.done = make(chan struct{})
+		s.pinger = c.SendRepeat(c.PID(), sessionPing{}, pingInterval)
+		go s.readLoop()
+	case actor.Stopped:
+		s.pinger.Stop()
+		s.cleanup()
+	case types.WSMessage:
+		s.handleMessage(c, msg)
+	case wsSend:
+		sendWS(s.conn, msg.Type, msg.Data, &s.writeMu)
+	case sessionPing:
+		sendWS(s.conn, "ping", s.latency.ping(time.Now()), &s.writeMu)
+	case zoneJoined:
+		s.zoneID = msg.ZoneID
+		s.zone = msg.Zone
+		c.Send(s.server.chat, chatZoneJoined{Session: s.pid, ZoneID: msg.ZoneID})
+	case instanceTransfer:
+		if s.inLobby {
+			s.enterWorld(c, msg.ToZone)
+			return
+		}
+		s.sendToZone(c, transferOut{EntityID: s.entityID, ToZone: msg.ToZone})
+	}
+}
+
+func (s *PlayerSession) cleanup() {
+	select {
+	case <-s.done:
+		// 이미 cleanup 됨
+		return
+	default:
+		close(s.done)
+		s.conn.Close()
+		// 존 퇴장은 GameServer 가 playerLeft 를 받고 처리한다 (존 이동 중이어도 안전)
+		if s.inLobby {
+			s.engine.Send(s.server.lobby, lobbyExit{Session: s.pid})
+		}
+		if s.server != nil {
+			s.server.removeSession(s.pid)
+			s.engine.Send(s.server.ctx.PID(), playerLeft{Username: s.username, Session: s.pid, EntityID: s.entityID})
+		}
+	}
+}
+
+func (s *PlayerSession) readLoop() {
+	// 연결이 끊기면 세션 액터를 종료한다 (Stopped 에서 cleanup)
+	defer s.engine.Poison(s.pid)
+
+	fmt.Printf("client %d : session %d started\n", s.clientID, s.sessionID)
+
+	for {
+		select {
+		case <-s.done:
+			return
+		default:
+			var msg types.WSMessage
+			err := s.conn.ReadJSON(&msg)
+			if err != nil {
+				// 1. websocket.CloseError 타입인 경우
+				if closeErr, ok := err.(*websocket.CloseError); ok {
+					switch closeErr.Code {
+					case websocket.CloseNormalClosure:
+						fmt.Printf("client %d : session %d 정상 종료 (CloseNormalClosure)\n", s.clientID, s.sessionID)
+					case websocket.CloseGoingAway:
+						fmt.Printf("client %d : session %d 정상 종료 (CloseGoingAway)\n", s.clientID, s.sessionID)
+					case websocket.CloseAbnormalClosure:
+						fmt.Printf("client %d : session %d 비정상 종료 (CloseAbnormalClosure)\n", s.clientID, s.sessionID)
+					default:
+						fmt.Printf("client %d : session %d 종료 (code=%d, text=%s)\n", s.clientID, s.sessionID, closeErr.Code, closeErr.Text)
+					}
+					// 2. 네트워크 연결이 이미 닫힌 경우
+				} else if strings.Contains(err.Error(), "use of closed network connection") {
+					fmt.Printf("client %d : session %d 네트워크 연결 종료 (use of closed network connection)\n", s.clientID, s.sessionID)
+					// 3. 타임아웃 등 기타 네트워크 에러
+				} else if strings.Contains(err.Error(), "i/o timeout") {
+					fmt.Printf("client %d : session %d 네트워크 타임아웃\n", s.clientID, s.sessionID)
+					// 4. 기타 예상치 못한 에러
+				} else {
+					fmt.Printf("client %d : session %d 예기치 않은 read error: %v\n", s.clientID, s.sessionID, err)
+				}
+				return
+			}
+			s.engine.Send(s.pid, msg)
+		}
+	}
+}
+
+// 로비를 떠나 존으로 입장 (zoneID 가 비어 있으면 시작 존)
+func (s *PlayerSession) enterWorld(c *actor.Context, zoneID string) {
+	s.inLobby = false
+	c.Send(s.server.lobby, lobbyExit{Session: s.pid})
+	c.Send(s.server.ctx.PID(), enterWorld{Session: s.pid, EntityID: s.entityID, Username: s.username, ZoneID: zoneID})
+}
+
+// 클라이언트 메시지 처리: 로비 요청은 로비 액터로, 게임 로직은 현재 존 액터로 전달
+func (s *PlayerSession) handleMessage(c *actor.Context, msg types.WSMessage) {
+	if s.handleLobbyMessage(c, msg) {
+		return
+	}
+	switch msg.Type {
+	case "pong":
+		var req types.Pong
+		if err := json.Unmarshal(msg.Data, &req); err != nil {
+			fmt.Printf("pong unmarshal error: %v\n", err)
+			return
+		}
+		s.latency.pong(req, time.Now())
+	case "timeSync":
+		var req types.TimeSyncRequest
+		if err := json.Unmarshal(msg.Data, &req); err != nil {
+			fmt.Printf("timeSync unmarshal error: %v\n", err)
+			return
+		}
+		sendWS(s.conn, "timeSync", types.TimeSync{ClientTime: req.ClientTime, ServerTime: serverTime(time.Now())}, &s.writeMu)
+	case "moveRequest":
+		// 이동 요청 수신: 존이 목표 위치를 검증하고 이동 승인 메시지를 보낸다
+		var req types.MoveRequest
+		if err := json.Unmarshal(msg.Data, &req); err != nil {
+			fmt.Printf("moveRequest unmarshal error: %v\n", err)
+			return
+		}
+		s.sendToZone(c, moveEntity{EntityID: s.entityID, Target: req.Target})
+	case "snapshotAck":
+		var req types.SnapshotAck
+		if err := json.Unmarshal(msg.Data, &req); err != nil {
+			fmt.Printf("snapshotAck unmarshal error: %v\n", err)
+			return
+		}
+		s.sendToZone(c, ackSnapshot{EntityID: s.entityID, ZoneID: req.ZoneID, Tick: req.Tick})
+	case "usePortal":
+		var req types.UsePortalRequest
+		if err := json.Unmarshal(msg.Data, &req); err != nil {
+			fmt.Printf("usePortal unmarshal error: %v\n", err)
+			return
+		}
+		s.sendToZone(c, usePortal{EntityID: s.entityID, PortalID: req.PortalID})
+	case "attack":
+		var req types.AttackRequest
+		if err := json.Unmarshal(msg.Data, &req); err != nil {
+			fmt.Printf("attack unmarshal error: %v\n", err)
+			return
+		}
+		s.sendToZone(c, attackEntity{EntityID: s.entityID, TargetID: req.TargetID, ViewTime: s.latency.viewTime(req.ClientTime, req.RenderTime, time.Now())})
+	case "useAbility":
+		var req types.UseAbilityRequest
+		if err := json.Unmarshal(msg.Data, &req); err != nil {
+			fmt.Printf("useAbility unmarshal error: %v\n", err)
+			return
+		}
+		s.sendToZone(c, useAbility{EntityID: s.entityID, AbilityID: req.AbilityID, TargetID: req.TargetID, Position: req.Position})
+	case "abilityCancel":
+		s.sendToZone(c, cancelAbility{EntityID: s.entityID})
+	case "instanceCreate":
+		var req types.InstanceCreateRequest
+		if err := json.Unmarshal(msg.Data, &req); err != nil {
+			fmt.Printf("instanceCreate unmarshal error: %v\n", err)
+			return
+		}
+		c.Send(s.server.instances, createInstance{Session: s.pid, Username: s.username, Template: req.Template})
+	case "instanceInvite":
+		var req types.InstanceInviteRequest
+		if err := json.Unmarshal(msg.Data, &req); err != nil {
+			fmt.Printf("instanceInvite unmarshal error: %v\n", err)
+			return
+		}
+		c.Send(s.server.instances, inviteInstance{Session: s.pid, Username: s.username, InstanceID: req.InstanceID, Target: req.Username})
+	case "instanceJoin":
+		var req types.InstanceJoinRequest
+		if err := json.Unmarshal(msg.Data, &req); err != nil {
+			fmt.Printf("instanceJoin unmarshal error: %v\n", err)
+			return
+		}
+		c.Send(s.server.instances, joinInstance{Session: s.pid, Username: s.username, InstanceID: req.InstanceID})
+	case "matchQueue":
+		var req types.MatchQueueRequest
+		if err := json.Unmarshal(msg.Data, &req); err != nil {
+			fmt.Printf("matchQueue unmarshal error: %v\n", err)
+			return
+		}
+		c.Send(s.server.matchmaker, queueMatch{Session: s.pid, Username: s.username, Mode: req.Mode})
+	case "matchCancel":
+		c.Send(s.server.matchmaker, cancelMatchQueue{Session: s.pid, Username: s.username})
+	case "matchAccept", "matchDecline":
+		var req types.MatchResponse
+		if err := json.Unmarshal(msg.Data, &req); err != nil {
+			fmt.Printf("%s unmarshal error: %v\n", msg.Type, err)
+			return
+		}
+		c.Send(s.server.matchmaker, respondMatch{Username: s.username, MatchID: req.MatchID, Accept: msg.Type == "matchAccept"})
+	case "chatSend":
+		var req types.ChatSendRequest
+		if err := json.Unmarshal(msg.Data, &req); err != nil {
+			fmt.Printf("chatSend unmarshal error: %v\n", err)
+			return
+		}
+		c.Send(s.server.chat, chatSend{Session: s.pid, Username: s.username, EntityID: s.entityID, Zone: s.zone, ZoneID: s.zoneID, Req: req})
+	case "chatBlock", "chatUnblock":
+		var req types.ChatBlockRequest
+		if err := json.Unmarshal(msg.Data, &req); err != nil {
+			fmt.Printf("%s unmarshal error: %v\n", msg.Type, err)
+			return
+		}
+		action := types.FriendActionUnblock
+		if msg.Type == "chatBlock" {
+			action = types.FriendActionBlock
+		}
+		c.Send(s.server.friends, friendAction{Session: s.pid, Username: s.username, Target: req.Username, Action: action, Chat: true})
+	case "gmCommand":
+		var req types.GMCommandRequest
+		if err := json.Unmarshal(msg.Data, &req); err != nil {
+			fmt.Printf("gmCommand unmarshal error: %v\n", err)
+			return
+		}
+		c.Send(s.server.chat, gmCommand{Session: s.pid, Username: s.username, Req: req})
+	case "partyInvite":
+		var req types.PartyInviteRequest
+		if err := json.Unmarshal(msg.Data, &req); err != nil {
+			fmt.Printf("partyInvite unmarshal error: %v\n", err)
+			return
+		}
+		c.Send(s.server.parties, partyInvite{Session: s.pid, Username: s.username, Target: req.Username})
+	case "partyAccept", "partyDecline":
+		var req types.PartyInvitationResponse
+		if err := json.Unmarshal(msg.Data, &req); err != nil {
+			fmt.Printf("%s unmarshal error: %v\n", msg.Type, err)
+			return
+		}
+		c.Send(s.server.parties, partyRespond{Session: s.pid, Username: s.username, From: req.From, Accept: msg.Type == "partyAccept"})
+	case "partyKick", "partyPromote":
+		var req types.PartyMemberRequest
+		if err := json.Unmarshal(msg.Data, &req); err != nil {
+			fmt.Printf("%s unmarshal error: %v\n", msg.Type, err)
+			return
+		}
+		c.Send(s.server.parties, partyMemberAction{Session: s.pid, Username: s.username, Target: req.Username, Promote: msg.Type == "partyPromote"})
+	case "partyLeave":
+		c.Send(s.server.parties, partyLeave{Session: s.pid, Username: s.username})
+	case "friendRequest", "friendAccept", "friendDecline", "friendRemove", "friendBlock", "friendUnblock":
+		var req types.FriendRequest
+		if err := json.Unmarshal(msg.Data, &req); err != nil {
+			fmt.Printf("%s unmarshal error: %v\n", msg.Type, err)
+			return
+		}
+		c.Send(s.server.friends, friendAction{Session: s.pid, Username: s.username, Target: req.Username, Action: friendActions[msg.Type]})
+	case "guildCreate":
+		var req types.GuildCreateRequest
+		if err := json.Unmarshal(msg.Data, &req); err != nil {
+			fmt.Printf("guildCreate unmarshal error: %v\n", err)
+			return
+		}
+		s.sendGuild(c, guildRequest{Action: types.GuildActionCreate, Text: req.Name})
+	case "guildInvite", "guildKick":
+		var req types.GuildMemberRequest
+		if err := json.Unmarshal(msg.Data, &req); err != nil {
+			fmt.Printf("%s unmarshal error: %v\n", msg.Type, err)
+			return
+		}
+		action := types.GuildActionInvite
+		if msg.Type == "guildKick" {
+			action = types.GuildActionKick
+		}
+		s.sendGuild(c, guildRequest{Action: action, Target: req.Username})
+	case "guildAccept", "guildDecline":
+		var req types.GuildInvitationResponse
+		if err := json.Unmarshal(msg.Data, &req); err != nil {
+			fmt.Printf("%s unmarshal error: %v\n", msg.Type, err)
+			return
+		}
+		action := types.GuildActionAccept
+		if msg.Type == "guildDecline" {
+			action = types.GuildActionDecline
+		}
+		s.sendGuild(c, guildRequest{Action: action, GuildID: req.GuildID})
+	case "guildSetRank":
+		var req types.GuildSetRankRequest
+		if err := json.Unmarshal(msg.Data, &req); err != nil {
+			fmt.Printf("guildSetRank unmarshal error: %v\n", err)
+			return
+		}
+		s.sendGuild(c, guildRequest{Action: types.GuildActionSetRank, Target: req.Username, Rank: req.Rank})
+	case "guildMotd":
+		var req types.GuildMotdRequest
+		if err := json.Unmarshal(msg.Data, &req); err != nil {
+			fmt.Printf("guildMotd unmarshal error: %v\n", err)
+			return
+		}
+		s.sendGuild(c, guildRequest{Action: types.GuildActionMotd, Text: req.Text})
+	case "guildDisband":
+		s.sendGuild(c, guildRequest{Action: types.GuildActionDisband})
+	case "guildLeave":
+		s.sendGuild(c, guildRequest{Action: types.GuildActionLeave})
+	case "guildRoster":
+		c.Send(s.server.guilds, guildRosterRequest{Session: s.pid, Username: s.username})
+	case "instanceLeave":
+		c.Send(s.server.instances, leaveInstance{Session: s.pid, EntityID: s.entityID, ZoneID: s.zoneID})
+	}
+}
+
+// 클라이언트 메시지 종류 -> FriendResult.Action
+var friendActions = map[string]string{
+	"friendRequest": types.FriendActionRequest,
+	"friendAccept":  types.FriendActionAccept,
+	"friendDecline": types.FriendActionDecline,
+	"friendRemove":  types.FriendActionRemove,
+	"friendBlock":   types.FriendActionBlock,
+	"friendUnblock": types.FriendActionUnblock,
+}
+
+// 클라이언트 메시지 종류 -> RoomResult.Action
+var roomActions = map[string]string{
+	"roomCreate": types.RoomActionCreate,
+	"roomJoin":   types.RoomActionJoin,
+	"roomLeave":  types.RoomActionLeave,
+	"roomStart":  types.RoomActionStart,
+}
+
+// 로비 메시지 처리. 로비 메시지가 아니면 false.
+func (s *PlayerSession) handleLobbyMessage(c *actor.Context, msg types.WSMessage) bool {
+	switch msg.Type {
+	case "enterWorld", "roomList", "roomCreate", "roomJoin", "roomLeave", "roomStart":
+	default:
+		return false
+	}
+	if !s.inLobby {
+		c.Send(s.pid, wsSend{Type: "roomResult", Data: types.RoomResult{Action: roomActions[msg.Type], Code: types.LobbyErrNotInLobby}})
+		return true
+	}
+
+	switch msg.Type {
+	case "enterWorld":
+		s.enterWorld(c, "")
+	case "roomList":
+		c.Send(s.server.lobby, listRooms{Session: s.pid})
+	case "roomCreate":
+		var req types.RoomCreateRequest
+		if err := json.Unmarshal(msg.Data, &req); err != nil {
+			fmt.Printf("roomCreate unmarshal error: %v\n", err)
+			return true
+		}
+		c.Send(s.server.lobby, createRoom{Session: s.pid, Req: req})
+	case "roomJoin":
+		var req types.RoomJoinRequest
+		if err := json.Unmarshal(msg.Data, &req); err != nil {
+			fmt.Printf("roomJoin unmarshal error: %v\n", err)
+			return true
+		}
+		c.Send(s.server.lobby, joinRoom{Session: s.pid, Req: req})
+	case "roomLeave":
+		c.Send(s.server.lobby, leaveRoom{Session: s.pid})
+	case "roomStart":
+		c.Send(s.server.lobby, startRoom{Session: s.pid})
+	}
+	return true
+}
+
+// 길드 요청 전달 (보낸 세션과 사용자명을 채운다)
+func (s *PlayerSession) sendGuild(c *actor.Context, req guildRequest) {
+	req.Session = s.pid
+	req.Username = s.username
+	c.Send(s.server.guilds, req)
+}
+
+// 현재 존으로 메시지 전달 (존 이동 중이면 무시)
+func (s *PlayerSession) sendToZone(c *actor.Context, msg interface{}) {
+	if s.zone == nil {
+		return
+	}
+	c.Send(s.zone, msg)
+}
+
+// 유틸: 메시지 전송 (세션별 Mutex로 보호)
+func sendWS(conn *websocket.Conn, msgType string, v interface{}, mu *sync.Mutex) {
+	data, _ := json.Marshal(v)
+	msg := types.WSMessage{
+		Type: msgType,
+		Data: data,
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	conn.WriteJSON(msg)
+}
+
+func newPlayerSession(sid int, entityID int64, username string, conn *websocket.Conn, server *GameServer) actor.Producer {
+	return func() actor.Receiver {
+		return &PlayerSession{
+			conn:      conn,
+			sessionID: sid,
+			entityID:  entityID,
+			username:  username,
+			inLobby:   true,
+			server:    server,
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"math"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/SilverSS/gameserver/types"
+)
+
+// 스냅샷 델타 압축 (규약은 types/zone.go 의 EntityState 참고)
+// 존은 플레이어마다 최근에 보낸 스냅샷을 보관하고, 클라이언트가 받았다고 알린 가장 최근 스냅샷과
+// 달라진 필드만 보낸다. 받았다는 알림이 없거나 너무 오래되었으면 전체 스냅샷을 보낸다.
+
+// 스냅샷 수신 확인 (세션 -> 존). ZoneID 는 클라이언트가 스냅샷을 받은 존.
+type ackSnapshot struct {
+	EntityID int64
+	ZoneID   string
+	Tick     int64
+}
+
+// 엔티티 하나의 복제 상태 (위치는 양자화한 값이라, 1cm 미만의 움직임은 바뀐 것으로 보지 않는다)
+type netState struct {
+	kind, name string
+	position   types.QVector
+	target     types.QVector
+	health     int
+	maxHealth  int
+	moveState  int
+	effects    []types.StatusEffectInfo
+	effectKeys []effectKey // 남은 시간 대신 끝나는 시각으로 비교 (틱마다 바뀌지 않게)
+}
+
+type effectKey struct {
+	id        string
+	stacks    int
+	shield    int
+	sourceID  int64
+	expiresAt int64 // 서버 시각 (ms)
+}
+
+func newNetState(e *entity, now time.Time) *netState {
+	s := &netState{
+		kind:      e.kind,
+		name:      e.name,
+		position:  quantize(e.state.Position),
+		target:    quantize(e.state.Target),
+		health:    e.state.Health,
+		maxHealth: e.state.MaxHealth,
+		moveState: e.state.MoveState,
+		effects:   statusInfos(e, now),
+	}
+	for _, eff := range e.combat.effects {
+		s.effectKeys = append(s.effectKeys, effectKey{
+			id:        eff.def.ID,
+			stacks:    eff.stacks,
+			shield:    eff.shield,
+			sourceID:  eff.sourceID,
+			expiresAt: serverTime(eff.expiresAt),
+		})
+	}
+	return s
+}
+
+func quantize(v types.Vector) types.QVector {
+	q := func(f float32) int32 {
+		return int32(math.Round(float64(f) * types.PositionScale))
+	}
+	return types.QVector{q(v.X), q(v.Y), q(v.Z)}
+}
+
+// base 와 달라진 필드만 담은 상태 (base 가 nil 이면 모든 필드). 바뀐 것이 없으면 false.
+func (s *netState) delta(id int64, base *netState) (types.EntityState, bool) {
+	out := types.EntityState{ID: id}
+	if base == nil {
+		effects := append([]types.StatusEffectInfo{}, s.effects...)
+		out.Kind, out.Name = s.kind, s.name
+		out.Position, out.Target = &s.position, &s.target
+		out.Health, out.MaxHealth, out.MoveState = &s.health, &s.maxHealth, &s.moveState
+		out.Effects = &effects
+		return out, true
+	}
+	changed := false
+	if s.position != base.position {
+		out.Position, changed = &s.position, true
+	}
+	if s.target != base.target {
+		out.Target, changed = &s.target, true
+	}
+	if s.health != base.health {
+		out.Health, changed = &s.health, true
+	}
+	if s.maxHealth != base.maxHealth {
+		out.MaxHealth, changed = &s.maxHealth, true
+	}
+	if s.moveState != base.moveState {
+		out.MoveState, changed = &s.moveState, true
+	}
+	if !slices.Equal(s.effectKeys, base.effectKeys) {
+		effects := append([]types.StatusEffectInfo{}, s.effects...)
+		out.Effects, changed = &effects, true
+	}
+	return out, changed
+}
+
+// base 에서 cur 로 가는 스냅샷 델타 (base 가 nil 이면 전체 스냅샷). 엔티티와 사라진 ID 는 ID 순이다.
+func diffSnapshot(base, cur map[int64]*netState) (entities []types.EntityState, removed []int64) {
+	ids := make([]int64, 0, len(cur))
+	for id := range cur {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for _, id := range ids {
+		var prev *netState
+		if base != nil {
+			prev = base[id]
+		}
+		if d, ok := cur[id].delta(id, prev); ok {
+			entities = append(entities, d)
+		}
+	}
+	for id := range base {
+		if _, ok := cur[id]; !ok {
+			removed = append(removed, id)
+		}
+	}
+	sort.Slice(removed, func(i, j int) bool { return removed[i] < removed[j] })
+	return entities, removed
+}
+
+// 한 플레이어에게 보낸 스냅샷
+type sentSnapshot struct {
+	tick   int64
+	states map[int64]*netState // 엔티티 ID -> 상태 (다른 플레이어의 기록과 공유하므로 고치지 않는다)
+}
+
+// 플레이어에게 보낸 스냅샷 기록과 기준 스냅샷
+type snapshotLog struct {
+	sent  []sentSnapshot // tick 순. 기준 스냅샷과 그 뒤에 보낸 것만 남긴다.
+	acked int64          // 클라이언트가 받았다고 알린 가장 최근 tick (0 이면 없음)
+}
+
+// tick 을 보낼 때 쓸 기준 스냅샷 (없거나 너무 오래되었으면 nil, 0)
+func (l *snapshotLog) baseline(tick int64) (map[int64]*netState, int64) {
+	if l.acked == 0 || tick-l.acked > types.SnapshotBacklog {
+		return nil, 0
+	}
+	for _, s := range l.sent {
+		if s.tick == l.acked {
+			return s.states, s.tick
+		}
+	}
+	return nil, 0
+}
+
+func (l *snapshotLog) add(tick int64, states map[int64]*netState) {
+	// 기준 스냅샷보다 오래되었거나 SnapshotBacklog 를 넘긴 기록은 다시 쓰이지 않는다
+	keep := l.sent[:0]
+	for _, s := range l.sent {
+		if s.tick >= l.acked && tick-s.tick < types.SnapshotBacklog {
+			keep = append(keep, s)
+		}
+	}
+	l.sent = append(keep, sentSnapshot{tick: tick, states: states})
+}
+
+// 보낸 적 있는 tick 만 받는다 (늦게 온 오래된 확인은 무시)
+func (l *snapshotLog) ack(tick int64) {
+	if tick <= l.acked {
+		return
+	}
+	for _, s := range l.sent {
+		if s.tick == tick {
+			l.acked = tick
+			return
+		}
+	}
+}
+
+// 존을 옮기는 동안 이전 존의 확인이 늦게 올 수 있다. tick 은 존마다 따로 세어 번호가 겹치므로,
+// 다른 존의 확인을 받으면 클라이언트가 받지 않은 스냅샷을 기준으로 델타를 보내게 된다.
+func (z *Zone) ackSnapshot(msg ackSnapshot) {
+	if msg.ZoneID != z.def.ID {
+		return
+	}
+	if e, ok := z.entities[msg.EntityID]; ok {
+		e.snapshots.ack(msg.Tick)
+	}
+}
@@ -0,0 +1,294 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/SilverSS/gameserver/types"
+)
+
+func testEntity(id int64, pos types.Vector) *entity {
+	return &entity{
+		id:    id,
+		kind:  types.EntityKindPlayer,
+		name:  fmt.Sprintf("p%d", id),
+		state: types.PlayerState{Health: 100, MaxHealth: 100, Position: pos, Target: pos},
+	}
+}
+
+func netStates(now time.Time, es ...*entity) map[int64]*netState {
+	states := make(map[int64]*netState, len(es))
+	for _, e := range es {
+		states[e.id] = newNetState(e, now)
+	}
+	return states
+}
+
+// 모든 필드가 담긴 엔티티 상태인지 (전체 스냅샷)
+func isFull(s types.EntityState) bool {
+	return s.Kind != "" && s.Position != nil && s.Target != nil && s.Health != nil &&
+		s.MaxHealth != nil && s.MoveState != nil && s.Effects != nil
+}
+
+func TestSnapshotDeltaAgainstAckedBaseline(t *testing.T) {
+	now := time.Now()
+	a := testEntity(1, types.Vector{X: 1})
+	b := testEntity(2, types.Vector{X: 2})
+	c := testEntity(3, types.Vector{X: 3})
+
+	var log snapshotLog
+	log.add(1, netStates(now, a, b, c))
+	log.ack(1)
+
+	// a 는 움직이고, b 는 피해를 받고, c 는 시야에서 사라졌다
+	a.state.Position.X = 1.5
+	b.state.Health = 90
+	base, baseTick := log.baseline(2)
+	if base == nil || baseTick != 1 {
+		t.Fatalf("baseline(2) = %v, %d; want tick 1", base, baseTick)
+	}
+	entities, removed := diffSnapshot(base, netStates(now, a, b))
+
+	if len(entities) != 2 {
+		t.Fatalf("got %d entities, want 2", len(entities))
+	}
+	da, db := entities[0], entities[1]
+	if da.ID != 1 || da.Position == nil || *da.Position != quantize(a.state.Position) {
+		t.Errorf("entity 1: want only the new position, got %+v", da)
+	}
+	if da.Health != nil || da.Target != nil || da.Kind != "" || da.Effects != nil {
+		t.Errorf("entity 1: unchanged fields sent: %+v", da)
+	}
+	if db.ID != 2 || db.Health == nil || *db.Health != 90 || db.Position != nil {
+		t.Errorf("entity 2: want only health 90, got %+v", db)
+	}
+	if len(removed) != 1 || removed[0] != 3 {
+		t.Errorf("removed = %v, want [3]", removed)
+	}
+}
+
+func TestSnapshotUnchangedEntityOmitted(t *testing.T) {
+	now := time.Now()
+	e := testEntity(1, types.Vector{X: 1})
+	base := netStates(now, e)
+	// 양자화 단위(1cm)보다 작은 움직임은 바뀐 것으로 보지 않는다
+	e.state.Position.X += 0.001
+	entities, removed := diffSnapshot(base, netStates(now, e))
+	if len(entities) != 0 || len(removed) != 0 {
+		t.Errorf("got %v, %v; want an empty delta", entities, removed)
+	}
+}
+
+func TestSnapshotFullWithoutBaseline(t *testing.T) {
+	now := time.Now()
+	e := testEntity(1, types.Vector{X: 1})
+
+	tests := []struct {
+		name string
+		log  func() *snapshotLog
+		tick int64
+	}{
+		{"never acked", func() *snapshotLog {
+			l := &snapshotLog{}
+			l.add(1, netStates(now, e))
+			return l
+		}, 2},
+		{"baseline too old", func() *snapshotLog {
+			l := &snapshotLog{}
+			l.add(1, netStates(now, e))
+			l.ack(1)
+			return l
+		}, 2 + types.SnapshotBacklog},
+		{"acked tick never sent", func() *snapshotLog {
+			l := &snapshotLog{}
+			l.add(1, netStates(now, e))
+			l.ack(5)
+			return l
+		}, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			base, baseTick := tt.log().baseline(tt.tick)
+			if base != nil || baseTick != 0 {
+				t.Fatalf("baseline(%d) = %v, %d; want nil, 0", tt.tick, base, baseTick)
+			}
+			entities, _ := diffSnapshot(base, netStates(now, e))
+			if len(entities) != 1 || !isFull(entities[0]) {
+				t.Errorf("want a full state for every entity, got %+v", entities)
+			}
+		})
+	}
+}
+
+func TestSnapshotLogAck(t *testing.T) {
+	now := time.Now()
+	var log snapshotLog
+	for tick := int64(1); tick <= 3; tick++ {
+		log.add(tick, netStates(now, testEntity(1, types.Vector{X: float32(tick)})))
+	}
+	log.ack(3)
+	// 늦게 온 오래된 확인은 기준 스냅샷을 되돌리지 않는다
+	log.ack(2)
+	if _, tick := log.baseline(4); tick != 3 {
+		t.Errorf("baseline tick = %d, want 3", tick)
+	}
+	// 기준 스냅샷보다 오래된 기록은 다음에 보낼 때 정리된다
+	log.add(4, netStates(now, testEntity(1, types.Vector{X: 4})))
+	if len(log.sent) != 2 || log.sent[0].tick != 3 {
+		t.Errorf("sent ticks after pruning: %v, want [3 4]", sentTicks(log.sent))
+	}
+}
+
+// 존을 옮기는 동안 늦게 온 이전 존의 확인은 tick 이 겹쳐도 받지 않는다
+func TestZoneIgnoresAckFromOtherZone(t *testing.T) {
+	e := testEntity(1, types.Vector{})
+	z := collisionZone(testZoneDef("dungeon-1", types.Vector{}), e)
+	e.snapshots.add(5, netStates(time.Now(), e))
+
+	z.ackSnapshot(ackSnapshot{EntityID: 1, ZoneID: "town", Tick: 5})
+	z.ackSnapshot(ackSnapshot{EntityID: 1, Tick: 5})
+	if e.snapshots.acked != 0 {
+		t.Fatalf("acked = %d after acks from another zone, want 0", e.snapshots.acked)
+	}
+	z.ackSnapshot(ackSnapshot{EntityID: 1, ZoneID: "dungeon-1", Tick: 5})
+	if e.snapshots.acked != 5 {
+		t.Errorf("acked = %d, want 5", e.snapshots.acked)
+	}
+}
+
+func sentTicks(sent []sentSnapshot) []int64 {
+	ticks := make([]int64, len(sent))
+	for i, s := range sent {
+		ticks[i] = s.tick
+	}
+	return ticks
+}
+
+// 델타 압축 이전의 "entityUpdate" 메시지 (크기 비교 기준)
+type legacyEntityUpdate struct {
+	Entities   []legacyEntityState `json:"entities"`
+	Tick       int64               `json:"tick"`
+	ServerTime int64               `json:"serverTime"`
+}
+
+type legacyEntityState struct {
+	ID      int64                    `json:"id"`
+	Kind    string                   `json:"kind"`
+	Name    string                   `json:"name"`
+	State   types.PlayerState        `json:"state"`
+	Effects []types.StatusEffectInfo `json:"effects,omitempty"`
+}
+
+// 클라이언트에게 실제로 보내는 WSMessage 의 크기 (sendWS 와 같은 방식으로 인코딩)
+func wireSize(msgType string, v interface{}) int {
+	data, _ := json.Marshal(v)
+	msg, _ := json.Marshal(types.WSMessage{Type: msgType, Data: data})
+	return len(msg)
+}
+
+// 한 플레이어의 시야 안에서 엔티티들이 돌아다니는 존
+type benchZone struct {
+	rng      *rand.Rand
+	entities []*entity
+	movers   int
+	hitRate  float64
+}
+
+func newBenchZone(count int, moving, hitRate float64) *benchZone {
+	z := &benchZone{rng: rand.New(rand.NewSource(1)), movers: int(float64(count) * moving), hitRate: hitRate}
+	for i := 0; i < count; i++ {
+		e := testEntity(int64(i+1), z.randomPos())
+		if i%2 == 1 {
+			e.kind = types.EntityKindMonster
+		}
+		if i < z.movers {
+			e.setPath([]types.Vector{z.randomPos()})
+		}
+		z.entities = append(z.entities, e)
+	}
+	return z
+}
+
+func (z *benchZone) randomPos() types.Vector {
+	return types.Vector{X: z.rng.Float32()*200 - 100, Z: z.rng.Float32()*200 - 100}
+}
+
+// 한 틱 진행: 움직이는 엔티티는 이동하고 (도착하면 새 목적지로), 가끔 피해를 받는다
+func (z *benchZone) step(dt float32) {
+	for i, e := range z.entities {
+		if e.moving {
+			stepMovement(e, dt)
+		} else if i < z.movers {
+			e.setPath([]types.Vector{z.randomPos()})
+		}
+		if z.rng.Float64() < z.hitRate {
+			e.state.Health -= 1 + z.rng.Intn(20)
+			if e.state.Health <= 0 {
+				e.state.Health = e.state.MaxHealth
+			}
+		}
+	}
+}
+
+type benchScenario struct {
+	name     string
+	entities int
+	moving   float64 // 계속 돌아다니는 엔티티 비율
+	ackDelay int64   // 스냅샷을 보낸 뒤 snapshotAck 가 도착하기까지 걸리는 틱 수 (RTT)
+	ackLoss  float64 // 도착하지 않는 snapshotAck 비율
+}
+
+var benchScenarios = []benchScenario{
+	{name: "50entities", entities: 50, moving: 0.3, ackDelay: 2},
+	{name: "100moving_ackloss10", entities: 100, moving: 1, ackDelay: 2, ackLoss: 0.1},
+}
+
+// 틱마다 한 플레이어에게 보내는 주변 상태의 평균 크기를 bytes/op 로 보고한다.
+//
+//	entityUpdate  델타 압축 이전 방식 (틱마다 모든 엔티티의 PlayerState 전체)
+//	full          snapshot 전체 스냅샷 (snapshotAck 를 보내지 않는 클라이언트)
+//	delta         snapshot 델타 (ackDelay 틱 뒤에 snapshotAck 가 도착)
+func benchmarkSnapshot(b *testing.B, format string) {
+	for _, sc := range benchScenarios {
+		b.Run(sc.name, func(b *testing.B) {
+			z := newBenchZone(sc.entities, sc.moving, 0.02)
+			var sent snapshotLog
+			now := time.Now()
+			total := 0
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				tick := int64(i + 1)
+				now = now.Add(defaultZoneTick)
+				z.step(float32(defaultZoneTick.Seconds()))
+				switch format {
+				case "entityUpdate":
+					msg := legacyEntityUpdate{Tick: tick, ServerTime: serverTime(now)}
+					for _, e := range z.entities {
+						msg.Entities = append(msg.Entities, legacyEntityState{ID: e.id, Kind: e.kind, Name: e.name, State: e.state})
+					}
+					total += wireSize("entityUpdate", msg)
+				case "full":
+					entities, _ := diffSnapshot(nil, netStates(now, z.entities...))
+					total += wireSize("snapshot", types.Snapshot{Tick: tick, ServerTime: serverTime(now), Entities: entities})
+				case "delta":
+					cur := netStates(now, z.entities...)
+					base, baseTick := sent.baseline(tick)
+					entities, removed := diffSnapshot(base, cur)
+					sent.add(tick, cur)
+					total += wireSize("snapshot", types.Snapshot{Tick: tick, ServerTime: serverTime(now), Baseline: baseTick, Entities: entities, Removed: removed})
+					if acked := tick - sc.ackDelay; acked >= 1 && z.rng.Float64() >= sc.ackLoss {
+						sent.ack(acked)
+					}
+				}
+			}
+			b.ReportMetric(float64(total)/float64(b.N), "bytes/op")
+		})
+	}
+}
+
+func BenchmarkSnapshotLegacy(b *testing.B) { benchmarkSnapshot(b, "entityUpdate") }
+func BenchmarkSnapshotFull(b *testing.B)   { benchmarkSnapshot(b, "full") }
+func BenchmarkSnapshotDelta(b *testing.B)  { benchmarkSnapshot(b, "delta") }
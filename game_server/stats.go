@@ -0,0 +1,157 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/SilverSS/gameserver/types"
+	"github.com/anthdm/hollywood/actor"
+)
+
+// 능력치 규칙 (data/stats.json)
+// 최대 체력 = 체력(stamina) * HealthPerStamina, 공격력 = 힘(strength) * PowerPerStrength.
+// 수정치는 기본 능력치에 먼저 붙고, 파생 능력치는 수정된 기본 능력치로 계산한 뒤 다시 수정치를 붙인다.
+type statRules struct {
+	Base             statBase `json:"base"`
+	HealthPerStamina float32  `json:"healthPerStamina"`
+	PowerPerStrength float32  `json:"powerPerStrength"`
+}
+
+// 수정치가 없을 때의 기본 능력치 (플레이어는 모두 같고, NPC 는 정의마다 다르다)
+type statBase struct {
+	Stamina   float32 `json:"stamina"`
+	Strength  float32 `json:"strength"`
+	MoveSpeed float32 `json:"moveSpeed"`
+}
+
+func (b *statBase) validate() error {
+	if b.Stamina < 0 || b.Strength < 0 || b.MoveSpeed <= 0 {
+		return fmt.Errorf("invalid base stats")
+	}
+	return nil
+}
+
+func (r *statRules) validate() error {
+	b := r.Base
+	if err := b.validate(); err != nil {
+		return err
+	}
+	if r.HealthPerStamina <= 0 || r.PowerPerStrength < 0 {
+		return fmt.Errorf("invalid healthPerStamina or powerPerStrength")
+	}
+	if b.Stamina*r.HealthPerStamina < 1 {
+		return fmt.Errorf("base maxHealth must be at least 1")
+	}
+	return nil
+}
+
+func loadStatRules() (*statRules, error) {
+	var r statRules
+	if err := readDataJSON("stats.json", &r); err != nil {
+		return nil, err
+	}
+	if err := r.validate(); err != nil {
+		return nil, fmt.Errorf("stats.json: %w", err)
+	}
+	return &r, nil
+}
+
+// 능력치 수정치. 같은 능력치의 Add 는 모두 더하고 Mul 은 모두 곱한다.
+// 데이터 파일에서 mul 을 생략하면 1 로 본다.
+type statModifier struct {
+	Stat string  `json:"stat"` // types.Stat*
+	Add  float32 `json:"add"`
+	Mul  float32 `json:"mul"`
+}
+
+func (m *statModifier) validate() error {
+	switch m.Stat {
+	case types.StatStamina, types.StatStrength, types.StatMaxHealth, types.StatAttackPower, types.StatMoveSpeed:
+	default:
+		return fmt.Errorf("unknown stat %q", m.Stat)
+	}
+	if m.Mul < 0 {
+		return fmt.Errorf("stat %s: mul must not be negative", m.Stat)
+	}
+	if m.Mul == 0 {
+		m.Mul = 1
+	}
+	return nil
+}
+
+// 기본 능력치에 수정치 목록을 반영한 능력치 계산
+func (r *statRules) compute(base statBase, mods []statModifier) types.CharacterStats {
+	add := make(map[string]float32)
+	mul := map[string]float32{
+		types.StatStamina:     1,
+		types.StatStrength:    1,
+		types.StatMaxHealth:   1,
+		types.StatAttackPower: 1,
+		types.StatMoveSpeed:   1,
+	}
+	for _, m := range mods {
+		add[m.Stat] += m.Add
+		mul[m.Stat] *= m.Mul
+	}
+	stat := func(name string, v float32) float32 {
+		return max((v+add[name])*mul[name], 0)
+	}
+	stamina := stat(types.StatStamina, base.Stamina)
+	strength := stat(types.StatStrength, base.Strength)
+	return types.CharacterStats{
+		Stamina:     int(stamina),
+		Strength:    int(strength),
+		MaxHealth:   max(int(stat(types.StatMaxHealth, stamina*r.HealthPerStamina)), 1),
+		AttackPower: int(stat(types.StatAttackPower, strength*r.PowerPerStrength)),
+		MoveSpeed:   stat(types.StatMoveSpeed, base.MoveSpeed),
+	}
+}
+
+// 엔티티에 붙은 수정치 전체. 지금은 상태 효과뿐이고, 장비가 생기면 장착한 아이템의 수정치도 여기서 모은다.
+func (e *entity) statModifiers() []statModifier {
+	var mods []statModifier
+	for _, eff := range e.combat.effects {
+		mods = append(mods, eff.modifiers()...)
+	}
+	return mods
+}
+
+// 존 입장(NPC 는 스폰) 시 능력치 계산과 체력 초기화. 처음이면 최대 체력으로 채운다.
+// 죽은 채 존을 옮겼으면 죽은 상태 그대로 두고, 원래 부활 시각에 도착한 존의 스폰 위치에서 부활한다.
+func (r *combatRules) initStats(e *entity) {
+	first := e.state.MaxHealth <= 0
+	e.stats = r.stats.compute(e.base, e.statModifiers())
+	e.state.MaxHealth = e.stats.MaxHealth
+	if first {
+		e.state.Health = e.state.MaxHealth
+	}
+	e.state.Health = min(e.state.Health, e.state.MaxHealth)
+}
+
+// 수정치가 바뀌었을 수 있을 때 능력치를 다시 계산한다.
+// 바뀌었으면 자신에게 알리고, 최대 체력이 줄면 체력을 맞추고, 이동 중이면 새 속도로 이동 승인을 다시 보낸다.
+func (z *Zone) refreshStats(c *actor.Context, e *entity) {
+	stats := z.rules.stats.compute(e.base, e.statModifiers())
+	if stats == e.stats {
+		return
+	}
+	old := e.stats
+	e.stats = stats
+	if stats.MaxHealth != old.MaxHealth {
+		e.state.MaxHealth = stats.MaxHealth
+		e.state.Health = min(e.state.Health, stats.MaxHealth)
+		z.sendHealth(c, e)
+	}
+	if stats.MoveSpeed != old.MoveSpeed && e.moving {
+		z.sendMoveApproved(c, e)
+	}
+	z.sendStats(c, e)
+}
+
+func (z *Zone) sendStats(c *actor.Context, e *entity) {
+	z.send(c, e, "statsUpdate", types.StatsUpdate{
+		Base:       z.rules.stats.compute(e.base, nil),
+		Stats:      e.stats,
+		Tick:       z.ticks,
+		ServerTime: z.stateTime(),
+	})
+}
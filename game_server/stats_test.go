@@ -0,0 +1,75 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/SilverSS/gameserver/types"
+)
+
+func TestComputeStats(t *testing.T) {
+	rules := testCombatRules().stats
+	mod := func(stat string, add, mul float32) statModifier {
+		return statModifier{Stat: stat, Add: add, Mul: mul}
+	}
+	tests := []struct {
+		name string
+		mods []statModifier
+		want types.CharacterStats
+	}{
+		{"base", nil, types.CharacterStats{Stamina: 10, Strength: 5, MaxHealth: 100, AttackPower: 10, MoveSpeed: 5}},
+		{"stamina add raises max health", []statModifier{mod(types.StatStamina, 5, 1)},
+			types.CharacterStats{Stamina: 15, Strength: 5, MaxHealth: 150, AttackPower: 10, MoveSpeed: 5}},
+		{"add before mul", []statModifier{mod(types.StatStamina, 5, 1), mod(types.StatStamina, 0, 2)},
+			types.CharacterStats{Stamina: 30, Strength: 5, MaxHealth: 300, AttackPower: 10, MoveSpeed: 5}},
+		{"derived stat modified after base", []statModifier{mod(types.StatStrength, 5, 1), mod(types.StatAttackPower, 4, 1.5)},
+			types.CharacterStats{Stamina: 10, Strength: 10, MaxHealth: 100, AttackPower: 36, MoveSpeed: 5}},
+		{"muls multiply", []statModifier{mod(types.StatMoveSpeed, 0, 0.5), mod(types.StatMoveSpeed, 0, 0.5)},
+			types.CharacterStats{Stamina: 10, Strength: 5, MaxHealth: 100, AttackPower: 10, MoveSpeed: 1.25}},
+		{"negative clamps to zero", []statModifier{mod(types.StatStrength, -20, 1)},
+			types.CharacterStats{Stamina: 10, Strength: 0, MaxHealth: 100, AttackPower: 0, MoveSpeed: 5}},
+		{"max health at least one", []statModifier{mod(types.StatStamina, 0, 0)},
+			types.CharacterStats{Stamina: 0, Strength: 5, MaxHealth: 1, AttackPower: 10, MoveSpeed: 5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rules.compute(rules.Base, tt.mods); got != tt.want {
+				t.Errorf("compute = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStatModifierValidate(t *testing.T) {
+	m := statModifier{Stat: types.StatStrength, Add: 3}
+	if err := m.validate(); err != nil || m.Mul != 1 {
+		t.Errorf("omitted mul: %v, mul = %v; want 1", err, m.Mul)
+	}
+	for _, bad := range []statModifier{{Stat: "luck", Mul: 1}, {Stat: types.StatMoveSpeed, Mul: -1}} {
+		if err := bad.validate(); err == nil {
+			t.Errorf("validate(%+v) succeeded", bad)
+		}
+	}
+}
+
+func TestInitStatsClampsHealth(t *testing.T) {
+	rules := testCombatRules()
+	e := testEntity(1, types.Vector{})
+	e.base = rules.stats.Base
+	e.state.MaxHealth, e.state.Health = 0, 0
+	rules.initStats(e)
+	if e.state.Health != 100 || e.state.MaxHealth != 100 {
+		t.Fatalf("first init: health %d/%d, want full 100", e.state.Health, e.state.MaxHealth)
+	}
+
+	// 존을 옮긴 뒤에는 체력을 유지하되 새 최대 체력을 넘지 않는다
+	e.state.Health = 40
+	rules.initStats(e)
+	if e.state.Health != 40 {
+		t.Errorf("health = %d after transfer, want 40 kept", e.state.Health)
+	}
+	e.base.Stamina = 3
+	rules.initStats(e)
+	if e.state.Health != 30 || e.state.MaxHealth != 30 {
+		t.Errorf("health %d/%d, want clamped to 30/30", e.state.Health, e.state.MaxHealth)
+	}
+}
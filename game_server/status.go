@@ -0,0 +1,369 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/SilverSS/gameserver/types"
+	"github.com/anthdm/hollywood/actor"
+)
+
+// 같은 효과가 다시 걸릴 때의 처리 (statusDef.Stacking)
+const (
+	stackRefresh = "refresh" // 지속 시간만 처음부터 다시
+	stackAdd     = "stack"   // 중첩을 하나 늘리고 (MaxStacks 까지) 지속 시간을 다시
+	stackIgnore  = "ignore"  // 걸려 있는 동안에는 무시
+)
+
+// 상태 효과 정의 (data/status_effects.json)
+// Magnitude 는 slow/haste 의 이동 속도 배율 (중첩마다 곱함), Amount 는 dot/hot 의 틱당 양 (중첩마다 더함)
+// 또는 shield 의 흡수량이다. Modifiers 는 어느 종류에나 붙일 수 있는 능력치 수정치다 (중첩마다 반복 적용).
+type statusDef struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Kind        string  `json:"kind"` // types.StatusKind*
+	DurationMs  int     `json:"durationMs"`
+	TickMs      int     `json:"tickMs"` // dot, hot
+	Amount      int     `json:"amount"`
+	Magnitude   float32 `json:"magnitude"`
+	Stacking    string  `json:"stacking"`
+	MaxStacks   int     `json:"maxStacks"` // stack 일 때만
+	Dispellable bool    `json:"dispellable"`
+
+	Modifiers []statModifier `json:"modifiers"`
+}
+
+func (d *statusDef) duration() time.Duration {
+	return time.Duration(d.DurationMs) * time.Millisecond
+}
+
+func (d *statusDef) tickInterval() time.Duration {
+	return time.Duration(d.TickMs) * time.Millisecond
+}
+
+// 이로운 효과인지 (해제 대상 구분). 능력치 효과는 수정치가 모두 능력치를 올릴 때만 이롭다.
+func (d *statusDef) buff() bool {
+	switch d.Kind {
+	case types.StatusKindHoT, types.StatusKindShield, types.StatusKindHaste:
+		return true
+	case types.StatusKindStats:
+		for _, m := range d.Modifiers {
+			if m.Add < 0 || m.Mul < 1 {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func (d *statusDef) validate() error {
+	if d.DurationMs <= 0 {
+		return fmt.Errorf("status %s: durationMs must be positive", d.ID)
+	}
+	switch d.Kind {
+	case types.StatusKindSlow:
+		if d.Magnitude <= 0 || d.Magnitude >= 1 {
+			return fmt.Errorf("status %s: slow magnitude must be between 0 and 1", d.ID)
+		}
+	case types.StatusKindHaste:
+		if d.Magnitude <= 1 {
+			return fmt.Errorf("status %s: haste magnitude must be greater than 1", d.ID)
+		}
+	case types.StatusKindDoT, types.StatusKindHoT:
+		if d.TickMs <= 0 || d.Amount <= 0 {
+			return fmt.Errorf("status %s: tickMs and amount must be positive", d.ID)
+		}
+	case types.StatusKindShield:
+		if d.Amount <= 0 {
+			return fmt.Errorf("status %s: amount must be positive", d.ID)
+		}
+	case types.StatusKindStats:
+		if len(d.Modifiers) == 0 {
+			return fmt.Errorf("status %s: modifiers required", d.ID)
+		}
+	case types.StatusKindStun:
+	default:
+		return fmt.Errorf("status %s: unknown kind %q", d.ID, d.Kind)
+	}
+	for i := range d.Modifiers {
+		if err := d.Modifiers[i].validate(); err != nil {
+			return fmt.Errorf("status %s: %w", d.ID, err)
+		}
+	}
+	switch d.Stacking {
+	case stackRefresh, stackIgnore:
+	case stackAdd:
+		if d.MaxStacks < 1 {
+			return fmt.Errorf("status %s: maxStacks must be positive", d.ID)
+		}
+	default:
+		return fmt.Errorf("status %s: unknown stacking %q", d.ID, d.Stacking)
+	}
+	return nil
+}
+
+// 상태 효과 정의 전체 로드
+func loadStatusEffects() (map[string]*statusDef, error) {
+	var list []*statusDef
+	if err := readDataJSON("status_effects.json", &list); err != nil {
+		return nil, err
+	}
+	statuses := make(map[string]*statusDef, len(list))
+	for _, d := range list {
+		if d.ID == "" {
+			return nil, fmt.Errorf("status_effects.json: missing id")
+		}
+		if _, dup := statuses[d.ID]; dup {
+			return nil, fmt.Errorf("status_effects.json: duplicate status %q", d.ID)
+		}
+		if err := d.validate(); err != nil {
+			return nil, fmt.Errorf("status_effects.json: %w", err)
+		}
+		statuses[d.ID] = d
+	}
+	return statuses, nil
+}
+
+// 엔티티에 걸려 있는 상태 효과. 같은 정의는 하나만 걸린다 (걸어 준 엔티티와 무관).
+type statusEffect struct {
+	def       *statusDef
+	sourceID  int64
+	stacks    int
+	expiresAt time.Time
+	nextTick  time.Time // dot, hot
+	shield    int       // shield: 남은 흡수량
+}
+
+// 효과 적용. 발생한 이벤트 종류를 돌려주고, 무시되었으면 빈 문자열.
+func applyStatus(e *entity, def *statusDef, sourceID int64, now time.Time) (*statusEffect, string) {
+	if cur := e.statusEffect(def.ID); cur != nil {
+		switch def.Stacking {
+		case stackIgnore:
+			return cur, ""
+		case stackAdd:
+			cur.stacks = min(cur.stacks+1, def.MaxStacks)
+		}
+		cur.sourceID = sourceID
+		cur.expiresAt = now.Add(def.duration())
+		cur.shield = def.Amount
+		return cur, types.StatusEventRefreshed
+	}
+	eff := &statusEffect{
+		def:       def,
+		sourceID:  sourceID,
+		stacks:    1,
+		expiresAt: now.Add(def.duration()),
+		nextTick:  now.Add(def.tickInterval()),
+		shield:    def.Amount,
+	}
+	e.combat.effects = append(e.combat.effects, eff)
+	return eff, types.StatusEventApplied
+}
+
+func (e *entity) statusEffect(id string) *statusEffect {
+	for _, eff := range e.combat.effects {
+		if eff.def.ID == id {
+			return eff
+		}
+	}
+	return nil
+}
+
+func (e *entity) removeStatus(eff *statusEffect) {
+	for i, cur := range e.combat.effects {
+		if cur == eff {
+			e.combat.effects = append(e.combat.effects[:i], e.combat.effects[i+1:]...)
+			return
+		}
+	}
+}
+
+func (e *entity) stunned() bool {
+	for _, eff := range e.combat.effects {
+		if eff.def.Kind == types.StatusKindStun {
+			return true
+		}
+	}
+	return false
+}
+
+// 효과가 주는 능력치 수정치 (기절이면 이동 속도 0, 감속/가속은 이동 속도 배율)
+func (eff *statusEffect) modifiers() []statModifier {
+	var mods []statModifier
+	switch eff.def.Kind {
+	case types.StatusKindStun:
+		mods = append(mods, statModifier{Stat: types.StatMoveSpeed, Mul: 0})
+	case types.StatusKindSlow, types.StatusKindHaste:
+		mul := float32(math.Pow(float64(eff.def.Magnitude), float64(eff.stacks)))
+		mods = append(mods, statModifier{Stat: types.StatMoveSpeed, Mul: mul})
+	}
+	for _, m := range eff.def.Modifiers {
+		mods = append(mods, statModifier{
+			Stat: m.Stat,
+			Add:  m.Add * float32(eff.stacks),
+			Mul:  float32(math.Pow(float64(m.Mul), float64(eff.stacks))),
+		})
+	}
+	return mods
+}
+
+// 보호막으로 피해를 흡수한다. 먼저 걸린 보호막부터 소모하고, 다 쓴 보호막을 돌려준다.
+func absorbDamage(e *entity, amount int) (absorbed int, depleted []*statusEffect) {
+	for _, eff := range e.combat.effects {
+		if eff.def.Kind != types.StatusKindShield || absorbed == amount {
+			continue
+		}
+		n := min(eff.shield, amount-absorbed)
+		eff.shield -= n
+		absorbed += n
+		if eff.shield == 0 {
+			depleted = append(depleted, eff)
+		}
+	}
+	for _, eff := range depleted {
+		e.removeStatus(eff)
+	}
+	return absorbed, depleted
+}
+
+// 해제 가능한 효과 제거 (buffs 가 true 면 이로운 효과, 아니면 해로운 효과)
+func dispelStatuses(e *entity, buffs bool) []*statusEffect {
+	var removed []*statusEffect
+	for _, eff := range e.combat.effects {
+		if eff.def.Dispellable && eff.def.buff() == buffs {
+			removed = append(removed, eff)
+		}
+	}
+	for _, eff := range removed {
+		e.removeStatus(eff)
+	}
+	return removed
+}
+
+func statusInfo(eff *statusEffect, now time.Time) types.StatusEffectInfo {
+	info := types.StatusEffectInfo{
+		ID:          eff.def.ID,
+		Kind:        eff.def.Kind,
+		Buff:        eff.def.buff(),
+		Stacks:      eff.stacks,
+		DurationMs:  eff.def.DurationMs,
+		RemainingMs: int(max(eff.expiresAt.Sub(now), 0) / time.Millisecond),
+		SourceID:    eff.sourceID,
+	}
+	if eff.def.Kind == types.StatusKindShield {
+		info.Shield = eff.shield
+	}
+	return info
+}
+
+// 걸려 있는 효과 목록 (없으면 nil)
+func statusInfos(e *entity, now time.Time) []types.StatusEffectInfo {
+	if len(e.combat.effects) == 0 {
+		return nil
+	}
+	infos := make([]types.StatusEffectInfo, len(e.combat.effects))
+	for i, eff := range e.combat.effects {
+		infos[i] = statusInfo(eff, now)
+	}
+	return infos
+}
+
+// 상태 효과를 걸고 주변에 알린다. 기절이면 시전과 이동을 멈춘다.
+func (z *Zone) applyStatusEffect(c *actor.Context, src, tgt *entity, def *statusDef, now time.Time) {
+	eff, ev := applyStatus(tgt, def, src.id, now)
+	if ev == "" {
+		return
+	}
+	z.sendStatus(c, tgt, eff, ev, "", now)
+	if def.Kind == types.StatusKindStun {
+		if cast := tgt.combat.cast; cast != nil {
+			z.interruptCast(c, tgt, cast.ability, types.CastInterruptStunned)
+		}
+		if tgt.moving {
+			tgt.stopMoving()
+			z.sendCorrection(c, tgt, now)
+		}
+	}
+	z.refreshStats(c, tgt)
+}
+
+// 해제: 적대 대상이면 이로운 효과를, 아니면 해로운 효과를 지운다.
+func (z *Zone) dispel(c *actor.Context, src, tgt *entity, now time.Time) {
+	removed := dispelStatuses(tgt, z.hostile(src, tgt))
+	z.statusesRemoved(c, tgt, removed, types.StatusRemovedDispelled, now)
+}
+
+func (z *Zone) statusesRemoved(c *actor.Context, e *entity, removed []*statusEffect, reason string, now time.Time) {
+	for _, eff := range removed {
+		z.sendStatus(c, e, eff, types.StatusEventRemoved, reason, now)
+	}
+	if len(removed) > 0 {
+		z.refreshStats(c, e)
+	}
+}
+
+// 틱마다 지속 피해/회복을 적용하고 끝난 효과를 지운다 (엔티티 ID 순)
+func (z *Zone) updateStatuses(c *actor.Context, now time.Time) {
+	ids := make([]int64, 0, len(z.entities))
+	for id, e := range z.entities {
+		if len(e.combat.effects) > 0 {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for _, id := range ids {
+		e, ok := z.entities[id]
+		if !ok {
+			continue
+		}
+		var expired []*statusEffect
+		// 지속 피해로 죽으면 효과 목록이 비워지므로 복사본을 돈다
+		for _, eff := range append([]*statusEffect(nil), e.combat.effects...) {
+			z.tickStatus(c, e, eff, now)
+			if e.dead() {
+				break
+			}
+			if !now.Before(eff.expiresAt) {
+				expired = append(expired, eff)
+			}
+		}
+		if e.dead() {
+			continue
+		}
+		for _, eff := range expired {
+			e.removeStatus(eff)
+		}
+		z.statusesRemoved(c, e, expired, types.StatusRemovedExpired, now)
+	}
+}
+
+// 지속 피해/회복 틱 (지속 시간이 끝나는 순간의 틱까지 적용)
+func (z *Zone) tickStatus(c *actor.Context, e *entity, eff *statusEffect, now time.Time) {
+	if eff.def.Kind != types.StatusKindDoT && eff.def.Kind != types.StatusKindHoT {
+		return
+	}
+	src := hitSource{entityID: eff.sourceID, statusID: eff.def.ID}
+	for !now.Before(eff.nextTick) && !eff.nextTick.After(eff.expiresAt) && !e.dead() {
+		eff.nextTick = eff.nextTick.Add(eff.def.tickInterval())
+		amount := eff.def.Amount * eff.stacks
+		if eff.def.Kind == types.StatusKindDoT {
+			z.damage(c, src, e, amount, now)
+		} else {
+			z.heal(c, src, e, amount)
+		}
+	}
+}
+
+func (z *Zone) sendStatus(c *actor.Context, e *entity, eff *statusEffect, ev, reason string, now time.Time) {
+	z.sendNear(c, e.state.Position, "statusEvent", types.StatusEvent{
+		Type:       ev,
+		EntityID:   e.id,
+		Effect:     statusInfo(eff, now),
+		Reason:     reason,
+		Tick:       z.ticks,
+		ServerTime: z.stateTime(),
+	})
+}
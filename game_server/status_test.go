@@ -0,0 +1,180 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/SilverSS/gameserver/types"
+	"github.com/anthdm/hollywood/actor"
+)
+
+func testStatus(id, kind, stacking string) *statusDef {
+	return &statusDef{ID: id, Kind: kind, DurationMs: 3000, TickMs: 1000, Amount: 5, Magnitude: 0.5, Stacking: stacking, MaxStacks: 3}
+}
+
+func TestApplyStatusStacking(t *testing.T) {
+	tests := []struct {
+		stacking   string
+		wantEvent  string
+		wantStacks int
+		refreshed  bool
+	}{
+		{stackRefresh, types.StatusEventRefreshed, 1, true},
+		{stackAdd, types.StatusEventRefreshed, 2, true},
+		{stackIgnore, "", 1, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.stacking, func(t *testing.T) {
+			e := testEntity(1, types.Vector{})
+			def := testStatus("s", types.StatusKindSlow, tt.stacking)
+			now := time.Now()
+			if _, ev := applyStatus(e, def, 2, now); ev != types.StatusEventApplied {
+				t.Fatalf("first apply event = %q", ev)
+			}
+
+			later := now.Add(time.Second)
+			eff, ev := applyStatus(e, def, 3, later)
+			if ev != tt.wantEvent || eff.stacks != tt.wantStacks || len(e.combat.effects) != 1 {
+				t.Fatalf("second apply: event %q, stacks %d, %d effects", ev, eff.stacks, len(e.combat.effects))
+			}
+			wantExpiry, wantSource := now.Add(def.duration()), int64(2)
+			if tt.refreshed {
+				wantExpiry, wantSource = later.Add(def.duration()), 3
+			}
+			if !eff.expiresAt.Equal(wantExpiry) || eff.sourceID != wantSource {
+				t.Errorf("expiresAt %v source %d, want %v source %d", eff.expiresAt, eff.sourceID, wantExpiry, wantSource)
+			}
+		})
+	}
+}
+
+func TestApplyStatusMaxStacks(t *testing.T) {
+	e := testEntity(1, types.Vector{})
+	def := testStatus("s", types.StatusKindSlow, stackAdd)
+	now := time.Now()
+	var eff *statusEffect
+	for i := 0; i < 5; i++ {
+		eff, _ = applyStatus(e, def, 2, now)
+	}
+	if eff.stacks != def.MaxStacks {
+		t.Errorf("stacks = %d, want %d", eff.stacks, def.MaxStacks)
+	}
+	// 감속 배율은 중첩마다 곱한다
+	if mods := eff.modifiers(); len(mods) != 1 || mods[0].Stat != types.StatMoveSpeed || mods[0].Mul != 0.125 {
+		t.Errorf("modifiers = %+v, want moveSpeed x0.125", mods)
+	}
+}
+
+func TestStatusModifiers(t *testing.T) {
+	stun := &statusEffect{def: testStatus("stun", types.StatusKindStun, stackRefresh), stacks: 1}
+	if mods := stun.modifiers(); len(mods) != 1 || mods[0].Mul != 0 {
+		t.Errorf("stun modifiers = %+v, want moveSpeed x0", mods)
+	}
+	def := testStatus("might", types.StatusKindStats, stackAdd)
+	def.Modifiers = []statModifier{{Stat: types.StatStrength, Add: 2, Mul: 1.5}}
+	might := &statusEffect{def: def, stacks: 2}
+	if mods := might.modifiers(); len(mods) != 1 || mods[0].Add != 4 || mods[0].Mul != 2.25 {
+		t.Errorf("stats modifiers = %+v, want add 4, mul 2.25", mods)
+	}
+}
+
+func TestAbsorbDamage(t *testing.T) {
+	e := testEntity(1, types.Vector{})
+	now := time.Now()
+	small, big := testStatus("small", types.StatusKindShield, stackRefresh), testStatus("big", types.StatusKindShield, stackRefresh)
+	small.Amount, big.Amount = 30, 50
+	applyStatus(e, small, 1, now)
+	bigEff, _ := applyStatus(e, big, 1, now)
+
+	// 먼저 걸린 보호막부터 소모한다
+	absorbed, depleted := absorbDamage(e, 40)
+	if absorbed != 40 || len(depleted) != 1 || depleted[0].def != small {
+		t.Fatalf("absorbDamage(40) = %d, %v; want 40 with the small shield depleted", absorbed, depleted)
+	}
+	if bigEff.shield != 40 || len(e.combat.effects) != 1 {
+		t.Errorf("big shield = %d, %d effects; want 40 left on one effect", bigEff.shield, len(e.combat.effects))
+	}
+	if absorbed, _ := absorbDamage(e, 100); absorbed != 40 || len(e.combat.effects) != 0 {
+		t.Errorf("absorbDamage(100) = %d, %d effects left; want 40 absorbed and none left", absorbed, len(e.combat.effects))
+	}
+}
+
+func TestDispelStatuses(t *testing.T) {
+	e := testEntity(1, types.Vector{})
+	now := time.Now()
+	haste := testStatus("haste", types.StatusKindHaste, stackRefresh)
+	slow := testStatus("slow", types.StatusKindSlow, stackRefresh)
+	curse := testStatus("curse", types.StatusKindDoT, stackRefresh)
+	haste.Dispellable, slow.Dispellable = true, true
+	for _, def := range []*statusDef{haste, slow, curse} {
+		applyStatus(e, def, 2, now)
+	}
+
+	removed := dispelStatuses(e, false)
+	if len(removed) != 1 || removed[0].def != slow {
+		t.Fatalf("dispel debuffs removed %v, want only slow", removed)
+	}
+	removed = dispelStatuses(e, true)
+	if len(removed) != 1 || removed[0].def != haste {
+		t.Fatalf("dispel buffs removed %v, want only haste", removed)
+	}
+	if len(e.combat.effects) != 1 || e.combat.effects[0].def != curse {
+		t.Errorf("left %v, want the non-dispellable curse", e.combat.effects)
+	}
+}
+
+func TestStatusTicks(t *testing.T) {
+	tests := []struct {
+		name       string
+		kind       string
+		health     int
+		stacks     int
+		after      time.Duration
+		wantHealth int
+		wantActive bool
+	}{
+		// 1, 2, 3 초의 틱 (지속 시간이 끝나는 순간 포함) 을 적용하고 효과가 끝난다
+		{"dot to expiry", types.StatusKindDoT, 100, 1, 3500 * time.Millisecond, 85, false},
+		{"dot stacks", types.StatusKindDoT, 100, 2, 1500 * time.Millisecond, 90, true},
+		{"hot", types.StatusKindHoT, 50, 1, 2 * time.Second, 60, true},
+		{"hot capped at max health", types.StatusKindHoT, 98, 1, 3 * time.Second, 100, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newCombatHarness(t)
+			z := h.newZone(testZoneDef("a", types.Vector{}), testCombatRules())
+			e := h.enter(z, 1, types.Vector{})
+			e.state.Health = tt.health
+			def := testStatus("s", tt.kind, stackAdd)
+			now := time.Now()
+			for i := 0; i < tt.stacks; i++ {
+				applyStatus(e, def, 2, now)
+			}
+
+			h.do(func(c *actor.Context) { z.updateStatuses(c, now.Add(tt.after)) })
+
+			if e.state.Health != tt.wantHealth {
+				t.Errorf("health = %d, want %d", e.state.Health, tt.wantHealth)
+			}
+			if active := e.statusEffect("s") != nil; active != tt.wantActive {
+				t.Errorf("effect active = %v, want %v", active, tt.wantActive)
+			}
+		})
+	}
+}
+
+func TestDoTKillClearsStatuses(t *testing.T) {
+	h := newCombatHarness(t)
+	z := h.newZone(testZoneDef("a", types.Vector{}), testCombatRules())
+	e := h.enter(z, 1, types.Vector{})
+	e.state.Health = 8
+	now := time.Now()
+	applyStatus(e, testStatus("dot", types.StatusKindDoT, stackRefresh), 2, now)
+	applyStatus(e, testStatus("slow", types.StatusKindSlow, stackRefresh), 2, now)
+
+	h.do(func(c *actor.Context) { z.updateStatuses(c, now.Add(3*time.Second)) })
+
+	if !e.dead() || len(e.combat.effects) != 0 {
+		t.Errorf("health %d, %d effects; want dead with no effects", e.state.Health, len(e.combat.effects))
+	}
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/SilverSS/gameserver/types"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// 회원가입 사용자명/비밀번호 정책
+type CredentialPolicy struct {
+	MinUsernameLen  int    // 정규화 후 글자(rune) 수 기준
+	MaxUsernameLen  int    // 정규화 후 글자(rune) 수 기준
+	UsernameSymbols string // 글자/숫자 외에 사용자명에 허용할 문자
+	MinPasswordLen  int    // 글자(rune) 수 기준
+	MaxPasswordLen  int    // 바이트 수 기준 (해시 입력 크기 제한)
+
+	reserved  map[string]struct{} // usernameKey 로 정규화된 예약어
+	profanity []string            // usernameKey 로 정규화된 금칙어 (부분 일치)
+}
+
+func defaultCredentialPolicy() CredentialPolicy {
+	return CredentialPolicy{
+		MinUsernameLen:  3,
+		MaxUsernameLen:  16,
+		UsernameSymbols: "_-",
+		MinPasswordLen:  8,
+		MaxPasswordLen:  128,
+	}
+}
+
+// 데이터 파일에서 예약어/금칙어 목록을 읽어 정책에 적용한다.
+func (p *CredentialPolicy) loadNameLists() error {
+	reserved, err := readDataLines("reserved_names.txt")
+	if err != nil {
+		return fmt.Errorf("reserved_names.txt: %w", err)
+	}
+	profanity, err := readDataLines("profanity.txt")
+	if err != nil {
+		return fmt.Errorf("profanity.txt: %w", err)
+	}
+	p.reserved = make(map[string]struct{}, len(reserved))
+	for _, name := range reserved {
+		p.reserved[usernameKey(name)] = struct{}{}
+	}
+	p.profanity = p.profanity[:0]
+	for _, word := range profanity {
+		p.profanity = append(p.profanity, usernameKey(word))
+	}
+	return nil
+}
+
+// 사용자명 정규화 (NFKC). 저장/표시용 사용자명.
+func normalizeUsername(name string) string {
+	return norm.NFKC.String(name)
+}
+
+// 대소문자 구분 없는 비교/유일성 검사용 키
+func usernameKey(name string) string {
+	return norm.NFKC.String(cases.Fold().String(normalizeUsername(name)))
+}
+
+// 사용자명 검증. 정규화된 사용자명과 필드 오류 목록을 반환한다.
+func (p *CredentialPolicy) validateUsername(raw string) (string, []types.FieldError) {
+	const field = "username"
+	if raw == "" {
+		return "", []types.FieldError{{Field: field, Code: types.FieldErrRequired}}
+	}
+	if !utf8.ValidString(raw) {
+		return "", []types.FieldError{{Field: field, Code: types.FieldErrInvalidChars}}
+	}
+	// 정규화 전에 길이를 대략 제한해 거대한 입력의 정규화 비용을 막는다.
+	if len(raw) > p.MaxUsernameLen*utf8.UTFMax*4 {
+		return "", []types.FieldError{{Field: field, Code: types.FieldErrTooLong}}
+	}
+
+	name := normalizeUsername(raw)
+	var errs []types.FieldError
+	n := utf8.RuneCountInString(name)
+	if n < p.MinUsernameLen {
+		errs = append(errs, types.FieldError{Field: field, Code: types.FieldErrTooShort})
+	}
+	if n > p.MaxUsernameLen {
+		errs = append(errs, types.FieldError{Field: field, Code: types.FieldErrTooLong})
+	}
+	for i, r := range name {
+		if i == 0 && !unicode.IsLetter(r) {
+			errs = append(errs, types.FieldError{Field: field, Code: types.FieldErrInvalidStart})
+			continue
+		}
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && !strings.ContainsRune(p.UsernameSymbols, r) {
+			errs = append(errs, types.FieldError{Field: field, Code: types.FieldErrInvalidChars})
+			break
+		}
+	}
+
+	key := usernameKey(name)
+	if _, ok := p.reserved[key]; ok {
+		errs = append(errs, types.FieldError{Field: field, Code: types.FieldErrReserved})
+	}
+	for _, word := range p.profanity {
+		if strings.Contains(key, word) {
+			errs = append(errs, types.FieldError{Field: field, Code: types.FieldErrProfanity})
+			break
+		}
+	}
+	return name, errs
+}
+
+// 비밀번호 검증
+func (p *CredentialPolicy) validatePassword(password, username string) []types.FieldError {
+	const field = "password"
+	if password == "" {
+		return []types.FieldError{{Field: field, Code: types.FieldErrRequired}}
+	}
+	if len(password) > p.MaxPasswordLen {
+		return []types.FieldError{{Field: field, Code: types.FieldErrTooLong}}
+	}
+	if !utf8.ValidString(password) {
+		return []types.FieldError{{Field: field, Code: types.FieldErrInvalidChars}}
+	}
+
+	var errs []types.FieldError
+	if utf8.RuneCountInString(password) < p.MinPasswordLen {
+		errs = append(errs, types.FieldError{Field: field, Code: types.FieldErrTooShort})
+	}
+	for _, r := range password {
+		if unicode.IsControl(r) {
+			errs = append(errs, types.FieldError{Field: field, Code: types.FieldErrInvalidChars})
+			break
+		}
+	}
+	if username != "" && usernameKey(password) == usernameKey(username) {
+		errs = append(errs, types.FieldError{Field: field, Code: types.FieldErrSameAsUsername})
+	}
+	return errs
+}
+
+// 회원가입 입력 전체 검증
+func (p *CredentialPolicy) validateRegistration(username, password string) (string, []types.FieldError) {
+	name, errs := p.validateUsername(username)
+	errs = append(errs, p.validatePassword(password, name)...)
+	return name, errs
+}
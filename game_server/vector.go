@@ -0,0 +1,55 @@
+package main
+
+import (
+	"math"
+
+	"github.com/SilverSS/gameserver/types"
+)
+
+// 벡터 연산 함수들
+func subtract(a, b types.Vector) types.Vector {
+	return types.Vector{X: a.X - b.X, Y: a.Y - b.Y, Z: a.Z - b.Z}
+}
+func add(a, b types.Vector) types.Vector {
+	return types.Vector{X: a.X + b.X, Y: a.Y + b.Y, Z: a.Z + b.Z}
+}
+func multiply(a types.Vector, scalar float32) types.Vector {
+	return types.Vector{X: a.X * scalar, Y: a.Y * scalar, Z: a.Z * scalar}
+}
+func length(a types.Vector) float32 {
+	return float32(math.Sqrt(float64(a.X*a.X + a.Y*a.Y + a.Z*a.Z)))
+}
+func normalize(a types.Vector) types.Vector {
+	l := length(a)
+	if l == 0 {
+		return types.Vector{X: 0, Y: 0, Z: 0}
+	}
+	return types.Vector{X: a.X / l, Y: a.Y / l, Z: a.Z / l}
+}
+func distance(a, b types.Vector) float32 {
+	return length(subtract(a, b))
+}
+func dot(a, b types.Vector) float32 {
+	return a.X*b.X + a.Y*b.Y + a.Z*b.Z
+}
+func lerp(a, b types.Vector, t float32) types.Vector {
+	return add(a, multiply(subtract(b, a), t))
+}
+
+// X/Z 평면에서 두 위치의 거리가 r 이하인지 (높이 무시)
+func withinXZ(a, b types.Vector, r float32) bool {
+	dx := a.X - b.X
+	dz := a.Z - b.Z
+	return dx*dx+dz*dz <= r*r
+}
+
+// 값을 [lo, hi] 범위로 제한
+func clampf(v, lo, hi float32) float32 {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
@@ -0,0 +1,615 @@
+package main
+
+import (
+	"fmt"
+	"io/fs"
+	"sync/atomic"
+	"time"
+
+	"github.com/SilverSS/gameserver/types"
+	"github.com/anthdm/hollywood/actor"
+)
+
+// 존 틱 기본 주기
+const defaultZoneTick = 200 * time.Millisecond
+
+// 존 정의 (data/zones/*.json)
+type zoneDef struct {
+	ID              string        `json:"id"`
+	Name            string        `json:"name"`
+	BoundsMin       types.Vector  `json:"boundsMin"`
+	BoundsMax       types.Vector  `json:"boundsMax"`
+	Spawn           types.Vector  `json:"spawn"`
+	TickMs          int           `json:"tickMs"`     // 0 이면 defaultZoneTick
+	ViewRadius      float32       `json:"viewRadius"` // 0 이면 존 전체가 시야
+	PvP             bool          `json:"pvp"`        // 플레이어끼리 공격할 수 있음
+	Portals         []portalDef   `json:"portals"`
+	NPCs            []npcSpawnDef `json:"npcs"`
+	NavGrid         string        `json:"navGrid"` // 길찾기 격자 파일 (data 기준 경로, 없으면 직선 이동)
+	Colliders       []colliderDef `json:"colliders"`
+	EntityCollision bool          `json:"entityCollision"` // 살아 있는 엔티티끼리 겹치지 않게 막음
+	Heightmap       string        `json:"heightmap"`       // 지형 높이맵 파일 (data 기준 경로, 없으면 평지)
+
+	nav    *navGrid
+	height *heightmap
+}
+
+// 포탈 정의: 반경 안에서 usePortal 을 보내면 TargetZone 의 TargetPosition 으로 이동
+type portalDef struct {
+	ID             string       `json:"id"`
+	Position       types.Vector `json:"position"`
+	Radius         float32      `json:"radius"`
+	TargetZone     string       `json:"targetZone"`
+	TargetPosition types.Vector `json:"targetPosition"`
+}
+
+func (d *zoneDef) tickInterval() time.Duration {
+	if d.TickMs <= 0 {
+		return defaultZoneTick
+	}
+	return time.Duration(d.TickMs) * time.Millisecond
+}
+
+// 위치를 존 경계 안으로 제한
+func (d *zoneDef) clamp(v types.Vector) types.Vector {
+	return types.Vector{
+		X: clampf(v.X, d.BoundsMin.X, d.BoundsMax.X),
+		Y: clampf(v.Y, d.BoundsMin.Y, d.BoundsMax.Y),
+		Z: clampf(v.Z, d.BoundsMin.Z, d.BoundsMax.Z),
+	}
+}
+
+func (d *zoneDef) portal(id string) *portalDef {
+	for i := range d.Portals {
+		if d.Portals[i].ID == id {
+			return &d.Portals[i]
+		}
+	}
+	return nil
+}
+
+func (d *zoneDef) validate(npcs map[string]*npcDef) error {
+	if d.ID == "" {
+		return fmt.Errorf("missing id")
+	}
+	if d.BoundsMin.X > d.BoundsMax.X || d.BoundsMin.Y > d.BoundsMax.Y || d.BoundsMin.Z > d.BoundsMax.Z {
+		return fmt.Errorf("zone %s: boundsMin must not exceed boundsMax", d.ID)
+	}
+	seen := make(map[string]bool)
+	for _, p := range d.Portals {
+		if p.ID == "" || seen[p.ID] {
+			return fmt.Errorf("zone %s: portal id %q missing or duplicated", d.ID, p.ID)
+		}
+		seen[p.ID] = true
+	}
+	for i := range d.Colliders {
+		if err := d.Colliders[i].validate(); err != nil {
+			return fmt.Errorf("zone %s: collider %d: %w", d.ID, i, err)
+		}
+	}
+	for i, s := range d.NPCs {
+		if err := s.validate(npcs); err != nil {
+			return fmt.Errorf("zone %s: npc spawn %d: %w", d.ID, i, err)
+		}
+	}
+	if d.NavGrid != "" {
+		nav, err := loadNavGrid(d.NavGrid)
+		if err != nil {
+			return fmt.Errorf("zone %s: %w", d.ID, err)
+		}
+		nav.blockColliders(d.Colliders)
+		d.nav = nav
+	}
+	if d.Heightmap != "" {
+		h, err := loadHeightmap(d.Heightmap)
+		if err != nil {
+			return fmt.Errorf("zone %s: %w", d.ID, err)
+		}
+		d.height = h
+	}
+	return nil
+}
+
+// 존 정의 파일 전체 로드 (data/zones/*.json)
+func loadZoneDefs(npcs map[string]*npcDef) (map[string]*zoneDef, error) {
+	names, err := fs.Glob(dataFS, "zones/*.json")
+	if err != nil {
+		return nil, err
+	}
+	defs := make(map[string]*zoneDef, len(names))
+	for _, name := range names {
+		var def zoneDef
+		if err := readDataJSON(name, &def); err != nil {
+			return nil, err
+		}
+		if err := def.validate(npcs); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		if _, dup := defs[def.ID]; dup {
+			return nil, fmt.Errorf("%s: duplicate zone id %q", name, def.ID)
+		}
+		defs[def.ID] = &def
+	}
+	for _, def := range defs {
+		for _, p := range def.Portals {
+			if _, ok := defs[p.TargetZone]; !ok {
+				return nil, fmt.Errorf("zone %s: portal %s targets unknown zone %q", def.ID, p.ID, p.TargetZone)
+			}
+		}
+	}
+	return defs, nil
+}
+
+// 엔티티 ID 발급 (서버 전체에서 유일)
+var lastEntityID atomic.Int64
+
+func newEntityID() int64 {
+	return lastEntityID.Add(1)
+}
+
+// 존 안의 엔티티
+type entity struct {
+	id        int64
+	kind      string
+	name      string
+	session   *actor.PID // 플레이어 세션 (플레이어가 아니면 nil)
+	npc       *npcState  // NPC 의 정의와 AI 상태 (플레이어면 nil)
+	state     types.PlayerState
+	target    types.Vector   // 최종 목표 위치
+	path      []types.Vector // 남은 경유지 (마지막이 target)
+	moving    bool
+	snapshots snapshotLog // 이 플레이어에게 보낸 주변 엔티티 스냅샷
+	combat    combatState
+	base      statBase             // 수정치가 없을 때의 능력치
+	stats     types.CharacterStats // 수정치를 반영한 현재 능력치 (존 입장 시 다시 계산)
+	history   positionHistory      // 지연 보상용 최근 위치 기록
+}
+
+// 존 액터 메시지
+type (
+	zoneTick struct{}
+
+	// 존 입장. Position 이 nil 이면 존의 기본 스폰 위치에 배치한다.
+	enterZone struct {
+		EntityID int64
+		Name     string
+		Session  *actor.PID
+		State    types.PlayerState
+		Combat   combatState
+		Position *types.Vector
+	}
+
+	leaveZone struct {
+		EntityID int64
+	}
+
+	moveEntity struct {
+		EntityID int64
+		Target   types.Vector
+	}
+
+	usePortal struct {
+		EntityID int64
+		PortalID string
+	}
+
+	// 다른 존으로 내보내기 요청 (세션 -> 존). Position 이 nil 이면 대상 존의 스폰 위치.
+	transferOut struct {
+		EntityID int64
+		ToZone   string
+		Position *types.Vector
+	}
+
+	// 존 이동 요청 (존 -> GameServer). 엔티티는 이미 원래 존에서 빠진 상태다.
+	transferEntity struct {
+		EntityID int64
+		Name     string
+		Session  *actor.PID
+		State    types.PlayerState
+		Combat   combatState
+		FromZone string
+		ToZone   string
+		Position *types.Vector
+	}
+
+	// 존 인원 변화 알림 (존 -> observer). Players 는 존 안 플레이어의 사용자명.
+	zonePopulation struct {
+		ZoneID  string
+		Count   int
+		Players []string
+	}
+)
+
+// 존 액터: 자신의 엔티티 집합, 경계, 틱 루프를 가진다.
+type Zone struct {
+	def      *zoneDef
+	rules    *combatRules
+	server   *actor.PID // 존 이동을 중계하는 GameServer
+	observer *actor.PID // 인원 변화를 알릴 액터 (인스턴스 관리자, 없으면 nil)
+	parties  *actor.PID // 플레이어 상태를 보고할 파티 액터
+	entities map[int64]*entity
+	spawners []*npcSpawner
+	repeater actor.SendRepeater
+	ticks    int64       // 지금까지 돈 틱 수 (상태 메시지의 tick)
+	tickTime time.Time   // 처리 중인 틱의 시각 (틱 밖이면 zero)
+	match    *arenaState // 매치 인스턴스면 진행 중인 매치
+
+	lastPartyReport time.Time
+}
+
+func newZone(def *zoneDef, rules *combatRules, server, observer, parties *actor.PID) actor.Producer {
+	return func() actor.Receiver {
+		return &Zone{
+			def:      def,
+			rules:    rules,
+			server:   server,
+			observer: observer,
+			parties:  parties,
+			entities: make(map[int64]*entity),
+		}
+	}
+}
+
+func (z *Zone) Receive(c *actor.Context) {
+	switch msg := c.Message().(type) {
+	case actor.Started:
+		z.initSpawners()
+		z.repeater = c.SendRepeat(c.PID(), zoneTick{}, z.def.tickInterval())
+		fmt.Printf("zone %s started (%s)\n", z.def.ID, c.PID())
+	case actor.Stopped:
+		z.repeater.Stop()
+	case zoneTick:
+		z.tick(c)
+	case enterZone:
+		z.enter(c, msg)
+	case leaveZone:
+		z.remove(c, msg.EntityID)
+	case moveEntity:
+		z.move(c, msg)
+	case usePortal:
+		z.usePortal(c, msg)
+	case attackEntity:
+		z.attack(c, msg, time.Now())
+	case useAbility:
+		z.useAbility(c, msg, time.Now())
+	case cancelAbility:
+		z.cancelAbility(c, msg)
+	case ackSnapshot:
+		z.ackSnapshot(msg)
+	case zoneChat:
+		z.chat(c, msg)
+	case arenaMatch:
+		z.startMatch(msg)
+	case transferOut:
+		if e, ok := z.entities[msg.EntityID]; ok {
+			z.transfer(c, e, msg.ToZone, msg.Position)
+		}
+	}
+}
+
+func (z *Zone) enter(c *actor.Context, msg enterZone) {
+	pos := z.def.Spawn
+	if msg.Position != nil {
+		pos = *msg.Position
+	}
+	pos = z.def.onGround(pos)
+	state := msg.State
+	state.Position = pos
+	state.Target = pos
+	state.MoveState = 0
+	state.ZoneID = z.def.ID
+	e := &entity{
+		id:      msg.EntityID,
+		kind:    types.EntityKindPlayer,
+		name:    msg.Name,
+		session: msg.Session,
+		state:   state,
+		target:  pos,
+		combat:  msg.Combat,
+		base:    z.rules.stats.Base,
+	}
+	z.rules.initCombat(&e.combat)
+	z.rules.initStats(e)
+	z.entities[e.id] = e
+	z.notifyPopulation(c)
+
+	if e.session != nil {
+		c.Send(e.session, zoneJoined{ZoneID: z.def.ID, Zone: c.PID()})
+		portals := make([]types.PortalInfo, len(z.def.Portals))
+		for i, p := range z.def.Portals {
+			portals[i] = types.PortalInfo{ID: p.ID, Position: p.Position, Radius: p.Radius, TargetZone: p.TargetZone}
+		}
+		z.send(c, e, "zoneChanged", types.ZoneChanged{
+			ZoneID:    z.def.ID,
+			Name:      z.def.Name,
+			EntityID:  e.id,
+			Position:  pos,
+			BoundsMin: z.def.BoundsMin,
+			BoundsMax: z.def.BoundsMax,
+			Portals:   portals,
+			TickMs:    int(z.def.tickInterval() / time.Millisecond),
+		})
+		z.sendHealth(c, e)
+		z.sendStats(c, e)
+		z.sendResources(c, e)
+		z.send(c, e, "statusEffects", types.StatusEffects{Effects: append([]types.StatusEffectInfo{}, statusInfos(e, time.Now())...)})
+		if e.dead() {
+			left := max(time.Until(e.combat.respawnAt), 0)
+			z.send(c, e, "playerDied", types.PlayerDied{RespawnInMs: int(left / time.Millisecond)})
+		}
+	}
+	fmt.Printf("zone %s: entity %d (%s) entered\n", z.def.ID, e.id, e.name)
+}
+
+// 이동 요청: 목표 위치까지 길을 찾아 경유지와 함께 이동 승인 메시지를 보낸다.
+// 갈 수 없는 곳이면 이동 거부를 보내고 하던 일을 계속한다.
+func (z *Zone) move(c *actor.Context, msg moveEntity) {
+	e, ok := z.entities[msg.EntityID]
+	if !ok || e.dead() || e.stunned() {
+		return
+	}
+	if !z.def.nearGround(msg.Target) {
+		z.send(c, e, "moveRejected", types.MoveRejected{Target: msg.Target, Code: types.MoveErrOffGround})
+		return
+	}
+	path, ok := z.findPath(e.state.Position, msg.Target)
+	if !ok {
+		z.send(c, e, "moveRejected", types.MoveRejected{Target: msg.Target, Code: types.MoveErrNoPath})
+		return
+	}
+	if cast := e.combat.cast; cast != nil {
+		z.interruptCast(c, e, cast.ability, types.CastInterruptMoved)
+	}
+	e.setPath(path)
+	z.sendMoveApproved(c, e)
+}
+
+// from 에서 to 까지의 경유지 (마지막이 도착 위치, 모두 지면 위). 길찾기 격자가 없는 존은 곧게 가고
+// (가다가 충돌체에 막히면 틱에서 미끄러지거나 멈춤), 충돌체 안을 목표로 하면 갈 수 없다.
+func (z *Zone) findPath(from, to types.Vector) ([]types.Vector, bool) {
+	to = z.def.onGround(to)
+	if z.def.nav == nil {
+		if z.insideCollider(to) {
+			return nil, false
+		}
+		return []types.Vector{to}, true
+	}
+	path, ok := z.def.nav.findPath(from, to)
+	for i := range path {
+		path[i] = z.def.onGround(path[i])
+	}
+	return path, ok
+}
+
+func (z *Zone) sendMoveApproved(c *actor.Context, e *entity) {
+	z.send(c, e, "moveApproved", types.MoveApproved{
+		Target:     e.target,
+		Speed:      e.stats.MoveSpeed,
+		Path:       append([]types.Vector(nil), e.path...),
+		Tick:       z.ticks,
+		ServerTime: z.stateTime(),
+	})
+}
+
+func (z *Zone) usePortal(c *actor.Context, msg usePortal) {
+	e, ok := z.entities[msg.EntityID]
+	if !ok {
+		return
+	}
+	if e.dead() {
+		z.send(c, e, "zoneTransferResult", types.ZoneTransferResult{Success: false, Code: types.ZoneErrDead})
+		return
+	}
+	p := z.def.portal(msg.PortalID)
+	if p == nil {
+		z.send(c, e, "zoneTransferResult", types.ZoneTransferResult{Success: false, Code: types.ZoneErrUnknownPortal})
+		return
+	}
+	if distance(e.state.Position, p.Position) > p.Radius {
+		z.send(c, e, "zoneTransferResult", types.ZoneTransferResult{Success: false, Code: types.ZoneErrTooFar})
+		return
+	}
+	pos := p.TargetPosition
+	z.transfer(c, e, p.TargetZone, &pos)
+}
+
+// 엔티티를 존에서 빼고 GameServer 에 다른 존으로의 이동을 요청한다.
+func (z *Zone) transfer(c *actor.Context, e *entity, toZone string, pos *types.Vector) {
+	z.remove(c, e.id)
+	e.state.MoveState = 0
+	c.Send(z.server, transferEntity{
+		EntityID: e.id,
+		Name:     e.name,
+		Session:  e.session,
+		State:    e.state,
+		Combat:   e.combat,
+		FromZone: z.def.ID,
+		ToZone:   toZone,
+		Position: pos,
+	})
+}
+
+// 엔티티 제거 (퇴장, 접속 종료, 존 이동)
+func (z *Zone) remove(c *actor.Context, id int64) {
+	e, ok := z.entities[id]
+	if !ok {
+		return
+	}
+	delete(z.entities, id)
+	z.leftMatch(e)
+	z.notifyPopulation(c)
+}
+
+// 플레이어 수만 알린다 (NPC 는 세지 않음)
+func (z *Zone) notifyPopulation(c *actor.Context) {
+	if z.observer == nil {
+		return
+	}
+	var players []string
+	for _, e := range z.entities {
+		if e.session != nil {
+			players = append(players, e.name)
+		}
+	}
+	c.Send(z.observer, zonePopulation{ZoneID: z.def.ID, Count: len(players), Players: players})
+}
+
+// 틱마다 NPC 를 스폰하고 행동을 정한 뒤, 이동 중인 엔티티의 위치를 계산하고,
+// 회복/부활과 매치 승패를 처리한 뒤 위치를 기록하고 주변 상태를 플레이어들에게 보낸다.
+func (z *Zone) tick(c *actor.Context) {
+	now := time.Now()
+	dt := float32(z.def.tickInterval().Seconds())
+	z.ticks++
+	z.tickTime = now
+	defer func() { z.tickTime = time.Time{} }()
+	z.updateSpawners(now)
+	z.updateNPCs(c, now)
+	for _, e := range z.entities {
+		if !e.moving {
+			continue
+		}
+		z.moveStep(e, e.stats.MoveSpeed*dt)
+		e.state.Position.Y = z.def.groundHeight(e.state.Position.X, e.state.Position.Z)
+		z.sendCorrection(c, e, now)
+	}
+	z.updateCombat(c, dt, now)
+	z.updateMatch(c, now)
+	z.recordHistory(now)
+	z.replicate(c, now)
+	z.reportParty(c)
+}
+
+// 파티원 상태 갱신용으로 플레이어 위치/체력을 파티 액터에 보고 (파티원 여부는 파티 액터가 판단)
+func (z *Zone) reportParty(c *actor.Context) {
+	if z.parties == nil || time.Since(z.lastPartyReport) < partyStateInterval {
+		return
+	}
+	z.lastPartyReport = time.Now()
+	states := make([]memberState, 0, len(z.entities))
+	for _, e := range z.entities {
+		if e.session != nil {
+			states = append(states, memberState{EntityID: e.id, Position: e.state.Position, Health: e.state.Health})
+		}
+	}
+	if len(states) > 0 {
+		c.Send(z.parties, partyMemberStates{ZoneID: z.def.ID, States: states})
+	}
+}
+
+// 경유지를 따라 이동을 시작한다.
+func (e *entity) setPath(path []types.Vector) {
+	e.path = path
+	e.target = path[len(path)-1]
+	e.state.Target = e.target
+	e.moving = true
+}
+
+// 이동을 멈추고 현재 위치를 목표로 삼는다.
+func (e *entity) stopMoving() {
+	e.moving = false
+	e.path = nil
+	e.target = e.state.Position
+	e.state.Target = e.state.Position
+	e.state.MoveState = 0
+}
+
+// 경유지를 따라 step 만큼 이동. 경유지에 닿고 남은 거리는 다음 경유지로 이어 간다.
+// 마지막 경유지에 도착하면 이동 상태를 해제한다.
+func stepMovement(e *entity, step float32) {
+	for len(e.path) > 0 {
+		cur := e.state.Position
+		wp := e.path[0]
+		d := distance(cur, wp)
+		if d-step < 0.01 {
+			e.state.Position = wp
+			e.path = e.path[1:]
+			step -= d
+			continue
+		}
+		e.state.Position = add(cur, multiply(normalize(subtract(wp, cur)), step))
+		break
+	}
+	if len(e.path) == 0 {
+		e.moving = false
+		e.state.MoveState = 0 // Idle
+	} else {
+		e.state.MoveState = 1 // Moving
+	}
+}
+
+// 플레이어마다 시야 안 엔티티의 스냅샷을 보낸다. 기준 스냅샷이 있으면 달라진 것만 보낸다.
+func (z *Zone) replicate(c *actor.Context, now time.Time) {
+	states := make(map[int64]*netState) // 이번 틱의 엔티티 상태 (시야에 든 것만 만든다)
+	for _, obs := range z.entities {
+		if obs.session == nil {
+			continue
+		}
+		cur := make(map[int64]*netState)
+		for id, other := range z.entities {
+			if id == obs.id || !z.inView(obs, other) {
+				continue
+			}
+			if states[id] == nil {
+				states[id] = newNetState(other, now)
+			}
+			cur[id] = states[id]
+		}
+		base, baseTick := obs.snapshots.baseline(z.ticks)
+		entities, removed := diffSnapshot(base, cur)
+		obs.snapshots.add(z.ticks, cur)
+		z.send(c, obs, "snapshot", types.Snapshot{
+			Tick:       z.ticks,
+			ServerTime: serverTime(now),
+			Baseline:   baseTick,
+			Entities:   entities,
+			Removed:    removed,
+		})
+	}
+}
+
+// 상태 메시지의 serverTime. 틱을 처리하는 중이면 그 틱의 시각이라 같은 틱에 보낸 메시지는 serverTime 이 같고,
+// 틱 사이에 요청을 처리하다 보내면 지금 시각이다 (tick 은 마지막 틱 번호).
+func (z *Zone) stateTime() int64 {
+	if z.tickTime.IsZero() {
+		return serverTime(time.Now())
+	}
+	return serverTime(z.tickTime)
+}
+
+// 자신이 조종하는 엔티티의 위치 보정 (틱 사이에 보내면 마지막 틱 번호와 지금 시각)
+func (z *Zone) sendCorrection(c *actor.Context, e *entity, now time.Time) {
+	z.send(c, e, "positionCorrection", types.PositionCorrection{Position: e.state.Position, Tick: z.ticks, ServerTime: serverTime(now)})
+}
+
+// 시야 판정 (X/Z 평면 거리)
+func (z *Zone) inView(obs, other *entity) bool {
+	if z.def.ViewRadius <= 0 {
+		return true
+	}
+	return withinXZ(obs.state.Position, other.state.Position, z.def.ViewRadius)
+}
+
+// 존/근거리 채팅 전달. 근거리 채팅은 보낸 사람 위치 기준 X/Z 평면 거리로 판정한다.
+func (z *Zone) chat(c *actor.Context, msg zoneChat) {
+	sender, ok := z.entities[msg.Sender]
+	if !ok {
+		return
+	}
+	for _, e := range z.entities {
+		if msg.Blocked[e.name] {
+			continue
+		}
+		if msg.Radius > 0 && !withinXZ(sender.state.Position, e.state.Position, msg.Radius) {
+			continue
+		}
+		z.send(c, e, "chatMessage", msg.Msg)
+	}
+}
+
+// 플레이어 엔티티의 세션으로 WebSocket 메시지 전송
+func (z *Zone) send(c *actor.Context, e *entity, msgType string, v interface{}) {
+	if e.session == nil {
+		return
+	}
+	c.Send(e.session, wsSend{Type: msgType, Data: v})
+}
@@ -0,0 +1,95 @@
+package types
+
+// 능력(스킬) 관련 메시지
+// 능력 정의는 서버 데이터(abilities.json)에 있고, 시전 시작/완료/중단은 "castEvent" 로
+// 시전자 주변의 플레이어 모두에게 온다. 능력의 피해/회복은 "combatEvent" 로 온다.
+
+// 자원 종류 (ResourceUpdate.Resource)
+const (
+	ResourceMana   = "mana"
+	ResourceEnergy = "energy"
+)
+
+// 능력 사용 오류 코드 (AbilityResult.Code)
+const (
+	AbilityErrUnknown       = "unknown_ability"
+	AbilityErrDead          = "dead"
+	AbilityErrCasting       = "casting" // 다른 능력을 시전 중
+	AbilityErrMoving        = "moving"  // 시전 시간이 있는 능력은 멈춰서 써야 함
+	AbilityErrStunned       = "stunned"
+	AbilityErrCooldown      = "cooldown"
+	AbilityErrNoResource    = "not_enough_resource"
+	AbilityErrInvalidTarget = "invalid_target"
+	AbilityErrOutOfRange    = "out_of_range"
+	AbilityErrNoLineOfSight = "no_line_of_sight"
+	AbilityErrNotCasting    = "not_casting" // abilityCancel 을 보냈지만 시전 중이 아님
+)
+
+// 시전 이벤트 종류 (CastEvent.Type)
+const (
+	CastEventStart     = "start"
+	CastEventFinish    = "finish"
+	CastEventInterrupt = "interrupt"
+)
+
+// 시전 중단 사유 (CastEvent.Reason)
+const (
+	CastInterruptMoved      = "moved"
+	CastInterruptDied       = "died"
+	CastInterruptStunned    = "stunned"
+	CastInterruptCancelled  = "cancelled"
+	CastInterruptTargetLost = "target_lost"
+	CastInterruptOutOfRange = "out_of_range"
+)
+
+// 능력 사용
+// 대상 지정 능력은 targetID, 지점 지정 능력은 position (부채꼴/직선은 방향으로만 사용)
+// 클라이언트 -> 서버 ("useAbility")
+// { "abilityID": "fireball", "targetID": 2, "position": Vector }
+type UseAbilityRequest struct {
+	AbilityID string  `json:"abilityID"`
+	TargetID  int64   `json:"targetID,omitempty"`
+	Position  *Vector `json:"position,omitempty"`
+}
+
+// 시전 취소 (본문 없음)
+// 클라이언트 -> 서버 ("abilityCancel")
+
+// 능력 사용 실패 응답 (성공 시에는 "castEvent" 를 보냄)
+// 서버 -> 클라이언트 ("abilityResult")
+// { "success": false, "code": "string", "abilityID": "fireball", "cooldownMs": 0 }
+type AbilityResult struct {
+	Success    bool   `json:"success"`
+	Code       string `json:"code,omitempty"`
+	AbilityID  string `json:"abilityID,omitempty"`
+	CooldownMs int    `json:"cooldownMs,omitempty"` // cooldown 일 때 남은 시간
+}
+
+// 시전 이벤트 (시전자 주변 플레이어 모두에게)
+// 서버 -> 클라이언트 ("castEvent")
+// { "type": "start", "casterID": 1, "abilityID": "fireball", "castMs": 1500, "targetID": 2, "position": Vector, "cooldownMs": 0, "reason": "string", "tick": 1, "serverTime": 1000 }
+// 변경 이력: tick, serverTime 필드 추가 (보간 규약은 net.go 참고)
+type CastEvent struct {
+	Type       string  `json:"type"`
+	CasterID   int64   `json:"casterID"`
+	AbilityID  string  `json:"abilityID"`
+	CastMs     int     `json:"castMs,omitempty"`     // start
+	TargetID   int64   `json:"targetID,omitempty"`   // start, finish
+	Position   *Vector `json:"position,omitempty"`   // start, finish: 조준 지점
+	CooldownMs int     `json:"cooldownMs,omitempty"` // finish: 다시 쓸 수 있을 때까지
+	Reason     string  `json:"reason,omitempty"`     // interrupt
+	Tick       int64   `json:"tick"`
+	ServerTime int64   `json:"serverTime"`
+}
+
+// 자신의 자원 변화 (존 입장, 소모, 자연 회복, 부활)
+// 서버 -> 클라이언트 ("resourceUpdate")
+// { "resource": "mana", "value": 80, "max": 100, "tick": 1, "serverTime": 1000 }
+// 변경 이력: tick, serverTime 필드 추가 (보간 규약은 net.go 참고)
+type ResourceUpdate struct {
+	Resource   string `json:"resource"`
+	Value      int    `json:"value"`
+	Max        int    `json:"max"`
+	Tick       int64  `json:"tick"`
+	ServerTime int64  `json:"serverTime"`
+}
@@ -0,0 +1,101 @@
+package types
+
+// 채팅 관련 메시지
+
+// 채팅 채널 종류 (ChatSendRequest.Channel, ChatMessage.Channel)
+const (
+	ChatGlobal    = "global"    // 접속 중인 모든 플레이어
+	ChatZone      = "zone"      // 같은 존
+	ChatProximity = "proximity" // 같은 존에서 일정 거리 안
+	ChatWhisper   = "whisper"   // 특정 플레이어 (to)
+	ChatParty     = "party"     // 같은 파티
+	ChatGuild     = "guild"     // 같은 길드
+	ChatSystem    = "system"    // 서버/GM 공지 (보내기 불가)
+)
+
+// 채팅 오류 코드 (ChatResult.Code)
+const (
+	ChatErrEmpty          = "empty"
+	ChatErrTooLong        = "too_long"
+	ChatErrRateLimited    = "rate_limited"
+	ChatErrMuted          = "muted"
+	ChatErrUnknownChannel = "unknown_channel"
+	ChatErrPlayerOffline  = "player_offline" // 더 이상 쓰지 않음: 접속하지 않은 플레이어에게는 귓속말이 저장된다
+	ChatErrUnknownPlayer  = "unknown_player"
+	ChatErrMailboxFull    = "mailbox_full" // 받는 사람이 아직 받지 않은 귓속말이 너무 많음
+	ChatErrNotInZone      = "not_in_zone"
+	ChatErrNotInParty     = "not_in_party"
+	ChatErrNotInGuild     = "not_in_guild"
+	ChatErrNotGM          = "not_gm"
+	ChatErrInvalidCommand = "invalid_command"
+)
+
+// 채팅 요청 종류 (ChatResult.Action)
+const (
+	ChatActionSend    = "send"
+	ChatActionBlock   = "block"
+	ChatActionUnblock = "unblock"
+	ChatActionGM      = "gm"
+)
+
+// 채팅 보내기
+// 클라이언트 -> 서버 ("chatSend")
+// { "channel": "whisper", "to": "string", "text": "string" }
+type ChatSendRequest struct {
+	Channel string `json:"channel"`
+	To      string `json:"to,omitempty"` // whisper 대상 사용자명
+	Text    string `json:"text"`
+}
+
+// 채팅 메시지 (보낸 사람에게도 같은 메시지가 간다)
+// 서버 -> 클라이언트 ("chatMessage")
+// { "channel": "zone", "from": "string", "to": "string", "text": "string", "time": 1700000000000, "offline": false }
+// 변경 이력: offline 필드 추가
+type ChatMessage struct {
+	Channel string `json:"channel"`
+	From    string `json:"from,omitempty"` // system 채널은 비어 있음
+	To      string `json:"to,omitempty"`   // whisper 대상
+	Text    string `json:"text"`
+	Time    int64  `json:"time"`              // 서버 시각 (Unix ms)
+	Offline bool   `json:"offline,omitempty"` // 받는 사람이 접속하지 않아 저장된 귓속말 (다음 접속 때 전달)
+}
+
+// 채널 최근 기록 (접속 직후 global, 존 입장 시 zone, 접속 직후 받지 못한 귓속말)
+// 서버 -> 클라이언트 ("chatHistory")
+// { "channel": "zone", "zoneID": "town", "messages": [ChatMessage] }
+type ChatHistory struct {
+	Channel  string        `json:"channel"`
+	ZoneID   string        `json:"zoneID,omitempty"`
+	Messages []ChatMessage `json:"messages"` // 오래된 것부터
+}
+
+// 차단/차단 해제 (차단한 플레이어의 채팅은 받지 않는다)
+// friendBlock/friendUnblock 과 같은 차단으로, DB 에 저장되어 다시 접속해도 유지된다.
+// 차단하면 친구 관계와 상대가 보낸 친구 요청도 지워진다. 결과는 "chatResult" 로 온다.
+// 클라이언트 -> 서버 ("chatBlock", "chatUnblock")
+// { "username": "string" }
+// 변경 이력: 접속 중에만 유지되던 차단을 친구 차단과 합침
+type ChatBlockRequest struct {
+	Username string `json:"username"`
+}
+
+// GM 명령
+// 클라이언트 -> 서버 ("gmCommand")
+// notice: 전체 공지 (text) / mute: 채팅 금지 (target, minutes) / unmute: 채팅 금지 해제 (target)
+// { "command": "mute", "target": "string", "minutes": 10, "text": "string" }
+type GMCommandRequest struct {
+	Command string `json:"command"`
+	Target  string `json:"target,omitempty"`
+	Minutes int    `json:"minutes,omitempty"`
+	Text    string `json:"text,omitempty"`
+}
+
+// 채팅 요청 결과 (보내기는 실패했을 때만 온다)
+// 서버 -> 클라이언트 ("chatResult")
+// { "action": "send", "success": false, "code": "rate_limited", "retryAfterMs": 800 }
+type ChatResult struct {
+	Action       string `json:"action"`
+	Success      bool   `json:"success"`
+	Code         string `json:"code,omitempty"`
+	RetryAfterMs int64  `json:"retryAfterMs,omitempty"` // rate_limited, muted 일 때 남은 시간
+}
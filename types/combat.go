@@ -0,0 +1,86 @@
+package types
+
+// 전투 관련 메시지
+// 체력은 PlayerState.Health / MaxHealth 로 주변 플레이어에게 복제되고,
+// 피해/사망/부활은 "combatEvent" 로 대상 주변의 플레이어 모두에게 온다.
+
+// 공격 오류 코드 (AttackResult.Code)
+const (
+	CombatErrInvalidTarget = "invalid_target" // 없는 엔티티이거나 자기 자신
+	CombatErrDead          = "dead"           // 공격한 플레이어가 죽어 있음
+	CombatErrTargetDead    = "target_dead"
+	CombatErrCooldown      = "cooldown"
+	CombatErrOutOfRange    = "out_of_range"
+	CombatErrNoLineOfSight = "no_line_of_sight"
+	CombatErrPvPDisabled   = "pvp_disabled" // 플레이어끼리 싸울 수 없는 존
+	CombatErrStunned       = "stunned"
+)
+
+// 전투 이벤트 종류 (CombatEvent.Type)
+const (
+	CombatEventDamage  = "damage"
+	CombatEventHeal    = "heal"
+	CombatEventDeath   = "death"
+	CombatEventRespawn = "respawn"
+)
+
+// 기본 공격
+// 클라이언트 -> 서버 ("attack")
+// { "targetID": 1, "clientTime": 1700000000000, "renderTime": 1000 }
+// 변경 이력: clientTime 필드 추가 (지연 보상, 0 이면 서버가 잰 지연만으로 보상)
+// 변경 이력: renderTime 필드 추가 (있으면 clientTime 대신 씀)
+type AttackRequest struct {
+	TargetID   int64 `json:"targetID"`
+	ClientTime int64 `json:"clientTime"` // 공격을 입력한 순간의 클라이언트 시각 (ms, 클라이언트 시계)
+	RenderTime int64 `json:"renderTime"` // 그때 원격 엔티티를 그리던 서버 시각 (보간 규약은 net.go 참고)
+}
+
+// 공격 실패 응답 (성공 시에는 "combatEvent" 를 보냄)
+// 서버 -> 클라이언트 ("attackResult")
+// { "success": false, "code": "string", "targetID": 1, "cooldownMs": 0 }
+type AttackResult struct {
+	Success    bool   `json:"success"`
+	Code       string `json:"code,omitempty"`
+	TargetID   int64  `json:"targetID"`
+	CooldownMs int    `json:"cooldownMs,omitempty"` // cooldown 일 때 남은 시간
+}
+
+// 전투 이벤트 (대상 주변 플레이어 모두에게)
+// 서버 -> 클라이언트 ("combatEvent")
+// { "type": "damage", "sourceID": 1, "targetID": 2, "abilityID": "string", "statusID": "string", "amount": 10, "absorbed": 0, "health": 90, "position": Vector, "tick": 1, "serverTime": 1000 }
+// 변경 이력: heal 이벤트와 abilityID 필드 추가 (기본 공격이면 비어 있음)
+// 변경 이력: statusID, absorbed 필드 추가 (지속 피해/회복, 보호막)
+// 변경 이력: tick, serverTime 필드 추가 (보간 규약은 net.go 참고)
+type CombatEvent struct {
+	Type       string  `json:"type"`
+	SourceID   int64   `json:"sourceID,omitempty"` // damage, heal, death: 공격하거나 회복시킨 엔티티
+	TargetID   int64   `json:"targetID"`
+	AbilityID  string  `json:"abilityID,omitempty"`
+	StatusID   string  `json:"statusID,omitempty"` // 지속 피해/회복을 일으킨 상태 효과
+	Amount     int     `json:"amount,omitempty"`   // damage, heal: 실제로 바뀐 체력
+	Absorbed   int     `json:"absorbed,omitempty"` // damage: 보호막이 흡수한 피해
+	Health     int     `json:"health"`             // 이벤트 후 대상 체력
+	Position   *Vector `json:"position,omitempty"` // respawn: 부활한 위치
+	Tick       int64   `json:"tick"`
+	ServerTime int64   `json:"serverTime"`
+}
+
+// 자신의 체력 변화 (피해, 자연 회복, 부활)
+// 서버 -> 클라이언트 ("healthUpdate")
+// { "health": 90, "maxHealth": 100, "tick": 1, "serverTime": 1000 }
+// 변경 이력: tick, serverTime 필드 추가 (보간 규약은 net.go 참고)
+type HealthUpdate struct {
+	Health     int   `json:"health"`
+	MaxHealth  int   `json:"maxHealth"`
+	Tick       int64 `json:"tick"`
+	ServerTime int64 `json:"serverTime"`
+}
+
+// 사망 알림 (죽은 플레이어에게, respawnInMs 뒤 존의 스폰 위치에서 부활)
+// 서버 -> 클라이언트 ("playerDied")
+// { "killerID": 1, "respawnInMs": 5000 }
+// 변경 이력: 죽은 채 존을 옮기면 도착한 존에서 남은 시간으로 다시 보냄 (killerID 0)
+type PlayerDied struct {
+	KillerID    int64 `json:"killerID"` // 존을 옮긴 뒤 다시 보낼 때는 0
+	RespawnInMs int   `json:"respawnInMs"`
+}
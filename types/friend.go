@@ -0,0 +1,83 @@
+package types
+
+// 친구 관련 메시지
+// 친구 요청/응답/삭제/차단은 WebSocket 으로, 친구 목록 조회는 HTTP GET /friends 로 한다.
+// 친구의 접속/접속 종료/존 이동은 "friendPresence" 로 온다.
+
+// 친구 오류 코드 (FriendResult.Code, FriendListResponse.Code)
+const (
+	FriendErrUnknownPlayer    = "unknown_player"
+	FriendErrInvalidTarget    = "invalid_target" // 자기 자신이거나 비어 있음
+	FriendErrAlreadyFriends   = "already_friends"
+	FriendErrAlreadyRequested = "already_requested"
+	FriendErrUnavailable      = "unavailable" // 어느 한쪽이 차단함
+	FriendErrNoRequest        = "no_request"
+	FriendErrNotFriends       = "not_friends"
+	FriendErrNotBlocked       = "not_blocked"
+	FriendErrUnauthorized     = "unauthorized" // 토큰이 없거나 잘못됨 (/friends)
+)
+
+// 친구 요청 종류 (FriendResult.Action)
+const (
+	FriendActionRequest = "request"
+	FriendActionAccept  = "accept"
+	FriendActionDecline = "decline"
+	FriendActionRemove  = "remove"
+	FriendActionBlock   = "block"
+	FriendActionUnblock = "unblock"
+)
+
+// 친구 요청/수락/거절/삭제/차단/차단 해제
+// 상대가 이미 나에게 요청을 보냈으면 friendRequest 는 바로 수락이 된다.
+// friendRemove 는 보낸 요청을 취소할 때도 쓴다. 차단하면 친구 관계와 상대가 보낸 요청이 지워진다.
+// 클라이언트 -> 서버 ("friendRequest", "friendAccept", "friendDecline", "friendRemove", "friendBlock", "friendUnblock")
+// { "username": "string" }
+type FriendRequest struct {
+	Username string `json:"username"`
+}
+
+// 친구 요청 결과
+// 서버 -> 클라이언트 ("friendResult")
+// { "action": "request", "success": true, "code": "string", "username": "string" }
+type FriendResult struct {
+	Action   string `json:"action"`
+	Success  bool   `json:"success"`
+	Code     string `json:"code,omitempty"`
+	Username string `json:"username,omitempty"` // 대상의 정식 사용자명 (성공 시)
+}
+
+// 친구 요청을 받음
+// 서버 -> 클라이언트 ("friendRequestReceived")
+// { "from": "string" }
+type FriendRequestReceived struct {
+	From string `json:"from"`
+}
+
+// 친구 접속 상태. 로비에 있으면 zoneID 가 비어 있다.
+// 서버 -> 클라이언트 ("friendPresence": 접속/접속 종료/존 이동, "friendAdded": 친구가 됨)
+// { "username": "string", "online": true, "zoneID": "town" }
+type FriendPresence struct {
+	Username string `json:"username"`
+	Online   bool   `json:"online"`
+	ZoneID   string `json:"zoneID,omitempty"`
+}
+
+// 친구 관계가 끊김 (상대가 삭제하거나 차단함)
+// 서버 -> 클라이언트 ("friendRemoved")
+// { "username": "string" }
+type FriendRemoved struct {
+	Username string `json:"username"`
+}
+
+// 친구 목록 조회 응답
+// 클라이언트 -> 서버 (HTTP GET /friends, Authorization: Bearer <token> 또는 ?token=)
+// 서버 -> 클라이언트
+// { "success": true, "code": "string", "friends": [FriendPresence], "incoming": ["string"], "outgoing": ["string"], "blocked": ["string"] }
+type FriendListResponse struct {
+	Success  bool             `json:"success"`
+	Code     string           `json:"code,omitempty"`
+	Friends  []FriendPresence `json:"friends"`
+	Incoming []string         `json:"incoming"` // 나에게 온 요청
+	Outgoing []string         `json:"outgoing"` // 내가 보낸 요청
+	Blocked  []string         `json:"blocked"`  // 내가 차단한 사용자
+}
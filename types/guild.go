@@ -0,0 +1,146 @@
+package types
+
+// 길드 관련 메시지
+// 길드와 길드원은 DB 에 저장되고, 길드 이벤트는 접속 중인 길드원 모두에게 "guildEvent" 로 온다.
+
+// 길드 등급 (GuildMemberInfo.Rank, GuildSetRankRequest.Rank)
+// leader: 모든 권한 / officer: 초대, member 추방, 공지 변경 / member: 길드 채팅만
+const (
+	GuildRankLeader  = "leader"
+	GuildRankOfficer = "officer"
+	GuildRankMember  = "member"
+)
+
+// 길드 오류 코드 (GuildResult.Code)
+const (
+	GuildErrInvalidName       = "invalid_name"
+	GuildErrNameTaken         = "name_taken"
+	GuildErrAlreadyInGuild    = "already_in_guild"
+	GuildErrNotInGuild        = "not_in_guild"
+	GuildErrNoPermission      = "no_permission"
+	GuildErrPlayerOffline     = "player_offline"
+	GuildErrInvalidTarget     = "invalid_target" // 자기 자신이거나 비어 있음
+	GuildErrNotMember         = "not_member"     // 대상이 같은 길드원이 아님
+	GuildErrNoInvitation      = "no_invitation"  // 초대가 없거나 만료됨
+	GuildErrFull              = "guild_full"
+	GuildErrInvalidRank       = "invalid_rank"
+	GuildErrLeaderCannotLeave = "leader_cannot_leave" // 다른 길드원이 있으면 먼저 길드장을 넘겨야 함
+	GuildErrTooLong           = "too_long"
+	GuildErrBusy              = "busy" // 길드를 만드는 중이거나, 저장할 변경이 밀려 있어 잠시 받을 수 없음
+)
+
+// 길드 요청 종류 (GuildResult.Action)
+const (
+	GuildActionCreate  = "create"
+	GuildActionDisband = "disband"
+	GuildActionInvite  = "invite"
+	GuildActionAccept  = "accept"
+	GuildActionDecline = "decline"
+	GuildActionLeave   = "leave"
+	GuildActionKick    = "kick"
+	GuildActionSetRank = "setRank"
+	GuildActionMotd    = "motd"
+	GuildActionRoster  = "roster"
+)
+
+// 길드 이벤트 종류 (GuildEvent.Type)
+const (
+	GuildEventJoined    = "joined"
+	GuildEventLeft      = "left"
+	GuildEventKicked    = "kicked"
+	GuildEventRank      = "rank"
+	GuildEventOnline    = "online"
+	GuildEventOffline   = "offline"
+	GuildEventMotd      = "motd"
+	GuildEventDisbanded = "disbanded"
+)
+
+// 길드 만들기 (만든 사람이 길드장)
+// 클라이언트 -> 서버 ("guildCreate")
+// { "name": "string" }
+type GuildCreateRequest struct {
+	Name string `json:"name"`
+}
+
+// 길드 해산 (길드장만, 본문 없음)
+// 클라이언트 -> 서버 ("guildDisband")
+
+// 길드 초대 / 추방
+// 클라이언트 -> 서버 ("guildInvite", "guildKick")
+// { "username": "string" }
+type GuildMemberRequest struct {
+	Username string `json:"username"`
+}
+
+// 길드 초대 알림
+// 서버 -> 클라이언트 ("guildInvitation")
+// { "guildID": 1, "name": "string", "from": "string" }
+type GuildInvitation struct {
+	GuildID int    `json:"guildID"`
+	Name    string `json:"name"`
+	From    string `json:"from"`
+}
+
+// 길드 초대 수락/거절
+// 클라이언트 -> 서버 ("guildAccept", "guildDecline")
+// { "guildID": 1 }
+type GuildInvitationResponse struct {
+	GuildID int `json:"guildID"`
+}
+
+// 길드 탈퇴 (본문 없음, 혼자 남은 길드장이 나가면 길드가 해산됨)
+// 클라이언트 -> 서버 ("guildLeave")
+
+// 길드원 등급 변경 (길드장만, leader 로 바꾸면 길드장을 넘기고 자신은 officer 가 됨)
+// 클라이언트 -> 서버 ("guildSetRank")
+// { "username": "string", "rank": "officer" }
+type GuildSetRankRequest struct {
+	Username string `json:"username"`
+	Rank     string `json:"rank"`
+}
+
+// 길드 공지 변경 (빈 문자열이면 지움)
+// 클라이언트 -> 서버 ("guildMotd")
+// { "text": "string" }
+type GuildMotdRequest struct {
+	Text string `json:"text"`
+}
+
+// 길드 요청 결과
+// 서버 -> 클라이언트 ("guildResult")
+// { "action": "create", "success": true, "code": "string", "guildID": 1 }
+type GuildResult struct {
+	Action  string `json:"action"`
+	Success bool   `json:"success"`
+	Code    string `json:"code,omitempty"`
+	GuildID int    `json:"guildID,omitempty"`
+}
+
+// 길드원 정보
+type GuildMemberInfo struct {
+	Username string `json:"username"`
+	Rank     string `json:"rank"`
+	Online   bool   `json:"online"`
+}
+
+// 길드 명단 (요청 시, 접속 직후, 길드 가입 시)
+// 클라이언트 -> 서버 ("guildRoster", 본문 없음)
+// 서버 -> 클라이언트 ("guildRoster")
+// { "guildID": 1, "name": "string", "motd": "string", "members": [GuildMemberInfo] }
+type GuildRoster struct {
+	GuildID int               `json:"guildID"`
+	Name    string            `json:"name"`
+	Motd    string            `json:"motd"`
+	Members []GuildMemberInfo `json:"members"` // 등급순, 같은 등급은 이름순
+}
+
+// 길드 이벤트 (접속 중인 길드원 모두에게)
+// 서버 -> 클라이언트 ("guildEvent")
+// { "type": "rank", "username": "string", "rank": "officer", "text": "string", "by": "string" }
+type GuildEvent struct {
+	Type     string `json:"type"`
+	Username string `json:"username,omitempty"` // 대상 길드원
+	Rank     string `json:"rank,omitempty"`     // joined, rank
+	Text     string `json:"text,omitempty"`     // motd
+	By       string `json:"by,omitempty"`       // kicked, rank, motd, disbanded 를 한 사람
+}
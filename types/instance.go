@@ -0,0 +1,69 @@
+package types
+
+// 인스턴스(던전/방) 관련 메시지
+
+// 인스턴스 오류 코드 (InstanceResult.Code)
+const (
+	InstanceErrUnknownTemplate = "unknown_template"
+	InstanceErrLimit           = "instance_limit"
+	InstanceErrUnknown         = "unknown_instance"
+	InstanceErrFull            = "instance_full"
+	InstanceErrNotInvited      = "not_invited"
+	InstanceErrNotInInstance   = "not_in_instance"
+	InstanceErrPlayerOffline   = "player_offline"
+)
+
+// 인스턴스 요청 종류 (InstanceResult.Action)
+const (
+	InstanceActionCreate = "create"
+	InstanceActionInvite = "invite"
+	InstanceActionJoin   = "join"
+	InstanceActionLeave  = "leave"
+)
+
+// 인스턴스 생성 요청. 성공하면 만든 사람이 바로 입장하고, 만든 사람의 파티원은 모두 초대된다
+// (접속 중인 파티원에게는 "instanceInvitation" 이 간다).
+// 클라이언트 -> 서버 ("instanceCreate")
+// { "template": "string" }
+// 변경 이력: 만든 사람의 파티원 자동 초대
+type InstanceCreateRequest struct {
+	Template string `json:"template"`
+}
+
+// 인스턴스 초대 요청 (인스턴스 참가자만 초대할 수 있음)
+// 클라이언트 -> 서버 ("instanceInvite")
+// { "instanceID": "string", "username": "string" }
+type InstanceInviteRequest struct {
+	InstanceID string `json:"instanceID"`
+	Username   string `json:"username"`
+}
+
+// 인스턴스 초대 알림
+// 서버 -> 클라이언트 ("instanceInvitation")
+// { "instanceID": "string", "template": "string", "name": "string", "from": "string" }
+type InstanceInvitation struct {
+	InstanceID string `json:"instanceID"`
+	Template   string `json:"template"`
+	Name       string `json:"name"`
+	From       string `json:"from"`
+}
+
+// 초대받은 인스턴스 입장 요청
+// 클라이언트 -> 서버 ("instanceJoin")
+// { "instanceID": "string" }
+type InstanceJoinRequest struct {
+	InstanceID string `json:"instanceID"`
+}
+
+// 인스턴스 퇴장 요청 (본문 없음, 템플릿의 출구 존으로 이동)
+// 클라이언트 -> 서버 ("instanceLeave")
+
+// 인스턴스 요청 결과. 입장/퇴장 성공 후에는 "zoneChanged" 가 이어서 온다.
+// 서버 -> 클라이언트 ("instanceResult")
+// { "action": "create", "success": true, "code": "string", "instanceID": "string" }
+type InstanceResult struct {
+	Action     string `json:"action"`
+	Success    bool   `json:"success"`
+	Code       string `json:"code,omitempty"`
+	InstanceID string `json:"instanceID,omitempty"`
+}
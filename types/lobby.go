@@ -0,0 +1,90 @@
+package types
+
+// 로비/방 관련 메시지
+// 인증된 세션은 먼저 로비에 들어온다. "enterWorld" 를 보내면 시작 존으로,
+// 방장이 "roomStart" 를 보내면 방 인원 전체가 방 템플릿의 인스턴스로 이동한다.
+
+// 로비 오류 코드 (RoomResult.Code). 방 시작 실패는 인스턴스 오류 코드를 그대로 쓴다.
+const (
+	LobbyErrNotInLobby    = "not_in_lobby"
+	LobbyErrInvalidRoom   = "invalid_room" // 방 이름/인원/템플릿 값이 잘못됨
+	LobbyErrUnknownRoom   = "unknown_room"
+	LobbyErrRoomFull      = "room_full"
+	LobbyErrWrongPassword = "wrong_room_password"
+	LobbyErrAlreadyInRoom = "already_in_room"
+	LobbyErrNotInRoom     = "not_in_room"
+	LobbyErrNotHost       = "not_host"
+	LobbyErrRoomStarting  = "room_starting"
+)
+
+// 방 요청 종류 (RoomResult.Action)
+const (
+	RoomActionCreate = "create"
+	RoomActionJoin   = "join"
+	RoomActionLeave  = "leave"
+	RoomActionStart  = "start"
+)
+
+// 방 정보
+type RoomInfo struct {
+	ID          int64    `json:"id"`
+	Name        string   `json:"name"`
+	Template    string   `json:"template"` // 시작하면 만들어질 인스턴스 템플릿
+	Host        string   `json:"host"`
+	Members     []string `json:"members"` // 들어온 순서
+	MaxPlayers  int      `json:"maxPlayers"`
+	HasPassword bool     `json:"hasPassword"`
+}
+
+// 로비 입장 알림 (접속 직후, 현재 방 목록 포함)
+// 서버 -> 클라이언트 ("lobbyJoined")
+// { "rooms": [RoomInfo] }
+type LobbyJoined struct {
+	Rooms []RoomInfo `json:"rooms"`
+}
+
+// 방 목록
+// 클라이언트 -> 서버 ("roomList", 본문 없음)
+// 서버 -> 클라이언트 ("roomList")
+// { "rooms": [RoomInfo] }
+type RoomList struct {
+	Rooms []RoomInfo `json:"rooms"`
+}
+
+// 방 만들기 (만든 사람이 방장으로 바로 들어감)
+// 클라이언트 -> 서버 ("roomCreate")
+// { "name": "string", "template": "string", "maxPlayers": 4, "password": "string" }
+type RoomCreateRequest struct {
+	Name       string `json:"name"`
+	Template   string `json:"template"`
+	MaxPlayers int    `json:"maxPlayers"` // 0 이면 템플릿 최대 인원
+	Password   string `json:"password"`   // 비우면 공개 방
+}
+
+// 방 들어가기
+// 클라이언트 -> 서버 ("roomJoin")
+// { "roomID": 1, "password": "string" }
+type RoomJoinRequest struct {
+	RoomID   int64  `json:"roomID"`
+	Password string `json:"password"`
+}
+
+// 방 나가기 / 방 시작 (방장만) / 월드 입장 (로비를 떠나 시작 존으로)
+// 클라이언트 -> 서버 ("roomLeave", "roomStart", "enterWorld", 본문 없음)
+
+// 방 요청 결과
+// 서버 -> 클라이언트 ("roomResult")
+// { "action": "join", "success": true, "code": "string", "roomID": 1 }
+type RoomResult struct {
+	Action  string `json:"action"`
+	Success bool   `json:"success"`
+	Code    string `json:"code,omitempty"`
+	RoomID  int64  `json:"roomID,omitempty"`
+}
+
+// 방 인원/방장 변경 알림 (방 안의 모든 인원에게)
+// 서버 -> 클라이언트 ("roomUpdate")
+// { "room": RoomInfo }
+type RoomUpdate struct {
+	Room RoomInfo `json:"room"`
+}
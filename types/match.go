@@ -0,0 +1,117 @@
+package types
+
+// 매치메이킹 관련 메시지
+// 대기열 등록 -> "matchFound" -> 전원 수락 -> "matchStarted" 후 매치 인스턴스로 이동
+
+// 매치메이킹 오류 코드 (MatchQueueResult.Code)
+const (
+	MatchErrUnknownMode   = "unknown_mode"
+	MatchErrAlreadyQueued = "already_queued" // 대기 중이거나 수락 대기 중인 매치가 있음
+	MatchErrNotQueued     = "not_queued"
+	MatchErrPenalty       = "queue_penalty" // 매치 거절/미응답으로 일정 시간 대기열 등록 불가
+	MatchErrUnavailable   = "rating_unavailable"
+)
+
+// 대기열 요청 종류 (MatchQueueResult.Action)
+const (
+	MatchActionQueue  = "queue"
+	MatchActionCancel = "cancel"
+)
+
+// 매치 취소 사유 (MatchCancelled.Reason)
+const (
+	MatchCancelDeclined = "declined"
+	MatchCancelTimeout  = "timeout"
+	MatchCancelFailed   = "instance_failed"
+)
+
+// 매치 종료 사유 (MatchEnded.Reason)
+const (
+	MatchEndEliminated = "eliminated" // 진 팀의 남은 참가자가 모두 쓰러짐
+	MatchEndForfeit    = "forfeit"    // 진 팀이 모두 나가거나 접속이 끊김 (제한 시간 안에 입장하지 않은 경우 포함)
+	MatchEndTimeout    = "timeout"    // 제한 시간 초과 (무승부)
+)
+
+// 대기열 등록
+// 클라이언트 -> 서버 ("matchQueue")
+// { "mode": "duel" }
+type MatchQueueRequest struct {
+	Mode string `json:"mode"`
+}
+
+// 대기열 취소 (본문 없음)
+// 클라이언트 -> 서버 ("matchCancel")
+
+// 대기열 요청 결과
+// 서버 -> 클라이언트 ("matchQueueResult")
+// { "action": "queue", "success": true, "code": "string", "mode": "duel", "rating": 1200, "penaltySec": 0 }
+type MatchQueueResult struct {
+	Action     string `json:"action"`
+	Success    bool   `json:"success"`
+	Code       string `json:"code,omitempty"`
+	Mode       string `json:"mode,omitempty"`
+	Rating     int    `json:"rating,omitempty"`
+	PenaltySec int    `json:"penaltySec,omitempty"` // queue_penalty 일 때 남은 시간
+}
+
+// 매치 성사 알림. acceptTimeoutSec 안에 수락하지 않으면 거절로 처리한다.
+// 서버 -> 클라이언트 ("matchFound")
+// { "matchID": 1, "mode": "duel", "players": 2, "acceptTimeoutSec": 15 }
+type MatchFound struct {
+	MatchID          int64  `json:"matchID"`
+	Mode             string `json:"mode"`
+	Players          int    `json:"players"`
+	AcceptTimeoutSec int    `json:"acceptTimeoutSec"`
+}
+
+// 매치 수락/거절
+// 클라이언트 -> 서버 ("matchAccept", "matchDecline")
+// { "matchID": 1 }
+type MatchResponse struct {
+	MatchID int64 `json:"matchID"`
+}
+
+// 매치 취소 알림. 수락했던 플레이어는 원래 대기 순서로 대기열에 다시 들어간다 (requeued).
+// 서버 -> 클라이언트 ("matchCancelled")
+// { "matchID": 1, "reason": "declined", "requeued": true }
+type MatchCancelled struct {
+	MatchID  int64  `json:"matchID"`
+	Reason   string `json:"reason"`
+	Requeued bool   `json:"requeued"`
+}
+
+// 매치 참가자
+type MatchPlayer struct {
+	Username string `json:"username"`
+	Rating   int    `json:"rating"`
+}
+
+// 매치 시작 알림 (이어서 매치 인스턴스의 "zoneChanged" 가 온다)
+// 서버 -> 클라이언트 ("matchStarted")
+// { "matchID": 1, "instanceID": "arena-1", "team": 0, "teams": [[MatchPlayer]], "timeLimitSec": 180 }
+// 변경 이력: timeLimitSec 필드 추가 (지나면 무승부)
+type MatchStarted struct {
+	MatchID      int64           `json:"matchID"`
+	InstanceID   string          `json:"instanceID"`
+	Team         int             `json:"team"` // teams 에서 내 팀 번호
+	Teams        [][]MatchPlayer `json:"teams"`
+	TimeLimitSec int             `json:"timeLimitSec"`
+}
+
+// 매치 종료 알림 (매치 인스턴스 안의 참가자에게, 이어서 "ratingChanged" 가 온다)
+// 서버 -> 클라이언트 ("matchEnded")
+// { "matchID": 1, "winningTeam": 0, "reason": "eliminated" }
+type MatchEnded struct {
+	MatchID     int64  `json:"matchID"`
+	WinningTeam int    `json:"winningTeam"` // 무승부면 -1
+	Reason      string `json:"reason"`
+}
+
+// 매치 종료 후 레이팅 변경 알림
+// 서버 -> 클라이언트 ("ratingChanged")
+// { "matchID": 1, "rating": 1216, "delta": 16 }
+type RatingChanged struct {
+	MatchID int64 `json:"matchID"`
+	Rating  int   `json:"rating"`
+	Delta   int   `json:"delta"`
+}
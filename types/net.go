@@ -0,0 +1,56 @@
+package types
+
+// 연결 상태 관련 메시지
+// 서버는 주기적으로 "ping" 을 보내고, 클라이언트는 받은 serverTime 을 그대로 담아 바로 "pong" 으로 답한다.
+// 서버는 이것으로 왕복 지연(RTT)과 두 시계의 차이를 재고, 지연 보상에 쓴다.
+//
+// 서버 시각과 스냅샷 보간 규약
+//   - serverTime 은 서버가 시작된 뒤 지난 ms 로, 거꾸로 가지 않는다. tick 이 붙은 상태 메시지와 timeSync,
+//     ping 의 serverClock 이 모두 이 시계를 쓴다 (ping 의 serverTime 만 이전처럼 Unix ms).
+//   - tick 은 존 틱 번호로 존마다 1 부터 센다. zoneChanged 를 받으면 새 존의 번호로 바뀌므로 이전 존의 번호와 비교하지 않는다.
+//     존마다 번호가 겹치므로 snapshotAck 처럼 tick 을 서버에 돌려보낼 때는 zoneID 를 함께 보낸다.
+//     같은 틱에 보낸 메시지는 tick 과 serverTime 이 같다. 틱 사이에 요청을 처리하다 보낸 메시지 (moveApproved,
+//     공격의 combatEvent, 기절의 positionCorrection 등) 는 마지막 틱 번호와 보낸 순간의 serverTime 을 담는다.
+//     tick 이 붙는 상태 메시지: snapshot, positionCorrection, moveApproved, combatEvent, healthUpdate,
+//     statusEvent, castEvent, statsUpdate, resourceUpdate
+//   - 클라이언트는 "timeSync" 를 몇 번 주고받아 RTT 가 가장 작은 응답으로 서버 시각을 추정한다
+//     (추정 서버 시각 = serverTime + RTT/2 + 응답을 받은 뒤 지난 시간).
+//   - 원격 엔티티는 (추정 서버 시각 - 보간 지연) 시점으로 그린다. 보간 지연은 InterpolationDelayTicks * zoneChanged.tickMs 로,
+//     스냅샷 하나가 늦거나 빠져도 그 시점을 감싸는 앞뒤 스냅샷이 있을 만큼이다. 두 스냅샷 사이는 선형 보간하고,
+//     다음 스냅샷이 아직 없으면 마지막 위치에 멈춘다 (외삽하지 않음).
+//   - 자신이 조종하는 엔티티는 보간하지 않고 moveApproved 로 예측해서 그리며, positionCorrection 으로 맞춘다.
+//   - 공격할 때 그리던 시점을 attack 의 renderTime 으로 보내면 서버는 대상 위치를 그 시점으로 되돌려 판정한다
+//     (서버도 틱마다 기록한 위치 사이를 선형 보간한다. 되돌리는 폭은 서버 설정 lagCompensationMs 까지).
+
+// 원격 엔티티를 그릴 때 추정 서버 시각보다 몇 틱 늦게 그리는지
+const InterpolationDelayTicks = 2
+
+// 서버 -> 클라이언트 ("ping")
+// { "serverTime": 1700000000000, "serverClock": 1000 }
+// 변경 이력: serverClock 필드 추가 (서버 시각). serverTime 은 그대로 Unix ms 이다.
+type Ping struct {
+	ServerTime  int64 `json:"serverTime"`  // 보낸 시각 (Unix ms, 서버 시계). pong 에 그대로 돌려준다.
+	ServerClock int64 `json:"serverClock"` // 보낸 시각 (서버 시각, ms)
+}
+
+// 클라이언트 -> 서버 ("pong")
+// { "serverTime": 1700000000000, "clientTime": 1700000000000 }
+type Pong struct {
+	ServerTime int64 `json:"serverTime"` // 받은 ping 의 serverTime 그대로
+	ClientTime int64 `json:"clientTime"` // 답할 때의 클라이언트 시각 (ms, 클라이언트 시계)
+}
+
+// 서버 시각 추정 요청 (접속 직후 여러 번, 이후 가끔)
+// 클라이언트 -> 서버 ("timeSync")
+// { "clientTime": 1700000000000 }
+type TimeSyncRequest struct {
+	ClientTime int64 `json:"clientTime"` // 보낼 때의 클라이언트 시각 (ms)
+}
+
+// 서버 시각 추정 응답 (받자마자 답한다)
+// 서버 -> 클라이언트 ("timeSync")
+// { "clientTime": 1700000000000, "serverTime": 1000 }
+type TimeSync struct {
+	ClientTime int64 `json:"clientTime"` // 요청의 clientTime 그대로 (RTT = 지금 - clientTime)
+	ServerTime int64 `json:"serverTime"` // 답할 때의 서버 시각 (ms)
+}
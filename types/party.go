@@ -0,0 +1,116 @@
+package types
+
+// 파티 관련 메시지
+// 파티에 속하지 않은 플레이어가 초대한 사람이 수락하면 초대한 사람을 파티장으로 파티가 만들어진다.
+// 파티원 상태(존, 위치, 체력)는 시야와 관계없이 주기적으로 온다.
+
+// 파티 오류 코드 (PartyResult.Code)
+const (
+	PartyErrPlayerOffline  = "player_offline"
+	PartyErrInvalidTarget  = "invalid_target" // 자기 자신이거나 비어 있음
+	PartyErrAlreadyInParty = "already_in_party"
+	PartyErrNotInParty     = "not_in_party"
+	PartyErrNotLeader      = "not_leader"
+	PartyErrNotMember      = "not_member" // 대상이 같은 파티원이 아님
+	PartyErrFull           = "party_full"
+	PartyErrNoInvitation   = "no_invitation" // 초대가 없거나 만료됨
+)
+
+// 파티 요청 종류 (PartyResult.Action)
+const (
+	PartyActionInvite  = "invite"
+	PartyActionAccept  = "accept"
+	PartyActionDecline = "decline"
+	PartyActionLeave   = "leave"
+	PartyActionKick    = "kick"
+	PartyActionPromote = "promote"
+)
+
+// 파티를 떠난 이유 (PartyLeft.Reason)
+const (
+	PartyLeftSelf      = "left"
+	PartyLeftKicked    = "kicked"
+	PartyLeftDisbanded = "disbanded"
+	PartyLeftTimeout   = "timeout" // 접속이 끊긴 채로 유예 시간이 지남
+)
+
+// 파티 초대 (파티장 또는 파티에 속하지 않은 플레이어만 초대할 수 있음)
+// 클라이언트 -> 서버 ("partyInvite")
+// { "username": "string" }
+type PartyInviteRequest struct {
+	Username string `json:"username"`
+}
+
+// 파티 초대 알림
+// 서버 -> 클라이언트 ("partyInvitation")
+// { "from": "string", "expiresInMs": 60000 }
+type PartyInvitation struct {
+	From        string `json:"from"`
+	ExpiresInMs int64  `json:"expiresInMs"`
+}
+
+// 파티 초대 수락/거절 (from: 초대한 사람)
+// 클라이언트 -> 서버 ("partyAccept", "partyDecline")
+// { "from": "string" }
+type PartyInvitationResponse struct {
+	From string `json:"from"`
+}
+
+// 초대를 거절당함 (초대한 사람에게)
+// 서버 -> 클라이언트 ("partyInviteDeclined")
+// { "username": "string" }
+type PartyInviteDeclined struct {
+	Username string `json:"username"`
+}
+
+// 파티 탈퇴 요청 (본문 없음, 파티장이 나가면 가장 먼저 들어온 파티원이 파티장이 됨)
+// 클라이언트 -> 서버 ("partyLeave")
+
+// 파티원 추방/파티장 위임 (파티장만)
+// 클라이언트 -> 서버 ("partyKick", "partyPromote")
+// { "username": "string" }
+type PartyMemberRequest struct {
+	Username string `json:"username"`
+}
+
+// 파티 요청 결과
+// 서버 -> 클라이언트 ("partyResult")
+// { "action": "invite", "success": false, "code": "party_full" }
+type PartyResult struct {
+	Action  string `json:"action"`
+	Success bool   `json:"success"`
+	Code    string `json:"code,omitempty"`
+}
+
+// 파티원 상태. 월드에 들어오지 않았거나(로비) 접속이 끊긴 파티원은 zoneID 가 비어 있다.
+type PartyMemberState struct {
+	Username string `json:"username"`
+	Online   bool   `json:"online"`
+	ZoneID   string `json:"zoneID,omitempty"`
+	Position Vector `json:"position"`
+	Health   int    `json:"health"`
+}
+
+// 파티 구성 (결성, 가입/탈퇴, 파티장 변경, 파티원 접속/접속 종료 시)
+// 서버 -> 클라이언트 ("partyUpdate")
+// { "partyID": 1, "leader": "string", "members": [PartyMemberState] }
+type PartyUpdate struct {
+	PartyID int64              `json:"partyID"`
+	Leader  string             `json:"leader"`
+	Members []PartyMemberState `json:"members"` // 들어온 순서
+}
+
+// 상태가 바뀐 파티원 (주기적으로, 시야와 관계없이)
+// 서버 -> 클라이언트 ("partyMemberUpdate")
+// { "members": [PartyMemberState] }
+type PartyMemberUpdate struct {
+	Members []PartyMemberState `json:"members"`
+}
+
+// 파티에서 빠짐
+// 서버 -> 클라이언트 ("partyLeft")
+// { "partyID": 1, "reason": "kicked" }
+type PartyLeft struct {
+	PartyID int64  `json:"partyID"`
+	Reason  string `json:"reason"`
+}
@@ -0,0 +1,35 @@
+package types
+
+// 캐릭터 능력치 관련 메시지
+// 기본 능력치(stamina, strength)에서 파생 능력치(maxHealth, attackPower)를 계산하고,
+// 장비/상태 효과의 수정치를 더한 결과가 바뀔 때마다 "statsUpdate" 가 자신에게 온다.
+
+// 능력치 이름 (상태 효과 정의의 수정치 대상)
+const (
+	StatStamina     = "stamina"     // 기본: 최대 체력을 올림
+	StatStrength    = "strength"    // 기본: 공격력을 올림
+	StatMaxHealth   = "maxHealth"   // 파생
+	StatAttackPower = "attackPower" // 파생: 기본 공격 피해에 더함
+	StatMoveSpeed   = "moveSpeed"   // 유닛/초
+)
+
+// 능력치 값
+type CharacterStats struct {
+	Stamina     int     `json:"stamina"`
+	Strength    int     `json:"strength"`
+	MaxHealth   int     `json:"maxHealth"`
+	AttackPower int     `json:"attackPower"`
+	MoveSpeed   float32 `json:"moveSpeed"`
+}
+
+// 자신의 능력치 (존 입장, 수정치 변화)
+// base 는 수정치가 없을 때의 값, stats 는 수정치를 모두 반영한 현재 값
+// 서버 -> 클라이언트 ("statsUpdate")
+// { "base": CharacterStats, "stats": CharacterStats, "tick": 1, "serverTime": 1000 }
+// 변경 이력: tick, serverTime 필드 추가 (보간 규약은 net.go 참고)
+type StatsUpdate struct {
+	Base       CharacterStats `json:"base"`
+	Stats      CharacterStats `json:"stats"`
+	Tick       int64          `json:"tick"`
+	ServerTime int64          `json:"serverTime"`
+}
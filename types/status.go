@@ -0,0 +1,63 @@
+package types
+
+// 상태 효과(버프/디버프) 관련 메시지
+// 효과가 걸리거나 갱신되거나 사라지면 "statusEvent" 가 대상 주변의 플레이어 모두에게 오고,
+// 시야에 새로 들어온 엔티티의 효과는 EntityState.Effects 로 온다.
+
+// 상태 효과 종류 (StatusEffectInfo.Kind)
+const (
+	StatusKindSlow   = "slow"   // 이동 속도 감소
+	StatusKindStun   = "stun"   // 이동, 공격, 능력 사용 불가 (시전 중단)
+	StatusKindDoT    = "dot"    // 주기적 피해
+	StatusKindHoT    = "hot"    // 주기적 회복
+	StatusKindShield = "shield" // 피해 흡수
+	StatusKindHaste  = "haste"  // 이동 속도 증가
+	StatusKindStats  = "stats"  // 능력치 수정 (statsUpdate 로 반영)
+)
+
+// 상태 이벤트 종류 (StatusEvent.Type)
+const (
+	StatusEventApplied   = "applied"
+	StatusEventRefreshed = "refreshed" // 지속 시간 갱신 또는 중첩 증가
+	StatusEventRemoved   = "removed"
+)
+
+// 상태 효과가 사라진 사유 (StatusEvent.Reason)
+const (
+	StatusRemovedExpired   = "expired"
+	StatusRemovedDispelled = "dispelled"
+	StatusRemovedDepleted  = "depleted" // 보호막을 모두 소모함
+	StatusRemovedDied      = "died"     // 대상이 죽어 모든 효과가 사라짐
+)
+
+// 걸려 있는 상태 효과
+type StatusEffectInfo struct {
+	ID          string `json:"id"`
+	Kind        string `json:"kind"`
+	Buff        bool   `json:"buff"` // false 면 디버프
+	Stacks      int    `json:"stacks"`
+	DurationMs  int    `json:"durationMs"`
+	RemainingMs int    `json:"remainingMs"`
+	SourceID    int64  `json:"sourceID"`
+	Shield      int    `json:"shield,omitempty"` // shield: 남은 흡수량
+}
+
+// 상태 효과 변화 (대상 주변 플레이어 모두에게)
+// 서버 -> 클라이언트 ("statusEvent")
+// { "type": "applied", "entityID": 1, "effect": StatusEffectInfo, "reason": "string", "tick": 1, "serverTime": 1000 }
+// 변경 이력: tick, serverTime 필드 추가 (보간 규약은 net.go 참고)
+type StatusEvent struct {
+	Type       string           `json:"type"`
+	EntityID   int64            `json:"entityID"`
+	Effect     StatusEffectInfo `json:"effect"`
+	Reason     string           `json:"reason,omitempty"` // removed
+	Tick       int64            `json:"tick"`
+	ServerTime int64            `json:"serverTime"`
+}
+
+// 자신에게 걸린 상태 효과 전체 (존 입장 시)
+// 서버 -> 클라이언트 ("statusEffects")
+// { "effects": [StatusEffectInfo] }
+type StatusEffects struct {
+	Effects []StatusEffectInfo `json:"effects"`
+}
@@ -20,24 +20,88 @@ type MoveRequest struct {
 	Target Vector `json:"target"`
 }
 
+// 이동 승인 (자신이 조종하는 엔티티)
+// 서버 -> 클라이언트 ("moveApproved")
+// { "target": Vector, "speed": 1, "path": [Vector], "tick": 1, "serverTime": 1000 }
+// 변경 이력: path 필드 추가 (길찾기 경유지, 마지막이 target)
+// 변경 이력: tick, serverTime 필드 추가 (보간 규약은 net.go 참고)
 type MoveApproved struct {
-	Target Vector  `json:"target"`
-	Speed  float32 `json:"speed"`
+	Target     Vector   `json:"target"`
+	Speed      float32  `json:"speed"`
+	Path       []Vector `json:"path"`
+	Tick       int64    `json:"tick"`
+	ServerTime int64    `json:"serverTime"`
 }
 
+// 이동 거부 코드 (MoveRejected.Code)
+const (
+	MoveErrNoPath    = "no_path"    // 막힌 곳이거나 길이 없음
+	MoveErrOffGround = "off_ground" // 목표의 Y 가 지면에서 너무 멂 (공중이나 땅속)
+)
+
+// 이동 거부 (하던 이동은 그대로 계속됨)
+// 서버 -> 클라이언트 ("moveRejected")
+// { "target": Vector, "code": "no_path" }
+type MoveRejected struct {
+	Target Vector `json:"target"`
+	Code   string `json:"code"`
+}
+
+// 자신이 조종하는 엔티티의 서버 위치 (이동 중 틱마다, 기절 등으로 멈췄을 때)
+// 서버 -> 클라이언트 ("positionCorrection")
+// { "position": Vector, "tick": 1, "serverTime": 1000 }
+// 변경 이력: tick, serverTime 필드 추가 (보간 규약은 net.go 참고)
 type PositionCorrection struct {
-	Position Vector `json:"position"`
+	Position   Vector `json:"position"`
+	Tick       int64  `json:"tick"`
+	ServerTime int64  `json:"serverTime"`
 }
 
+// 변경 이력: zoneID 필드 추가 (엔티티가 속한 존)
+// 변경 이력: maxHealth 필드 추가 (health 가 0 이면 사망 상태)
 type PlayerState struct {
 	Health    int    `json:"health"`
+	MaxHealth int    `json:"maxHealth"`
 	Position  Vector `json:"Position"`
 	Target    Vector `json:"Target"`
 	MoveState int    `json:"moveState"` // 0: Idle, 1: Moving
+	ZoneID    string `json:"zoneID"`
+}
+
+// 회원가입/로그인 HTTP API 오류 코드
+// 응답의 code 필드에 담겨 클라이언트가 메시지 문자열 대신 코드로 분기할 수 있게 한다.
+const (
+	ErrCodeMethodNotAllowed = "method_not_allowed"
+	ErrCodeInvalidRequest   = "invalid_request"
+	ErrCodeMissingFields    = "missing_fields"
+	ErrCodeValidation       = "validation_failed" // 상세 내용은 errors 필드 참고
+	ErrCodeUsernameTaken    = "username_taken"
+	ErrCodeUserNotFound     = "user_not_found"
+	ErrCodeWrongPassword    = "wrong_password"
+	ErrCodeInternal         = "internal_error"
+)
+
+// 필드별 검증 오류 코드 (FieldError.Code)
+const (
+	FieldErrRequired       = "required"
+	FieldErrTooShort       = "too_short"
+	FieldErrTooLong        = "too_long"
+	FieldErrInvalidChars   = "invalid_chars"
+	FieldErrInvalidStart   = "invalid_start" // 사용자명은 글자로 시작해야 함
+	FieldErrReserved       = "reserved"
+	FieldErrProfanity      = "profanity"
+	FieldErrSameAsUsername = "same_as_username"
+)
+
+// 필드별 검증 오류
+// { "field": "username", "code": "too_short" }
+type FieldError struct {
+	Field string `json:"field"`
+	Code  string `json:"code"`
 }
 
 // 회원가입 요청
-// 클라이언트 -> 서버
+// 클라이언트 -> 서버 (application/json 또는 form)
 // { "username": "string", "password": "string" }
 type RegisterRequest struct {
 	Username string `json:"username"`
@@ -46,14 +110,18 @@ type RegisterRequest struct {
 
 // 회원가입 응답
 // 서버 -> 클라이언트
-// { "success": true, "message": "string" }
+// { "success": true, "code": "string", "message": "string", "errors": [FieldError] }
+// 변경 이력: code 필드 추가 (실패 시에만 포함)
+// 변경 이력: errors 필드 추가 (code 가 validation_failed 일 때만 포함)
 type RegisterResponse struct {
-	Success bool   `json:"success"`
-	Message string `json:"message"`
+	Success bool         `json:"success"`
+	Code    string       `json:"code,omitempty"`
+	Message string       `json:"message"`
+	Errors  []FieldError `json:"errors,omitempty"`
 }
 
 // 로그인 요청
-// 클라이언트 -> 서버
+// 클라이언트 -> 서버 (application/json 또는 form)
 // { "username": "string", "password": "string" }
 type LoginRequest struct {
 	Username string `json:"username"`
@@ -62,8 +130,11 @@ type LoginRequest struct {
 
 // 로그인 응답
 // 서버 -> 클라이언트
-// { "success": true, "message": "string" }
+// { "success": true, "code": "string", "message": "string", "token": "string" }
+// 변경 이력: code 필드 추가 (실패 시에만 포함), token 필드 추가 (성공 시에만 포함)
 type LoginResponse struct {
 	Success bool   `json:"success"`
+	Code    string `json:"code,omitempty"`
 	Message string `json:"message"`
+	Token   string `json:"token,omitempty"`
 }
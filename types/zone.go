@@ -0,0 +1,112 @@
+package types
+
+// 존(맵) 관련 메시지
+
+// 존 오류 코드 (ZoneTransferResult.Code)
+const (
+	ZoneErrUnknownPortal = "unknown_portal"
+	ZoneErrTooFar        = "too_far"
+	ZoneErrUnavailable   = "zone_unavailable"
+	ZoneErrDead          = "dead" // 죽은 상태에서는 포탈을 쓸 수 없음
+)
+
+// 엔티티 종류 (EntityState.Kind)
+const (
+	EntityKindPlayer  = "player"
+	EntityKindNPC     = "npc"     // 우호 NPC: 공격하지도 공격받지도 않음
+	EntityKindMonster = "monster" // 플레이어를 공격하는 NPC
+)
+
+// 존 입장 알림 (접속 직후, 존 이동 완료 시)
+// 서버 -> 클라이언트 ("zoneChanged")
+// { "zoneID": "town", "name": "string", "entityID": 1, "position": Vector, "boundsMin": Vector, "boundsMax": Vector, "portals": [PortalInfo], "tickMs": 200 }
+// 변경 이력: tickMs 필드 추가 (보간 지연 계산용)
+type ZoneChanged struct {
+	ZoneID    string       `json:"zoneID"`
+	Name      string       `json:"name"`
+	EntityID  int64        `json:"entityID"` // 이 클라이언트가 조종하는 엔티티
+	Position  Vector       `json:"position"`
+	BoundsMin Vector       `json:"boundsMin"`
+	BoundsMax Vector       `json:"boundsMax"`
+	Portals   []PortalInfo `json:"portals"`
+	TickMs    int          `json:"tickMs"` // 존 틱 주기 (상태 메시지 간격)
+}
+
+// 존 안의 포탈 정보
+type PortalInfo struct {
+	ID         string  `json:"id"`
+	Position   Vector  `json:"position"`
+	Radius     float32 `json:"radius"`
+	TargetZone string  `json:"targetZone"`
+}
+
+// 포탈 이용 요청 (포탈 반경 안에 있어야 함)
+// 클라이언트 -> 서버 ("usePortal")
+// { "portalID": "string" }
+type UsePortalRequest struct {
+	PortalID string `json:"portalID"`
+}
+
+// 존 이동 실패 응답 (성공 시에는 zoneChanged 를 보냄)
+// 서버 -> 클라이언트 ("zoneTransferResult")
+// { "success": false, "code": "string" }
+type ZoneTransferResult struct {
+	Success bool   `json:"success"`
+	Code    string `json:"code,omitempty"`
+}
+
+// 주변 엔티티 상태 (스냅샷)
+// 서버는 존 틱마다 플레이어에게 시야 안 다른 엔티티들의 스냅샷을 보낸다. 대부분은 클라이언트가 받았다고 알린
+// 이전 스냅샷(기준 스냅샷)과 달라진 것만 담은 델타다.
+//   - baseline 이 0 이면 전체 스냅샷이다. entities 가 시야 안 엔티티 전부이고 모든 필드가 있다.
+//     처음, 존 입장 직후, snapshotAck 를 보내지 않는 클라이언트, 기준 스냅샷이 SnapshotBacklog 틱보다 오래되었을 때 온다.
+//   - baseline 이 있으면 그 tick 의 상태에서 removed 를 빼고, entities 의 필드를 덮어쓰면 이번 tick 의 상태가 된다.
+//     기준 스냅샷에 없던 엔티티는 모든 필드가 있고, 있던 엔티티는 바뀐 필드만 있으며, 바뀐 것이 없는 엔티티는 빠진다.
+//   - 클라이언트는 이렇게 만든 상태를 tick 별로 최근 SnapshotBacklog 개까지 보관하고, 받을 때마다 snapshotAck 로 tick 과
+//     그 스냅샷을 받은 존(마지막 zoneChanged 의 zoneID)을 알린다. tick 은 존마다 따로 세므로 zoneChanged 를 받으면
+//     보관한 스냅샷을 모두 버린다. 서버는 지금 존과 zoneID 가 다른 확인(존 이동 중에 보낸 이전 존의 확인)은 버린다.
+//   - 위치는 PositionScale 로 양자화한 정수다 (float 로 되돌릴 때 나눈다).
+//   - effects 도 바뀔 때만 온다. 바뀌지 않았으면 기준 스냅샷의 remainingMs 에서 그 사이 지난 시간(serverTime 차이)을 뺀다.
+
+// 기준 스냅샷으로 쓸 수 있는 가장 오래된 tick 차이 (클라이언트는 적어도 이만큼 보관해야 함)
+const SnapshotBacklog = 32
+
+// 위치 양자화 배율 (1cm 단위)
+const PositionScale = 100
+
+// 양자화한 위치 [x, y, z] (각 성분 * PositionScale 을 반올림한 정수)
+type QVector [3]int32
+
+// 스냅샷 안의 엔티티 하나. id 외의 필드는 델타에서 바뀌었을 때만 있다.
+// 변경 이력: state(PlayerState) 를 필드별 선택 필드로 나누고 위치를 양자화함 (델타 압축)
+type EntityState struct {
+	ID        int64               `json:"id"`
+	Kind      string              `json:"kind,omitempty"`
+	Name      string              `json:"name,omitempty"`
+	Position  *QVector            `json:"position,omitempty"`
+	Target    *QVector            `json:"target,omitempty"` // 이동 목표
+	Health    *int                `json:"health,omitempty"`
+	MaxHealth *int                `json:"maxHealth,omitempty"`
+	MoveState *int                `json:"moveState,omitempty"` // 0: Idle, 1: Moving
+	Effects   *[]StatusEffectInfo `json:"effects,omitempty"`   // 빈 배열이면 효과가 모두 사라짐
+}
+
+// 시야 안 다른 엔티티들의 스냅샷 (존 틱마다, entityUpdate 와 entityRemoved 를 대신함)
+// 서버 -> 클라이언트 ("snapshot")
+// { "tick": 10, "serverTime": 2000, "baseline": 8, "entities": [EntityState], "removed": [3] }
+type Snapshot struct {
+	Tick       int64         `json:"tick"`
+	ServerTime int64         `json:"serverTime"`
+	Baseline   int64         `json:"baseline,omitempty"` // 기준 스냅샷의 tick (0 이면 전체 스냅샷)
+	Entities   []EntityState `json:"entities,omitempty"`
+	Removed    []int64       `json:"removed,omitempty"` // 기준 스냅샷에는 있었지만 시야에서 사라진 엔티티
+}
+
+// 스냅샷 수신 확인 (받은 스냅샷마다)
+// 클라이언트 -> 서버 ("snapshotAck")
+// { "zoneID": "town", "tick": 10 }
+// 변경 이력: zoneID 필드 추가 (없거나 지금 존과 다르면 확인을 버리고 전체 스냅샷을 계속 보냄)
+type SnapshotAck struct {
+	ZoneID string `json:"zoneID"` // 스냅샷을 받은 존
+	Tick   int64  `json:"tick"`
+}